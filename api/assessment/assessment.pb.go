@@ -38,7 +38,6 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -178,10 +177,15 @@ func (*ConfigureAssessmentResponse) Descriptor() ([]byte, []int) {
 }
 
 type CalculateComplianceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ControlId     string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ControlId    string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	CategoryName string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CatalogId    string                 `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	// Optional. Only take assessment results of a specific target of evaluation
+	// into account.
+	TargetOfEvaluationId *string `protobuf:"bytes,4,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *CalculateComplianceRequest) Reset() {
@@ -221,6 +225,198 @@ func (x *CalculateComplianceRequest) GetControlId() string {
 	return ""
 }
 
+func (x *CalculateComplianceRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CalculateComplianceRequest) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CalculateComplianceRequest) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+// CalculateComplianceResponse contains the compliance of a control, broken
+// down by the metrics of the control and its sub-controls.
+type CalculateComplianceResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ControlId            string                 `protobuf:"bytes,1,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	CategoryName         string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CatalogId            string                 `protobuf:"bytes,3,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
+	TargetOfEvaluationId *string                `protobuf:"bytes,4,opt,name=target_of_evaluation_id,json=targetOfEvaluationId,proto3,oneof" json:"target_of_evaluation_id,omitempty"`
+	// Compliant is true, if assessment results are available for the control
+	// and all of them are compliant.
+	Compliant bool `protobuf:"varint,5,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	// The compliance of the individual metrics of the control.
+	Metrics       []*MetricCompliance `protobuf:"bytes,6,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateComplianceResponse) Reset() {
+	*x = CalculateComplianceResponse{}
+	mi := &file_api_assessment_assessment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateComplianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateComplianceResponse) ProtoMessage() {}
+
+func (x *CalculateComplianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_assessment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateComplianceResponse.ProtoReflect.Descriptor instead.
+func (*CalculateComplianceResponse) Descriptor() ([]byte, []int) {
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CalculateComplianceResponse) GetControlId() string {
+	if x != nil {
+		return x.ControlId
+	}
+	return ""
+}
+
+func (x *CalculateComplianceResponse) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CalculateComplianceResponse) GetCatalogId() string {
+	if x != nil {
+		return x.CatalogId
+	}
+	return ""
+}
+
+func (x *CalculateComplianceResponse) GetTargetOfEvaluationId() string {
+	if x != nil && x.TargetOfEvaluationId != nil {
+		return *x.TargetOfEvaluationId
+	}
+	return ""
+}
+
+func (x *CalculateComplianceResponse) GetCompliant() bool {
+	if x != nil {
+		return x.Compliant
+	}
+	return false
+}
+
+func (x *CalculateComplianceResponse) GetMetrics() []*MetricCompliance {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// MetricCompliance summarizes the latest assessment results of a single metric.
+type MetricCompliance struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MetricId string                 `protobuf:"bytes,1,opt,name=metric_id,json=metricId,proto3" json:"metric_id,omitempty"`
+	// Compliant is true, if assessment results are available for the metric and
+	// all of them are compliant.
+	Compliant bool `protobuf:"varint,2,opt,name=compliant,proto3" json:"compliant,omitempty"`
+	// The number of resources that are compliant to the metric.
+	CompliantCount int32 `protobuf:"varint,3,opt,name=compliant_count,json=compliantCount,proto3" json:"compliant_count,omitempty"`
+	// The number of resources that are not compliant to the metric.
+	NonCompliantCount int32 `protobuf:"varint,4,opt,name=non_compliant_count,json=nonCompliantCount,proto3" json:"non_compliant_count,omitempty"`
+	// The IDs of the assessment results the compliance is based on.
+	AssessmentResultIds []string `protobuf:"bytes,5,rep,name=assessment_result_ids,json=assessmentResultIds,proto3" json:"assessment_result_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MetricCompliance) Reset() {
+	*x = MetricCompliance{}
+	mi := &file_api_assessment_assessment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricCompliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricCompliance) ProtoMessage() {}
+
+func (x *MetricCompliance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_assessment_assessment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricCompliance.ProtoReflect.Descriptor instead.
+func (*MetricCompliance) Descriptor() ([]byte, []int) {
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MetricCompliance) GetMetricId() string {
+	if x != nil {
+		return x.MetricId
+	}
+	return ""
+}
+
+func (x *MetricCompliance) GetCompliant() bool {
+	if x != nil {
+		return x.Compliant
+	}
+	return false
+}
+
+func (x *MetricCompliance) GetCompliantCount() int32 {
+	if x != nil {
+		return x.CompliantCount
+	}
+	return 0
+}
+
+func (x *MetricCompliance) GetNonCompliantCount() int32 {
+	if x != nil {
+		return x.NonCompliantCount
+	}
+	return 0
+}
+
+func (x *MetricCompliance) GetAssessmentResultIds() []string {
+	if x != nil {
+		return x.AssessmentResultIds
+	}
+	return nil
+}
+
 type AssessEvidenceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Evidence      *evidence.Evidence     `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
@@ -230,7 +426,7 @@ type AssessEvidenceRequest struct {
 
 func (x *AssessEvidenceRequest) Reset() {
 	*x = AssessEvidenceRequest{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[3]
+	mi := &file_api_assessment_assessment_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +438,7 @@ func (x *AssessEvidenceRequest) String() string {
 func (*AssessEvidenceRequest) ProtoMessage() {}
 
 func (x *AssessEvidenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[3]
+	mi := &file_api_assessment_assessment_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +451,7 @@ func (x *AssessEvidenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssessEvidenceRequest.ProtoReflect.Descriptor instead.
 func (*AssessEvidenceRequest) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{3}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AssessEvidenceRequest) GetEvidence() *evidence.Evidence {
@@ -277,7 +473,7 @@ type AssessEvidenceResponse struct {
 
 func (x *AssessEvidenceResponse) Reset() {
 	*x = AssessEvidenceResponse{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[4]
+	mi := &file_api_assessment_assessment_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -289,7 +485,7 @@ func (x *AssessEvidenceResponse) String() string {
 func (*AssessEvidenceResponse) ProtoMessage() {}
 
 func (x *AssessEvidenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[4]
+	mi := &file_api_assessment_assessment_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +498,7 @@ func (x *AssessEvidenceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssessEvidenceResponse.ProtoReflect.Descriptor instead.
 func (*AssessEvidenceResponse) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{4}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AssessEvidenceResponse) GetStatus() AssessmentStatus {
@@ -326,7 +522,7 @@ type AssessEvidencesResponse struct {
 
 func (x *AssessEvidencesResponse) Reset() {
 	*x = AssessEvidencesResponse{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[5]
+	mi := &file_api_assessment_assessment_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +534,7 @@ func (x *AssessEvidencesResponse) String() string {
 func (*AssessEvidencesResponse) ProtoMessage() {}
 
 func (x *AssessEvidencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[5]
+	mi := &file_api_assessment_assessment_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +547,7 @@ func (x *AssessEvidencesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssessEvidencesResponse.ProtoReflect.Descriptor instead.
 func (*AssessEvidencesResponse) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{5}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AssessEvidencesResponse) GetStatus() AssessmentStatus {
@@ -406,7 +602,7 @@ type AssessmentResult struct {
 
 func (x *AssessmentResult) Reset() {
 	*x = AssessmentResult{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[6]
+	mi := &file_api_assessment_assessment_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +614,7 @@ func (x *AssessmentResult) String() string {
 func (*AssessmentResult) ProtoMessage() {}
 
 func (x *AssessmentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[6]
+	mi := &file_api_assessment_assessment_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +627,7 @@ func (x *AssessmentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssessmentResult.ProtoReflect.Descriptor instead.
 func (*AssessmentResult) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{6}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AssessmentResult) GetId() string {
@@ -542,7 +738,7 @@ type Record struct {
 
 func (x *Record) Reset() {
 	*x = Record{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[7]
+	mi := &file_api_assessment_assessment_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +750,7 @@ func (x *Record) String() string {
 func (*Record) ProtoMessage() {}
 
 func (x *Record) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[7]
+	mi := &file_api_assessment_assessment_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +763,7 @@ func (x *Record) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Record.ProtoReflect.Descriptor instead.
 func (*Record) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{7}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Record) GetEvidenceId() string {
@@ -603,7 +799,7 @@ type ComparisonResult struct {
 
 func (x *ComparisonResult) Reset() {
 	*x = ComparisonResult{}
-	mi := &file_api_assessment_assessment_proto_msgTypes[8]
+	mi := &file_api_assessment_assessment_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +811,7 @@ func (x *ComparisonResult) String() string {
 func (*ComparisonResult) ProtoMessage() {}
 
 func (x *ComparisonResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_assessment_assessment_proto_msgTypes[8]
+	mi := &file_api_assessment_assessment_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +824,7 @@ func (x *ComparisonResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComparisonResult.ProtoReflect.Descriptor instead.
 func (*ComparisonResult) Descriptor() ([]byte, []int) {
-	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{8}
+	return file_api_assessment_assessment_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ComparisonResult) GetProperty() string {
@@ -670,12 +866,36 @@ var File_api_assessment_assessment_proto protoreflect.FileDescriptor
 
 const file_api_assessment_assessment_proto_rawDesc = "" +
 	"\n" +
-	"\x1fapi/assessment/assessment.proto\x12\x18confirmate.assessment.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bapi/evidence/evidence.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\x1c\n" +
+	"\x1fapi/assessment/assessment.proto\x12\x18confirmate.assessment.v1\x1a\x1bapi/assessment/metric.proto\x1a\x1bapi/evidence/evidence.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13tagger/tagger.proto\"\x1c\n" +
 	"\x1aConfigureAssessmentRequest\"\x1d\n" +
-	"\x1bConfigureAssessmentResponse\";\n" +
-	"\x1aCalculateComplianceRequest\x12\x1d\n" +
+	"\x1bConfigureAssessmentResponse\"\x85\x02\n" +
+	"\x1aCalculateComplianceRequest\x12)\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcontrolId\x12/\n" +
+	"\rcategory_name\x18\x02 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\fcategoryName\x12)\n" +
+	"\n" +
+	"catalog_id\x18\x03 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\tcatalogId\x12D\n" +
+	"\x17target_of_evaluation_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x14targetOfEvaluationId\x88\x01\x01B\x1a\n" +
+	"\x18_target_of_evaluation_id\"\xd5\x02\n" +
+	"\x1bCalculateComplianceResponse\x12\"\n" +
+	"\n" +
+	"control_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tcontrolId\x12(\n" +
+	"\rcategory_name\x18\x02 \x01(\tB\x03\xe0A\x02R\fcategoryName\x12\"\n" +
 	"\n" +
-	"control_id\x18\x01 \x01(\tR\tcontrolId\"]\n" +
+	"catalog_id\x18\x03 \x01(\tB\x03\xe0A\x02R\tcatalogId\x12:\n" +
+	"\x17target_of_evaluation_id\x18\x04 \x01(\tH\x00R\x14targetOfEvaluationId\x88\x01\x01\x12!\n" +
+	"\tcompliant\x18\x05 \x01(\bB\x03\xe0A\x02R\tcompliant\x12I\n" +
+	"\ametrics\x18\x06 \x03(\v2*.confirmate.assessment.v1.MetricComplianceB\x03\xe0A\x02R\ametricsB\x1a\n" +
+	"\x18_target_of_evaluation_id\"\xf3\x01\n" +
+	"\x10MetricCompliance\x12 \n" +
+	"\tmetric_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bmetricId\x12!\n" +
+	"\tcompliant\x18\x02 \x01(\bB\x03\xe0A\x02R\tcompliant\x12,\n" +
+	"\x0fcompliant_count\x18\x03 \x01(\x05B\x03\xe0A\x02R\x0ecompliantCount\x123\n" +
+	"\x13non_compliant_count\x18\x04 \x01(\x05B\x03\xe0A\x02R\x11nonCompliantCount\x127\n" +
+	"\x15assessment_result_ids\x18\x05 \x03(\tB\x03\xe0A\x02R\x13assessmentResultIds\"]\n" +
 	"\x15AssessEvidenceRequest\x12D\n" +
 	"\bevidence\x18\x01 \x01(\v2 .confirmate.evidence.v1.EvidenceB\x06\xbaH\x03\xc8\x01\x01R\bevidence\"\\\n" +
 	"\x16AssessEvidenceResponse\x12B\n" +
@@ -723,10 +943,10 @@ const file_api_assessment_assessment_proto_rawDesc = "" +
 	"\x1dASSESSMENT_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
 	"%ASSESSMENT_STATUS_WAITING_FOR_RELATED\x10\x01\x12\x1e\n" +
 	"\x1aASSESSMENT_STATUS_ASSESSED\x10\x02\x12\x1c\n" +
-	"\x18ASSESSMENT_STATUS_FAILED\x10\x032\x98\x03\n" +
+	"\x18ASSESSMENT_STATUS_FAILED\x10\x032\xb8\x03\n" +
 	"\n" +
-	"Assessment\x12e\n" +
-	"\x13CalculateCompliance\x124.confirmate.assessment.v1.CalculateComplianceRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x9f\x01\n" +
+	"Assessment\x12\x84\x01\n" +
+	"\x13CalculateCompliance\x124.confirmate.assessment.v1.CalculateComplianceRequest\x1a5.confirmate.assessment.v1.CalculateComplianceResponse\"\x00\x12\x9f\x01\n" +
 	"\x0eAssessEvidence\x12/.confirmate.assessment.v1.AssessEvidenceRequest\x1a0.confirmate.assessment.v1.AssessEvidenceResponse\"*\x82\xd3\xe4\x93\x02$:\bevidence\"\x18/v1/assessment/evidences\x12\x80\x01\n" +
 	"\x14AssessEvidenceStream\x12/.confirmate.assessment.v1.AssessEvidenceRequest\x1a1.confirmate.assessment.v1.AssessEvidencesResponse\"\x00(\x010\x01B*Z(clouditor.io/clouditor/v2/api/assessmentb\x06proto3"
 
@@ -743,47 +963,49 @@ func file_api_assessment_assessment_proto_rawDescGZIP() []byte {
 }
 
 var file_api_assessment_assessment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_assessment_assessment_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_assessment_assessment_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_assessment_assessment_proto_goTypes = []any{
 	(AssessmentStatus)(0),               // 0: confirmate.assessment.v1.AssessmentStatus
 	(*ConfigureAssessmentRequest)(nil),  // 1: confirmate.assessment.v1.ConfigureAssessmentRequest
 	(*ConfigureAssessmentResponse)(nil), // 2: confirmate.assessment.v1.ConfigureAssessmentResponse
 	(*CalculateComplianceRequest)(nil),  // 3: confirmate.assessment.v1.CalculateComplianceRequest
-	(*AssessEvidenceRequest)(nil),       // 4: confirmate.assessment.v1.AssessEvidenceRequest
-	(*AssessEvidenceResponse)(nil),      // 5: confirmate.assessment.v1.AssessEvidenceResponse
-	(*AssessEvidencesResponse)(nil),     // 6: confirmate.assessment.v1.AssessEvidencesResponse
-	(*AssessmentResult)(nil),            // 7: confirmate.assessment.v1.AssessmentResult
-	(*Record)(nil),                      // 8: confirmate.assessment.v1.Record
-	(*ComparisonResult)(nil),            // 9: confirmate.assessment.v1.ComparisonResult
-	(*evidence.Evidence)(nil),           // 10: confirmate.evidence.v1.Evidence
-	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
-	(*MetricConfiguration)(nil),         // 12: confirmate.assessment.v1.MetricConfiguration
-	(*structpb.Value)(nil),              // 13: google.protobuf.Value
-	(*emptypb.Empty)(nil),               // 14: google.protobuf.Empty
+	(*CalculateComplianceResponse)(nil), // 4: confirmate.assessment.v1.CalculateComplianceResponse
+	(*MetricCompliance)(nil),            // 5: confirmate.assessment.v1.MetricCompliance
+	(*AssessEvidenceRequest)(nil),       // 6: confirmate.assessment.v1.AssessEvidenceRequest
+	(*AssessEvidenceResponse)(nil),      // 7: confirmate.assessment.v1.AssessEvidenceResponse
+	(*AssessEvidencesResponse)(nil),     // 8: confirmate.assessment.v1.AssessEvidencesResponse
+	(*AssessmentResult)(nil),            // 9: confirmate.assessment.v1.AssessmentResult
+	(*Record)(nil),                      // 10: confirmate.assessment.v1.Record
+	(*ComparisonResult)(nil),            // 11: confirmate.assessment.v1.ComparisonResult
+	(*evidence.Evidence)(nil),           // 12: confirmate.evidence.v1.Evidence
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
+	(*MetricConfiguration)(nil),         // 14: confirmate.assessment.v1.MetricConfiguration
+	(*structpb.Value)(nil),              // 15: google.protobuf.Value
 }
 var file_api_assessment_assessment_proto_depIdxs = []int32{
-	10, // 0: confirmate.assessment.v1.AssessEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
-	0,  // 1: confirmate.assessment.v1.AssessEvidenceResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
-	0,  // 2: confirmate.assessment.v1.AssessEvidencesResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
-	11, // 3: confirmate.assessment.v1.AssessmentResult.created_at:type_name -> google.protobuf.Timestamp
-	12, // 4: confirmate.assessment.v1.AssessmentResult.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
-	9,  // 5: confirmate.assessment.v1.AssessmentResult.compliance_details:type_name -> confirmate.assessment.v1.ComparisonResult
-	11, // 6: confirmate.assessment.v1.AssessmentResult.history_updated_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: confirmate.assessment.v1.AssessmentResult.history:type_name -> confirmate.assessment.v1.Record
-	11, // 8: confirmate.assessment.v1.Record.evidence_recorded_at:type_name -> google.protobuf.Timestamp
-	13, // 9: confirmate.assessment.v1.ComparisonResult.value:type_name -> google.protobuf.Value
-	13, // 10: confirmate.assessment.v1.ComparisonResult.target_value:type_name -> google.protobuf.Value
-	3,  // 11: confirmate.assessment.v1.Assessment.CalculateCompliance:input_type -> confirmate.assessment.v1.CalculateComplianceRequest
-	4,  // 12: confirmate.assessment.v1.Assessment.AssessEvidence:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
-	4,  // 13: confirmate.assessment.v1.Assessment.AssessEvidenceStream:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
-	14, // 14: confirmate.assessment.v1.Assessment.CalculateCompliance:output_type -> google.protobuf.Empty
-	5,  // 15: confirmate.assessment.v1.Assessment.AssessEvidence:output_type -> confirmate.assessment.v1.AssessEvidenceResponse
-	6,  // 16: confirmate.assessment.v1.Assessment.AssessEvidenceStream:output_type -> confirmate.assessment.v1.AssessEvidencesResponse
-	14, // [14:17] is the sub-list for method output_type
-	11, // [11:14] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	5,  // 0: confirmate.assessment.v1.CalculateComplianceResponse.metrics:type_name -> confirmate.assessment.v1.MetricCompliance
+	12, // 1: confirmate.assessment.v1.AssessEvidenceRequest.evidence:type_name -> confirmate.evidence.v1.Evidence
+	0,  // 2: confirmate.assessment.v1.AssessEvidenceResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
+	0,  // 3: confirmate.assessment.v1.AssessEvidencesResponse.status:type_name -> confirmate.assessment.v1.AssessmentStatus
+	13, // 4: confirmate.assessment.v1.AssessmentResult.created_at:type_name -> google.protobuf.Timestamp
+	14, // 5: confirmate.assessment.v1.AssessmentResult.metric_configuration:type_name -> confirmate.assessment.v1.MetricConfiguration
+	11, // 6: confirmate.assessment.v1.AssessmentResult.compliance_details:type_name -> confirmate.assessment.v1.ComparisonResult
+	13, // 7: confirmate.assessment.v1.AssessmentResult.history_updated_at:type_name -> google.protobuf.Timestamp
+	10, // 8: confirmate.assessment.v1.AssessmentResult.history:type_name -> confirmate.assessment.v1.Record
+	13, // 9: confirmate.assessment.v1.Record.evidence_recorded_at:type_name -> google.protobuf.Timestamp
+	15, // 10: confirmate.assessment.v1.ComparisonResult.value:type_name -> google.protobuf.Value
+	15, // 11: confirmate.assessment.v1.ComparisonResult.target_value:type_name -> google.protobuf.Value
+	3,  // 12: confirmate.assessment.v1.Assessment.CalculateCompliance:input_type -> confirmate.assessment.v1.CalculateComplianceRequest
+	6,  // 13: confirmate.assessment.v1.Assessment.AssessEvidence:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
+	6,  // 14: confirmate.assessment.v1.Assessment.AssessEvidenceStream:input_type -> confirmate.assessment.v1.AssessEvidenceRequest
+	4,  // 15: confirmate.assessment.v1.Assessment.CalculateCompliance:output_type -> confirmate.assessment.v1.CalculateComplianceResponse
+	7,  // 16: confirmate.assessment.v1.Assessment.AssessEvidence:output_type -> confirmate.assessment.v1.AssessEvidenceResponse
+	8,  // 17: confirmate.assessment.v1.Assessment.AssessEvidenceStream:output_type -> confirmate.assessment.v1.AssessEvidencesResponse
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_assessment_assessment_proto_init() }
@@ -792,14 +1014,16 @@ func file_api_assessment_assessment_proto_init() {
 		return
 	}
 	file_api_assessment_metric_proto_init()
-	file_api_assessment_assessment_proto_msgTypes[6].OneofWrappers = []any{}
+	file_api_assessment_assessment_proto_msgTypes[2].OneofWrappers = []any{}
+	file_api_assessment_assessment_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_assessment_assessment_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_assessment_assessment_proto_rawDesc), len(file_api_assessment_assessment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,7 +36,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -57,9 +56,10 @@ const (
 // Representing the link between orchestrator and discovery: Assessing evidences
 // from discovery and sending results to orchestrator
 type AssessmentClient interface {
-	// Triggers the compliance calculation. Part of the private API. Not exposed
+	// Calculates the compliance of a single control based on the latest
+	// assessment results of its metrics. Part of the private API. Not exposed
 	// as REST.
-	CalculateCompliance(ctx context.Context, in *CalculateComplianceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CalculateCompliance(ctx context.Context, in *CalculateComplianceRequest, opts ...grpc.CallOption) (*CalculateComplianceResponse, error)
 	// Assesses the evidence sent by the discovery. Part of the public API, also
 	// exposed as REST.
 	AssessEvidence(ctx context.Context, in *AssessEvidenceRequest, opts ...grpc.CallOption) (*AssessEvidenceResponse, error)
@@ -76,9 +76,9 @@ func NewAssessmentClient(cc grpc.ClientConnInterface) AssessmentClient {
 	return &assessmentClient{cc}
 }
 
-func (c *assessmentClient) CalculateCompliance(ctx context.Context, in *CalculateComplianceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *assessmentClient) CalculateCompliance(ctx context.Context, in *CalculateComplianceRequest, opts ...grpc.CallOption) (*CalculateComplianceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(CalculateComplianceResponse)
 	err := c.cc.Invoke(ctx, Assessment_CalculateCompliance_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -116,9 +116,10 @@ type Assessment_AssessEvidenceStreamClient = grpc.BidiStreamingClient[AssessEvid
 // Representing the link between orchestrator and discovery: Assessing evidences
 // from discovery and sending results to orchestrator
 type AssessmentServer interface {
-	// Triggers the compliance calculation. Part of the private API. Not exposed
+	// Calculates the compliance of a single control based on the latest
+	// assessment results of its metrics. Part of the private API. Not exposed
 	// as REST.
-	CalculateCompliance(context.Context, *CalculateComplianceRequest) (*emptypb.Empty, error)
+	CalculateCompliance(context.Context, *CalculateComplianceRequest) (*CalculateComplianceResponse, error)
 	// Assesses the evidence sent by the discovery. Part of the public API, also
 	// exposed as REST.
 	AssessEvidence(context.Context, *AssessEvidenceRequest) (*AssessEvidenceResponse, error)
@@ -135,7 +136,7 @@ type AssessmentServer interface {
 // pointer dereference when methods are called.
 type UnimplementedAssessmentServer struct{}
 
-func (UnimplementedAssessmentServer) CalculateCompliance(context.Context, *CalculateComplianceRequest) (*emptypb.Empty, error) {
+func (UnimplementedAssessmentServer) CalculateCompliance(context.Context, *CalculateComplianceRequest) (*CalculateComplianceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CalculateCompliance not implemented")
 }
 func (UnimplementedAssessmentServer) AssessEvidence(context.Context, *AssessEvidenceRequest) (*AssessEvidenceResponse, error) {
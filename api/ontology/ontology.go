@@ -168,6 +168,18 @@ func ResourceIDs(r []IsResource) []string {
 	return a
 }
 
+// SetLabel sets the label key of the resource to value, if the resource has labels.
+func SetLabel(r IsResource, key string, value string) {
+	m := r.ProtoReflect()
+
+	fd := m.Descriptor().Fields().ByName("labels")
+	if fd == nil || !fd.IsMap() {
+		return
+	}
+
+	m.Mutable(fd).Map().Set(protoreflect.ValueOfString(key).MapKey(), protoreflect.ValueOfString(value))
+}
+
 // ProtoResource converts a [IsResource] to a [Resource]
 func ProtoResource(resource IsResource) *Resource {
 	var (
@@ -196,6 +208,32 @@ func ProtoResource(resource IsResource) *Resource {
 	return &r
 }
 
+// UnwrapResource returns the [IsResource] contained in a [Resource]. It is the counterpart of [ProtoResource]. Nil is
+// returned, if no resource type is set.
+func UnwrapResource(r *Resource) IsResource {
+	var (
+		m     protoreflect.Message
+		field protoreflect.FieldDescriptor
+	)
+
+	if r == nil {
+		return nil
+	}
+
+	m = r.ProtoReflect()
+	field = m.WhichOneof(m.Descriptor().Oneofs().ByName("type"))
+	if field == nil {
+		return nil
+	}
+
+	resource, ok := m.Get(field).Message().Interface().(IsResource)
+	if !ok {
+		return nil
+	}
+
+	return resource
+}
+
 // MarshalJSON is a custom JSON marshaller for the [Resource] type that delegates JSON marshalling to the [protojson]
 // package.
 func (x *Resource) MarshalJSON() (b []byte, err error) {
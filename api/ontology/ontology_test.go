@@ -194,6 +194,45 @@ func TestListResourceIDs(t *testing.T) {
 	}
 }
 
+func TestSetLabel(t *testing.T) {
+	type args struct {
+		r     IsResource
+		key   string
+		value string
+	}
+	tests := []struct {
+		name string
+		args args
+		want IsResource
+	}{
+		{
+			name: "no labels",
+			args: args{
+				r:     &VirtualMachine{Id: "vm-1"},
+				key:   "key",
+				value: "value",
+			},
+			want: &VirtualMachine{Id: "vm-1", Labels: map[string]string{"key": "value"}},
+		},
+		{
+			name: "existing labels",
+			args: args{
+				r:     &VirtualMachine{Id: "vm-1", Labels: map[string]string{"key": "old", "other": "label"}},
+				key:   "key",
+				value: "value",
+			},
+			want: &VirtualMachine{Id: "vm-1", Labels: map[string]string{"key": "value", "other": "label"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLabel(tt.args.r, tt.args.key, tt.args.value)
+			assert.Equal(t, tt.want, tt.args.r)
+		})
+	}
+}
+
 func TestProtoResource(t *testing.T) {
 	type args struct {
 		resource IsResource
@@ -234,6 +273,53 @@ func TestProtoResource(t *testing.T) {
 	}
 }
 
+func TestUnwrapResource(t *testing.T) {
+	type args struct {
+		r *Resource
+	}
+	tests := []struct {
+		name string
+		args args
+		want IsResource
+	}{
+		{
+			name: "happy path",
+			args: args{
+				r: &Resource{
+					Type: &Resource_VirtualMachine{
+						VirtualMachine: &VirtualMachine{
+							Id: "vm-1",
+						},
+					},
+				},
+			},
+			want: &VirtualMachine{
+				Id: "vm-1",
+			},
+		},
+		{
+			name: "no type",
+			args: args{
+				r: &Resource{},
+			},
+			want: nil,
+		},
+		{
+			name: "nil input",
+			args: args{
+				r: nil,
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UnwrapResource(tt.args.r))
+		})
+	}
+}
+
 func TestListResourceTypes(t *testing.T) {
 	tests := []struct {
 		name string
@@ -27,6 +27,8 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
 
 	"clouditor.io/clouditor/v2/api/assessment"
@@ -184,3 +186,57 @@ func (c *Control) IsRelevantFor(auditScope *AuditScope, catalog *Catalog) bool {
 
 	return idxControl <= idxToe
 }
+
+// ControlGetterFunc retrieves the control with the given ID from the given catalog and category.
+type ControlGetterFunc func(catalogId, categoryName, controlId string) (*Control, error)
+
+// AllMetricsFromControl returns all metrics from a given controlId, which is retrieved using getControl.
+//
+// For now a control has either sub-controls or metrics. If the control has sub-controls, get also all metrics from the
+// sub-controls.
+func AllMetricsFromControl(getControl ControlGetterFunc, catalogId, categoryName, controlId string) (metrics []*assessment.Metric, err error) {
+	var subControlMetrics []*assessment.Metric
+
+	control, err := getControl(catalogId, categoryName, controlId)
+	if err != nil {
+		err = fmt.Errorf("could not get control for control id {%s}: %w", controlId, err)
+		return
+	}
+
+	// Add metric of control to the metrics list
+	metrics = append(metrics, control.Metrics...)
+
+	// Add sub-control metrics to the metric list if exist
+	if len(control.Controls) != 0 {
+		// Get the metrics from the next sub-control
+		subControlMetrics, err = MetricsFromSubcontrols(getControl, control)
+		if err != nil {
+			err = fmt.Errorf("error getting metrics from sub-controls: %w", err)
+			return
+		}
+
+		metrics = append(metrics, subControlMetrics...)
+	}
+
+	return
+}
+
+// MetricsFromSubcontrols returns a list of metrics from the sub-controls, which are retrieved using getControl.
+func MetricsFromSubcontrols(getControl ControlGetterFunc, control *Control) (metrics []*assessment.Metric, err error) {
+	var subcontrol *Control
+
+	if control == nil {
+		return nil, errors.New("control is missing")
+	}
+
+	for _, control := range control.Controls {
+		subcontrol, err = getControl(control.CategoryCatalogId, control.CategoryName, control.Id)
+		if err != nil {
+			return
+		}
+
+		metrics = append(metrics, subcontrol.Metrics...)
+	}
+
+	return
+}
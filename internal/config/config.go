@@ -71,6 +71,21 @@ const (
 	DiscoveryProviderFlag                    = "discovery-provider"
 	DiscoveryResourceGroupFlag               = "discovery-resource-group"
 	DiscoveryCSAFDomainFlag                  = "discovery-csaf-domain"
+	DiscoveryAWSAccountsFlag                 = "discovery-aws-accounts"
+	DiscoveryAWSOrganizationFlag             = "discovery-aws-organization"
+	DiscoveryAWSRoleNameFlag                 = "discovery-aws-role-name"
+	DiscoveryAWSRegionsFlag                  = "discovery-aws-regions"
+	DiscoveryAzureSubscriptionsFlag          = "discovery-azure-subscriptions"
+	DiscoveryAzureManagementGroupFlag        = "discovery-azure-management-group"
+	DiscoveryK8sContextsFlag                 = "discovery-k8s-contexts"
+	DiscoveryK8sNamespacesFlag               = "discovery-k8s-namespaces"
+	DiscoveryK8sExcludedNamespacesFlag       = "discovery-k8s-excluded-namespaces"
+	DiscoveryK8sManifestsPathFlag            = "discovery-k8s-manifests-path"
+	DiscoveryGCPProjectsFlag                 = "discovery-gcp-projects"
+	DiscoveryFilePathFlag                    = "discovery-file-path"
+	DiscoveryTerraformPathsFlag              = "discovery-terraform-paths"
+	DiscoveryGitPathsFlag                    = "discovery-git-paths"
+	DiscoveryGitKeyRingFlag                  = "discovery-git-keyring"
 	DashboardCallbackURLFlag                 = "dashboard-callback-url"
 	LogLevelFlag                             = "log-level"
 	IgnoreDefaultMetricsFlag                 = "ignore-default-metrics"
@@ -109,6 +124,8 @@ const (
 	DefaultDiscoveryAutoStart                   = false
 	DefaultDiscoveryResourceGroup               = ""
 	DefaultCSAFDomain                           = ""
+	DefaultDiscoveryAWSOrganization             = false
+	DefaultDiscoveryAWSRoleName                 = "OrganizationAccountAccessRole"
 	DefaultDashboardCallbackURL                 = "http://localhost:8080/callback"
 	DefaultLogLevel                             = "info"
 	DefaultIgnoreDefaultMetrics                 = false
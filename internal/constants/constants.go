@@ -33,6 +33,7 @@ const (
 	AES_128_GCM = "AES-128-GCM"
 	AES_256_GCM = "AES-256-GCM"
 
+	SHA_1   = "SHA-1"
 	SHA_256 = "SHA-256"
 	SHA_384 = "SHA-384"
 	SHA_512 = "SHA-512"
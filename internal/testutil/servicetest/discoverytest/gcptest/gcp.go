@@ -0,0 +1,111 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package gcptest contains a fake GCP API endpoint and canned responses of the GCP APIs used by the GCP discoverer.
+package gcptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/option"
+)
+
+const (
+	// MockProjectID is the ID of the project that is returned by the fake endpoint.
+	MockProjectID = "clouditor-test"
+	// MockProjectNumber is the number of the project that is returned by the fake endpoint.
+	MockProjectNumber = "123456789012"
+)
+
+// Server is a fake GCP API endpoint. All GCP services can share a single endpoint, since the paths of the services do
+// not overlap once the base path of a service (e.g., https://compute.googleapis.com/compute/v1/) is replaced by the
+// endpoint.
+type Server struct {
+	*httptest.Server
+
+	// Mux is the multiplexer the handlers of the canned responses are registered at. Requests to paths without a
+	// handler are answered with 404 Not Found.
+	Mux *http.ServeMux
+}
+
+// NewServer starts a new fake GCP API endpoint, which is closed when the test finishes.
+func NewServer(t *testing.T) *Server {
+	mux := http.NewServeMux()
+	srv := &Server{
+		Server: httptest.NewServer(mux),
+		Mux:    mux,
+	}
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// ClientOptions returns the client options that direct the GCP API clients to the fake endpoint without any
+// authentication.
+func (s *Server) ClientOptions() []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint(s.URL + "/"),
+		option.WithoutAuthentication(),
+	}
+}
+
+// HandleAllSuccessfully registers the handlers of all canned responses.
+func (s *Server) HandleAllSuccessfully() {
+	s.HandleProjectListSuccessfully()
+	s.HandleProjectGetSuccessfully()
+	s.HandleProjectIamPolicySuccessfully()
+	s.HandleInstanceAggregatedListSuccessfully()
+	s.HandleDiskAggregatedListSuccessfully()
+	s.HandleFirewallListSuccessfully()
+	s.HandleBucketListSuccessfully()
+	s.HandleSQLInstanceListSuccessfully()
+}
+
+// handle registers a handler for the pattern (including the HTTP method) that responds with the body.
+func (s *Server) handle(pattern string, body string) {
+	s.Mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+// HandleError registers a handler for the pattern that responds with a GCP API error.
+func (s *Server) HandleError(pattern string, code int, message string) {
+	s.Mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error": map[string]any{
+				"code":    code,
+				"message": message,
+			},
+		})
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcptest
+
+// InstanceAggregatedListBody contains the canned body of an instances.aggregatedList response of the Compute Engine
+// API. The instance "web-1" has an external IP address and serial port logging enabled, the instance "worker-1" is only
+// reachable internally. Zones without instances only contain a warning.
+const InstanceAggregatedListBody = `
+{
+	"kind": "compute#instanceAggregatedList",
+	"id": "projects/clouditor-test/aggregated/instances",
+	"items": {
+		"zones/us-central1-a": {
+			"warning": {
+				"code": "NO_RESULTS_ON_PAGE",
+				"message": "There are no results for scope 'zones/us-central1-a' on this page."
+			}
+		},
+		"zones/europe-west3-b": {
+			"instances": [
+				{
+					"kind": "compute#instance",
+					"id": "4567890123456789012",
+					"creationTimestamp": "2024-03-01T10:00:00.000-08:00",
+					"name": "worker-1",
+					"machineType": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b/machineTypes/e2-small",
+					"status": "RUNNING",
+					"zone": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b",
+					"networkInterfaces": [
+						{
+							"network": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/networks/default",
+							"networkIP": "10.156.0.3",
+							"name": "nic0"
+						}
+					],
+					"disks": [
+						{
+							"type": "PERSISTENT",
+							"mode": "READ_WRITE",
+							"source": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b/disks/worker-1",
+							"boot": true
+						}
+					],
+					"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b/instances/worker-1"
+				}
+			]
+		},
+		"zones/europe-west3-a": {
+			"instances": [
+				{
+					"kind": "compute#instance",
+					"id": "1234567890123456789",
+					"creationTimestamp": "2024-02-01T10:00:00.000-08:00",
+					"name": "web-1",
+					"description": "Web server",
+					"machineType": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/machineTypes/e2-medium",
+					"status": "RUNNING",
+					"zone": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a",
+					"labels": {
+						"env": "prod"
+					},
+					"networkInterfaces": [
+						{
+							"network": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/networks/default",
+							"networkIP": "10.156.0.2",
+							"name": "nic0",
+							"accessConfigs": [
+								{
+									"type": "ONE_TO_ONE_NAT",
+									"name": "External NAT",
+									"natIP": "34.89.1.1",
+									"networkTier": "PREMIUM"
+								}
+							]
+						}
+					],
+					"disks": [
+						{
+							"type": "PERSISTENT",
+							"mode": "READ_WRITE",
+							"source": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/web-1",
+							"boot": true
+						},
+						{
+							"type": "PERSISTENT",
+							"mode": "READ_WRITE",
+							"source": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/data-1"
+						}
+					],
+					"metadata": {
+						"items": [
+							{
+								"key": "serial-port-logging-enable",
+								"value": "true"
+							}
+						]
+					},
+					"shieldedInstanceConfig": {
+						"enableSecureBoot": true,
+						"enableVtpm": true,
+						"enableIntegrityMonitoring": true
+					},
+					"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/instances/web-1"
+				}
+			]
+		}
+	}
+}
+`
+
+// DiskAggregatedListBody contains the canned body of a disks.aggregatedList response of the Compute Engine API. The
+// disk "data-1" is encrypted with a customer-managed key, the other disks with a Google-managed key.
+const DiskAggregatedListBody = `
+{
+	"kind": "compute#diskAggregatedList",
+	"id": "projects/clouditor-test/aggregated/disks",
+	"items": {
+		"zones/europe-west3-a": {
+			"disks": [
+				{
+					"kind": "compute#disk",
+					"id": "2345678901234567890",
+					"creationTimestamp": "2024-02-01T10:00:00.000-08:00",
+					"name": "web-1",
+					"sizeGb": "10",
+					"zone": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a",
+					"status": "READY",
+					"type": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/diskTypes/pd-balanced",
+					"users": [
+						"https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/instances/web-1"
+					],
+					"labels": {
+						"env": "prod"
+					},
+					"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/web-1"
+				},
+				{
+					"kind": "compute#disk",
+					"id": "3456789012345678901",
+					"creationTimestamp": "2024-02-02T10:00:00.000-08:00",
+					"name": "data-1",
+					"sizeGb": "100",
+					"zone": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a",
+					"status": "READY",
+					"type": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/diskTypes/pd-ssd",
+					"diskEncryptionKey": {
+						"kmsKeyName": "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/disk-key/cryptoKeyVersions/1"
+					},
+					"users": [
+						"https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/instances/web-1"
+					],
+					"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/data-1"
+				}
+			]
+		},
+		"zones/europe-west3-b": {
+			"disks": [
+				{
+					"kind": "compute#disk",
+					"id": "4567890123456789013",
+					"creationTimestamp": "2024-03-01T10:00:00.000-08:00",
+					"name": "worker-1",
+					"sizeGb": "10",
+					"zone": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b",
+					"status": "READY",
+					"type": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b/diskTypes/pd-balanced",
+					"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-b/disks/worker-1"
+				}
+			]
+		}
+	}
+}
+`
+
+// FirewallListBody contains the canned body of a firewalls.list response of the Compute Engine API. Only the rule
+// "default-allow-ssh" allows inbound traffic from the internet, since the rule "legacy-allow-rdp" is disabled.
+const FirewallListBody = `
+{
+	"kind": "compute#firewallList",
+	"id": "projects/clouditor-test/global/firewalls",
+	"items": [
+		{
+			"kind": "compute#firewall",
+			"id": "5678901234567890123",
+			"creationTimestamp": "2024-01-15T09:31:00.000-08:00",
+			"name": "default-allow-ssh",
+			"description": "Allow SSH from anywhere",
+			"network": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/networks/default",
+			"priority": 65534,
+			"sourceRanges": [
+				"0.0.0.0/0"
+			],
+			"allowed": [
+				{
+					"IPProtocol": "tcp",
+					"ports": [
+						"22"
+					]
+				}
+			],
+			"direction": "INGRESS",
+			"disabled": false,
+			"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/firewalls/default-allow-ssh"
+		},
+		{
+			"kind": "compute#firewall",
+			"id": "6789012345678901234",
+			"creationTimestamp": "2024-01-15T09:31:00.000-08:00",
+			"name": "default-allow-internal",
+			"description": "Allow internal traffic on the default network",
+			"network": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/networks/default",
+			"priority": 65534,
+			"sourceRanges": [
+				"10.128.0.0/9"
+			],
+			"allowed": [
+				{
+					"IPProtocol": "tcp",
+					"ports": [
+						"0-65535"
+					]
+				}
+			],
+			"direction": "INGRESS",
+			"disabled": false,
+			"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/firewalls/default-allow-internal"
+		},
+		{
+			"kind": "compute#firewall",
+			"id": "7890123456789012345",
+			"creationTimestamp": "2024-01-15T09:31:00.000-08:00",
+			"name": "legacy-allow-rdp",
+			"network": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/networks/default",
+			"priority": 1000,
+			"sourceRanges": [
+				"0.0.0.0/0"
+			],
+			"allowed": [
+				{
+					"IPProtocol": "tcp",
+					"ports": [
+						"3389"
+					]
+				}
+			],
+			"direction": "INGRESS",
+			"disabled": true,
+			"selfLink": "https://www.googleapis.com/compute/v1/projects/clouditor-test/global/firewalls/legacy-allow-rdp"
+		}
+	]
+}
+`
+
+// HandleInstanceAggregatedListSuccessfully registers a handler that responds with an instances.aggregatedList
+// response.
+func (s *Server) HandleInstanceAggregatedListSuccessfully() {
+	s.handle("GET /projects/{project}/aggregated/instances", InstanceAggregatedListBody)
+}
+
+// HandleDiskAggregatedListSuccessfully registers a handler that responds with a disks.aggregatedList response.
+func (s *Server) HandleDiskAggregatedListSuccessfully() {
+	s.handle("GET /projects/{project}/aggregated/disks", DiskAggregatedListBody)
+}
+
+// HandleFirewallListSuccessfully registers a handler that responds with a firewalls.list response.
+func (s *Server) HandleFirewallListSuccessfully() {
+	s.handle("GET /projects/{project}/global/firewalls", FirewallListBody)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcptest
+
+// ProjectBody contains the canned body of a projects.get response of the Resource Manager API.
+const ProjectBody = `
+{
+	"projectNumber": "123456789012",
+	"projectId": "clouditor-test",
+	"lifecycleState": "ACTIVE",
+	"name": "Clouditor Test",
+	"createTime": "2024-01-15T09:30:00.000Z",
+	"labels": {
+		"env": "test"
+	},
+	"parent": {
+		"type": "organization",
+		"id": "987654321098"
+	}
+}
+`
+
+// ProjectListBody contains the canned body of a projects.list response of the Resource Manager API.
+const ProjectListBody = `
+{
+	"projects": [` + ProjectBody + `]
+}
+`
+
+// ProjectIamPolicyBody contains the canned body of a projects.getIamPolicy response of the Resource Manager API. The
+// project has an owner, two viewers and a group with a conditional storage admin binding.
+const ProjectIamPolicyBody = `
+{
+	"version": 3,
+	"etag": "BwWWja0YfJA=",
+	"bindings": [
+		{
+			"role": "roles/owner",
+			"members": [
+				"user:alice@example.com"
+			]
+		},
+		{
+			"role": "roles/viewer",
+			"members": [
+				"user:bob@example.com",
+				"serviceAccount:web@clouditor-test.iam.gserviceaccount.com"
+			]
+		},
+		{
+			"role": "roles/storage.admin",
+			"members": [
+				"group:admins@example.com",
+				"deleted:user:carol@example.com?uid=123456789012345678901"
+			],
+			"condition": {
+				"title": "expires_2030",
+				"expression": "request.time < timestamp(\"2030-01-01T00:00:00Z\")"
+			}
+		}
+	]
+}
+`
+
+// HandleProjectListSuccessfully registers a handler that responds with a projects.list response.
+func (s *Server) HandleProjectListSuccessfully() {
+	s.handle("GET /v1/projects", ProjectListBody)
+}
+
+// HandleProjectGetSuccessfully registers a handler that responds with a projects.get response.
+func (s *Server) HandleProjectGetSuccessfully() {
+	s.handle("GET /v1/projects/{projectId}", ProjectBody)
+}
+
+// HandleProjectIamPolicySuccessfully registers a handler that responds with a projects.getIamPolicy response. The
+// resource of the request is part of the last path segment, e.g., /v1/projects/clouditor-test:getIamPolicy.
+func (s *Server) HandleProjectIamPolicySuccessfully() {
+	s.handle("POST /v1/projects/{resource}", ProjectIamPolicyBody)
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcptest
+
+// SQLInstanceListBody contains the canned body of an instances.list response of the Cloud SQL Admin API. The instance
+// "orders-db" has a public IP address, enforces TLS, is highly available and encrypted with a customer-managed key. The
+// instance "reporting-db" is only reachable via a private IP address and has no automated backups.
+const SQLInstanceListBody = `
+{
+	"items": [
+		{
+			"kind": "sql#instance",
+			"state": "RUNNABLE",
+			"databaseVersion": "POSTGRES_15",
+			"settings": {
+				"tier": "db-custom-2-7680",
+				"availabilityType": "REGIONAL",
+				"userLabels": {
+					"env": "prod"
+				},
+				"ipConfiguration": {
+					"ipv4Enabled": true,
+					"sslMode": "ENCRYPTED_ONLY",
+					"authorizedNetworks": [
+						{
+							"value": "0.0.0.0/0",
+							"name": "anywhere"
+						}
+					]
+				},
+				"backupConfiguration": {
+					"enabled": true,
+					"startTime": "02:00",
+					"pointInTimeRecoveryEnabled": true,
+					"transactionLogRetentionDays": 7,
+					"backupRetentionSettings": {
+						"retentionUnit": "COUNT",
+						"retainedBackups": 14
+					}
+				}
+			},
+			"ipAddresses": [
+				{
+					"type": "PRIMARY",
+					"ipAddress": "34.159.1.2"
+				},
+				{
+					"type": "OUTGOING",
+					"ipAddress": "34.159.1.3"
+				}
+			],
+			"diskEncryptionConfiguration": {
+				"kmsKeyName": "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/sql-key"
+			},
+			"project": "clouditor-test",
+			"region": "europe-west3",
+			"gceZone": "europe-west3-a",
+			"secondaryGceZone": "europe-west3-c",
+			"name": "orders-db",
+			"connectionName": "clouditor-test:europe-west3:orders-db",
+			"createTime": "2024-02-20T12:00:00.000Z",
+			"selfLink": "https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/orders-db"
+		},
+		{
+			"kind": "sql#instance",
+			"state": "RUNNABLE",
+			"databaseVersion": "MYSQL_8_0",
+			"settings": {
+				"tier": "db-f1-micro",
+				"availabilityType": "ZONAL",
+				"ipConfiguration": {
+					"ipv4Enabled": false,
+					"privateNetwork": "projects/clouditor-test/global/networks/default",
+					"sslMode": "ALLOW_UNENCRYPTED_AND_ENCRYPTED"
+				},
+				"backupConfiguration": {
+					"enabled": false
+				}
+			},
+			"ipAddresses": [
+				{
+					"type": "PRIVATE",
+					"ipAddress": "10.20.0.3"
+				}
+			],
+			"project": "clouditor-test",
+			"region": "europe-west3",
+			"gceZone": "europe-west3-b",
+			"name": "reporting-db",
+			"connectionName": "clouditor-test:europe-west3:reporting-db",
+			"createTime": "2024-02-21T12:00:00.000Z",
+			"selfLink": "https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/reporting-db"
+		}
+	]
+}
+`
+
+// HandleSQLInstanceListSuccessfully registers a handler that responds with an instances.list response.
+func (s *Server) HandleSQLInstanceListSuccessfully() {
+	s.handle("GET /v1/projects/{project}/instances", SQLInstanceListBody)
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcptest
+
+import (
+	"net/http"
+)
+
+// BucketListBody contains the canned body of a buckets.list response of the Cloud Storage API. The bucket
+// "clouditor-public" is versioned and replicated across a multi-region, the bucket "clouditor-private" is encrypted
+// with a customer-managed key and has a locked retention policy.
+const BucketListBody = `
+{
+	"kind": "storage#buckets",
+	"items": [
+		{
+			"kind": "storage#bucket",
+			"selfLink": "https://www.googleapis.com/storage/v1/b/clouditor-public",
+			"id": "clouditor-public",
+			"name": "clouditor-public",
+			"projectNumber": "123456789012",
+			"timeCreated": "2024-02-10T08:00:00.000Z",
+			"location": "EU",
+			"locationType": "multi-region",
+			"storageClass": "STANDARD",
+			"labels": {
+				"env": "prod"
+			},
+			"versioning": {
+				"enabled": true
+			},
+			"iamConfiguration": {
+				"uniformBucketLevelAccess": {
+					"enabled": true
+				},
+				"publicAccessPrevention": "inherited"
+			}
+		},
+		{
+			"kind": "storage#bucket",
+			"selfLink": "https://www.googleapis.com/storage/v1/b/clouditor-private",
+			"id": "clouditor-private",
+			"name": "clouditor-private",
+			"projectNumber": "123456789012",
+			"timeCreated": "2024-02-11T08:00:00.000Z",
+			"location": "EUROPE-WEST3",
+			"locationType": "region",
+			"storageClass": "STANDARD",
+			"encryption": {
+				"defaultKmsKeyName": "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/bucket-key"
+			},
+			"retentionPolicy": {
+				"retentionPeriod": "2592000",
+				"effectiveTime": "2024-02-11T08:00:00.000Z",
+				"isLocked": true
+			},
+			"iamConfiguration": {
+				"uniformBucketLevelAccess": {
+					"enabled": true
+				},
+				"publicAccessPrevention": "enforced"
+			}
+		}
+	]
+}
+`
+
+// PublicBucketIamPolicyBody contains the canned body of a buckets.getIamPolicy response that grants read access to
+// everyone.
+const PublicBucketIamPolicyBody = `
+{
+	"kind": "storage#policy",
+	"resourceId": "projects/_/buckets/clouditor-public",
+	"version": 1,
+	"etag": "CAE=",
+	"bindings": [
+		{
+			"role": "roles/storage.legacyBucketOwner",
+			"members": [
+				"projectOwner:clouditor-test"
+			]
+		},
+		{
+			"role": "roles/storage.objectViewer",
+			"members": [
+				"allUsers"
+			]
+		}
+	]
+}
+`
+
+// PrivateBucketIamPolicyBody contains the canned body of a buckets.getIamPolicy response that only grants access to
+// the project owners.
+const PrivateBucketIamPolicyBody = `
+{
+	"kind": "storage#policy",
+	"resourceId": "projects/_/buckets/clouditor-private",
+	"version": 1,
+	"etag": "CAE=",
+	"bindings": [
+		{
+			"role": "roles/storage.legacyBucketOwner",
+			"members": [
+				"projectOwner:clouditor-test"
+			]
+		}
+	]
+}
+`
+
+// HandleBucketListSuccessfully registers handlers that respond with a buckets.list response and the
+// buckets.getIamPolicy responses of the listed buckets.
+func (s *Server) HandleBucketListSuccessfully() {
+	s.handle("GET /b", BucketListBody)
+
+	s.Mux.HandleFunc("GET /b/{bucket}/iam", func(w http.ResponseWriter, r *http.Request) {
+		var body string
+
+		switch r.PathValue("bucket") {
+		case "clouditor-public":
+			body = PublicBucketIamPolicyBody
+		case "clouditor-private":
+			body = PrivateBucketIamPolicyBody
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstacktest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// The code in this file is based on the Gophercloud library.
+// Gophercloud is licensed under the Apache License 2.0. See the LICENSE file in the
+// Gophercloud repository for the full license: https://github.com/gophercloud/gophercloud
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/keymanager/v1/secrets/testing/fixtures_test.go (2026-10-17)
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/keymanager/v1/containers/testing/fixtures_test.go (2026-10-17)
+//
+// Changes:
+// - 2026-10-17: Change the secret type of "anothersecret" to symmetric and add an expiration date, change the type of "mycontainer" to certificate, rename ListResponse to ListSecretsResponse and ListContainersResponse and handle the requests also on the versioned paths
+
+// ListSecretsResponse provides a single page of secret results.
+const ListSecretsResponse = `
+{
+    "secrets": [
+        {
+            "algorithm": "aes",
+            "bit_length": 256,
+            "content_types": {
+                "default": "text/plain"
+            },
+            "created": "2018-06-21T02:49:48",
+            "creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+            "expiration": null,
+            "mode": "cbc",
+            "name": "mysecret",
+            "secret_ref": "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+            "secret_type": "opaque",
+            "status": "ACTIVE",
+            "updated": "2018-06-21T02:49:48"
+        },
+        {
+            "algorithm": "aes",
+            "bit_length": 256,
+            "content_types": {
+                "default": "application/octet-stream"
+            },
+            "created": "2018-06-21T05:18:45",
+            "creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+            "expiration": "2028-06-21T05:18:45",
+            "mode": "cbc",
+            "name": "anothersecret",
+            "secret_ref": "http://barbican:9311/v1/secrets/1b12b69a-8822-442e-a303-da24ade648ac",
+            "secret_type": "symmetric",
+            "status": "ACTIVE",
+            "updated": "2018-06-21T05:18:45"
+        }
+    ],
+    "total": 2
+}`
+
+// ListContainersResponse provides a single page of container results.
+const ListContainersResponse = `
+{
+  "containers": [
+    {
+      "consumers": [],
+      "container_ref": "http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0",
+      "created": "2018-06-21T21:28:37",
+      "creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+      "name": "mycontainer",
+      "secret_refs": [
+        {
+          "name": "certificate",
+          "secret_ref": "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c"
+        }
+      ],
+      "status": "ACTIVE",
+      "type": "certificate",
+      "updated": "2018-06-21T21:28:37"
+    },
+    {
+      "consumers": [],
+      "container_ref": "http://barbican:9311/v1/containers/47b20e73-335b-4867-82dc-3796524d5e20",
+      "created": "2018-06-21T21:30:09",
+      "creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+      "name": "anothercontainer",
+      "secret_refs": [
+        {
+          "name": "another",
+          "secret_ref": "http://barbican:9311/v1/secrets/1b12b69a-8822-442e-a303-da24ade648ac"
+        }
+      ],
+      "status": "ACTIVE",
+      "type": "generic",
+      "updated": "2018-06-21T21:30:09"
+    }
+  ],
+  "total": 2
+}`
+
+// HandleListSecretsSuccessfully creates an HTTP handler at `/secrets` on the test handler mux that responds with a
+// list of two secrets.
+func HandleListSecretsSuccessfully(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListSecretsResponse)
+	}
+
+	th.Mux.HandleFunc("/secrets", handler)
+	th.Mux.HandleFunc("/v1/secrets", handler)
+}
+
+// HandleListContainersSuccessfully creates an HTTP handler at `/containers` on the test handler mux that responds
+// with a list of two containers.
+func HandleListContainersSuccessfully(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListContainersResponse)
+	}
+
+	th.Mux.HandleFunc("/containers", handler)
+	th.Mux.HandleFunc("/v1/containers", handler)
+}
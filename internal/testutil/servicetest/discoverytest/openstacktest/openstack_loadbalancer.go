@@ -0,0 +1,201 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstacktest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// The code in this file is based on the Gophercloud library.
+// Gophercloud is licensed under the Apache License 2.0. See the LICENSE file in the
+// Gophercloud repository for the full license: https://github.com/gophercloud/gophercloud
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/loadbalancer/v2/loadbalancers/testing/fixtures_test.go (2026-10-17)
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/loadbalancer/v2/listeners/testing/fixtures_test.go (2026-10-17)
+//
+// Changes:
+// - 2026-10-17: Use a public VIP address for "web_lb", add a TLS terminating listener with TLS ciphers for "web_lb", assign the listeners to the load balancers of the list response and add functions HandleLoadbalancerListSuccessfully() and HandleListenerListSuccessfully()
+
+// LoadbalancersListBody contains the canned body of a loadbalancer list response.
+const LoadbalancersListBody = `
+{
+	"loadbalancers":[
+		{
+			"id": "c331058c-6a40-4144-948e-b9fb1df9db4b",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"created_at": "2019-06-30T04:15:37",
+			"updated_at": "2019-06-30T05:18:49",
+			"name": "web_lb",
+			"description": "lb config for the web tier",
+			"vip_subnet_id": "8a49c438-848f-467b-9655-ea1548708154",
+			"vip_address": "203.0.113.10",
+			"vip_port_id": "2a22e552-a347-44fd-b530-1f2b1b2a6735",
+			"flavor_id": "60df399a-ee85-11e9-81b4-2a2ae2dbcce4",
+			"provider": "haproxy",
+			"admin_state_up": true,
+			"provisioning_status": "ACTIVE",
+			"operating_status": "ONLINE",
+			"listeners": [{"id": "db902c0c-d5ff-4753-b465-668ad9656918"}, {"id": "4ec89087-d057-4e2c-911f-60a3b47ee304"}],
+			"tags": ["test", "stage"]
+		},
+		{
+			"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"created_at": "2019-06-30T04:15:37",
+			"updated_at": "2019-06-30T05:18:49",
+			"name": "db_lb",
+			"description": "lb config for the db tier",
+			"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+			"vip_address": "10.30.176.48",
+			"vip_port_id": "2bf413c8-41a9-4477-b505-333d5cbe8b55",
+			"flavor_id": "bba40eb2-ee8c-11e9-81b4-2a2ae2dbcce4",
+			"availability_zone": "db_az",
+			"provider": "haproxy",
+			"admin_state_up": true,
+			"provisioning_status": "PENDING_CREATE",
+			"operating_status": "OFFLINE",
+			"listeners": [{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"}],
+			"tags": ["test", "stage"],
+			"additional_vips": [{"subnet_id": "0d4f6a08-60b7-44ab-8903-f7d76ec54095", "ip_address" : "192.168.10.10"}]
+		}
+	]
+}
+`
+
+// ListenersListBody contains the canned body of a listeners list response.
+const ListenersListBody = `
+{
+	"listeners":[
+		{
+			"id": "db902c0c-d5ff-4753-b465-668ad9656918",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"name": "web",
+			"description": "listener config for the web tier",
+			"loadbalancers": [{"id": "c331058c-6a40-4144-948e-b9fb1df9db4b"}],
+			"protocol": "HTTP",
+			"protocol_port": 80,
+			"default_pool_id": "fad389a3-9a4a-4762-a365-8c7038508b5d",
+			"admin_state_up": true,
+			"allowed_cidrs": [
+				"192.0.2.0/24",
+				"198.51.100.0/24"
+			]
+		},
+		{
+			"id": "4ec89087-d057-4e2c-911f-60a3b47ee304",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"name": "web-tls",
+			"description": "TLS listener config for the web tier",
+			"loadbalancers": [{"id": "c331058c-6a40-4144-948e-b9fb1df9db4b"}],
+			"protocol": "TERMINATED_HTTPS",
+			"protocol_port": 443,
+			"default_pool_id": "fad389a3-9a4a-4762-a365-8c7038508b5d",
+			"admin_state_up": true,
+			"default_tls_container_ref": "http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0",
+			"tls_ciphers": "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-CHACHA20-POLY1305",
+			"tls_versions": ["TLSv1.2", "TLSv1.3"]
+		},
+		{
+			"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"name": "db",
+			"description": "listener config for the db tier",
+			"loadbalancers": [{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"}],
+			"protocol": "TCP",
+			"protocol_port": 3306,
+			"default_pool_id": "41efe233-7591-43c5-9cf7-923964759f9e",
+			"connection_limit": 2000,
+			"admin_state_up": true,
+			"timeout_client_data": 50000,
+			"timeout_member_data": 50000,
+			"timeout_member_connect": 5000,
+			"timeout_tcp_inspect": 0,
+			"insert_headers": {
+				"X-Forwarded-For": "true"
+			},
+			"allowed_cidrs": [
+				"192.0.2.0/24",
+				"198.51.100.0/24"
+			],
+			"tls_versions": ["TLSv1.2"]
+		}
+	]
+}
+`
+
+// HandleLoadbalancerListSuccessfully sets up the test server to respond to a loadbalancer List request.
+func HandleLoadbalancerListSuccessfully(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, LoadbalancersListBody)
+		case "45e08a3e-a78f-4b40-a229-1e7e23eee1ab":
+			fmt.Fprint(w, `{ "loadbalancers": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/loadbalancers invoked with unexpected marker=[%s]", marker)
+		}
+	}
+
+	th.Mux.HandleFunc("/lbaas/loadbalancers", handler)
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers", handler)
+}
+
+// HandleListenerListSuccessfully sets up the test server to respond to a listener List request.
+func HandleListenerListSuccessfully(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, ListenersListBody)
+		case "45e08a3e-a78f-4b40-a229-1e7e23eee1ab":
+			fmt.Fprint(w, `{ "listeners": [] }`)
+		default:
+			t.Fatalf("/v2.0/lbaas/listeners invoked with unexpected marker=[%s]", marker)
+		}
+	}
+
+	th.Mux.HandleFunc("/lbaas/listeners", handler)
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", handler)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstacktest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// The code in this file is based on the Gophercloud library.
+// Gophercloud is licensed under the Apache License 2.0. See the LICENSE file in the
+// Gophercloud repository for the full license: https://github.com/gophercloud/gophercloud
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/objectstorage/v1/containers/testing/fixtures.go (2026-10-17)
+//
+// Changes:
+// - 2026-10-17: Add function HandleContainerListSuccessfully() that combines the list and get handlers, only responds to the service root and returns a public read ACL for the container "marktwain"
+
+// ContainerListBody contains the canned body of a containers.List response.
+const ContainerListBody = `
+[
+	{
+		"count": 0,
+		"bytes": 0,
+		"name": "janeausten"
+	},
+	{
+		"count": 1,
+		"bytes": 14,
+		"name": "marktwain"
+	}
+]
+`
+
+// HandleContainerListSuccessfully creates HTTP handlers that respond with a containers.List response and the
+// containers.Get responses of the listed containers.
+func HandleContainerListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// The container list is returned by the service root, all other paths are not known
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+
+		switch r.Form.Get("marker") {
+		case "":
+			fmt.Fprint(w, ContainerListBody)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	th.Mux.HandleFunc("/janeausten", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("X-Container-Bytes-Used", "0")
+		w.Header().Set("X-Container-Object-Count", "0")
+		w.Header().Set("X-Container-Read", "test")
+		w.Header().Set("X-Timestamp", "1471298837.95721")
+		w.Header().Set("X-Storage-Policy", "test_policy")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/marktwain", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("X-Container-Bytes-Used", "14")
+		w.Header().Set("X-Container-Object-Count", "1")
+		w.Header().Set("X-Container-Read", ".r:*,.rlistings")
+		w.Header().Set("X-Timestamp", "1471298837.95721")
+		w.Header().Set("X-Storage-Policy", "test_policy")
+		w.Header().Set("X-Versions-Enabled", "True")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
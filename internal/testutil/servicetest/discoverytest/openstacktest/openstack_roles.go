@@ -0,0 +1,118 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstacktest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// The code in this file is based on the Gophercloud library.
+// Gophercloud is licensed under the Apache License 2.0. See the LICENSE file in the
+// Gophercloud repository for the full license: https://github.com/gophercloud/gophercloud
+// Source: https://github.com/gophercloud/gophercloud/blob/master/openstack/identity/v3/roles/testing/fixtures_test.go (2026-10-17)
+//
+// Changes:
+// - 2026-10-17: Combine ListAssignmentOutput and ListAssignmentWithNamesOutput, assign the admin role to user "9fe1d3" and add function HandleListRoleAssignmentsSuccessfully()
+
+// ListAssignmentOutput provides a result of ListAssignment request.
+const ListAssignmentOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/domains/161718/users/313233/roles/123456"
+            },
+            "role": {
+                "id": "123456",
+                "name": "member"
+            },
+            "scope": {
+                "domain": {
+                    "id": "161718",
+                    "name": "52833"
+                }
+            },
+            "user": {
+                "domain": {
+                    "id": "161718",
+                    "name": "52833"
+                },
+                "id": "313233",
+                "name": "example-user-name"
+            }
+        },
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/projects/456789/users/9fe1d3/roles/654321"
+            },
+            "role": {
+                "id": "654321",
+                "name": "admin"
+            },
+            "scope": {
+                "project": {
+                    "domain": {
+                        "id": "161718"
+                    },
+                    "id": "456789",
+                    "name": "project"
+                }
+            },
+            "user": {
+                "domain": {
+                    "id": "1789d1"
+                },
+                "id": "9fe1d3",
+                "name": "jsmith"
+            }
+        }
+    ],
+    "links": {
+        "self": "http://identity:35357/v3/role_assignments?effective&include_names=True",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// HandleListRoleAssignmentsSuccessfully creates an HTTP handler at `/role_assignments` on the test handler mux that
+// responds with a list of two role assignments.
+func HandleListRoleAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAssignmentOutput)
+	})
+}
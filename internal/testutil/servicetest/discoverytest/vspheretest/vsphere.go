@@ -0,0 +1,147 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package vspheretest contains a simulated vSphere environment, which is based on the vCenter simulator (vcsim) of
+// govmomi.
+package vspheretest
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	// HardenedVM is the name of the VM that is configured with secure boot, a vTPM and VM encryption by
+	// [Server.HardenVirtualMachine].
+	HardenedVM = "DC0_H0_VM0"
+	// MockKeyProvider is the ID of the key provider used to encrypt the hardened VM.
+	MockKeyProvider = "clouditor-kms"
+	// MockKeyID is the ID of the key used to encrypt the hardened VM.
+	MockKeyID = "e1b5c0d4-6a5e-4f4f-8e0c-2d7c0b0d1a11"
+)
+
+// Server is a simulated vCenter. Its inventory consists of the default vcsim VPX model, i.e., the datacenter "DC0"
+// containing a standalone host, a cluster with three hosts, a datastore, a standard port group "VM Network", a
+// distributed port group and two VMs per host or cluster.
+type Server struct {
+	*simulator.Server
+
+	model *simulator.Model
+}
+
+// NewServer starts a new simulated vCenter, which is stopped when the test finishes.
+func NewServer(t *testing.T) *Server {
+	model := simulator.VPX()
+
+	err := model.Create()
+	if err != nil {
+		t.Fatalf("could not create vcsim model: %v", err)
+	}
+
+	srv := &Server{
+		Server: model.Service.NewServer(),
+		model:  model,
+	}
+
+	t.Cleanup(func() {
+		srv.Close()
+		model.Remove()
+	})
+
+	return srv
+}
+
+// URL returns the URL of the simulated vCenter including valid credentials.
+func (s *Server) URL() *url.URL {
+	u := *s.Server.URL
+
+	return &u
+}
+
+// HardenVirtualMachine enables UEFI secure boot, adds a vTPM and encrypts the VM [HardenedVM].
+func (s *Server) HardenVirtualMachine(t *testing.T) {
+	ctx := context.Background()
+
+	c, err := govmomi.NewClient(ctx, s.URL(), true)
+	if err != nil {
+		t.Fatalf("could not connect to vcsim: %v", err)
+	}
+	defer func() { _ = c.Logout(ctx) }()
+
+	vm, err := find.NewFinder(c.Client, true).VirtualMachine(ctx, "/DC0/vm/"+HardenedVM)
+	if err != nil {
+		t.Fatalf("could not find VM: %v", err)
+	}
+
+	// The VM needs to be powered off to be encrypted
+	reconfigure(t, ctx, vm.PowerOff)
+
+	// Secure boot requires EFI firmware, which needs to be configured first
+	reconfigure(t, ctx, func(ctx context.Context) (*object.Task, error) {
+		return vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+			Firmware: string(types.GuestOsDescriptorFirmwareTypeEfi),
+		})
+	})
+
+	reconfigure(t, ctx, func(ctx context.Context) (*object.Task, error) {
+		return vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+			BootOptions: &types.VirtualMachineBootOptions{
+				EfiSecureBootEnabled: types.NewBool(true),
+			},
+			DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+				&types.VirtualDeviceConfigSpec{
+					Operation: types.VirtualDeviceConfigSpecOperationAdd,
+					Device:    &types.VirtualTPM{},
+				},
+			},
+			Crypto: &types.CryptoSpecEncrypt{
+				CryptoKeyId: types.CryptoKeyId{
+					KeyId:      MockKeyID,
+					ProviderId: &types.KeyProviderId{Id: MockKeyProvider},
+				},
+			},
+		})
+	})
+}
+
+// reconfigure runs the task and waits until it is finished
+func reconfigure(t *testing.T, ctx context.Context, f func(ctx context.Context) (*object.Task, error)) {
+	task, err := f(ctx)
+	if err != nil {
+		t.Fatalf("could not start task: %v", err)
+	}
+
+	err = task.Wait(ctx)
+	if err != nil {
+		t.Fatalf("task failed: %v", err)
+	}
+}
@@ -26,6 +26,7 @@
 package launcher
 
 import (
+	"path/filepath"
 	"testing"
 
 	"clouditor.io/clouditor/v2/internal/config"
@@ -218,7 +219,7 @@ func TestLauncher_Launch(t *testing.T) {
 			name: "Happy path: with embedded OAuth 2.0 server",
 			prepViper: func() {
 				viper.Set(config.EmbeddedOAuth2ServerEnabledFlag, true)
-				viper.Set(config.APIKeyPathFlag, "keyPath")
+				viper.Set(config.APIKeyPathFlag, filepath.Join(t.TempDir(), "keyPath"))
 				viper.Set(config.APIKeyPasswordFlag, "passwd")
 				viper.Set(config.APIKeySaveOnCreateFlag, true)
 				viper.Set(config.DashboardCallbackURLFlag, "1.2.3.4")
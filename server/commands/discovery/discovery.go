@@ -69,6 +69,21 @@ func BindFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSliceP(config.DiscoveryProviderFlag, "p", []string{}, "Providers to discover, separated by comma")
 	cmd.Flags().String(config.DiscoveryResourceGroupFlag, config.DefaultDiscoveryResourceGroup, "Limit the scope of the discovery to a resource group (currently only used in the Azure discoverer")
 	cmd.Flags().String(config.DiscoveryCSAFDomainFlag, config.DefaultCSAFDomain, "The domain to look for a CSAF provider, if the CSAF discovery is enabled")
+	cmd.Flags().StringSlice(config.DiscoveryAWSAccountsFlag, []string{}, "AWS accounts to discover, separated by comma. A role is assumed in each account that differs from the account of the default credentials")
+	cmd.Flags().Bool(config.DiscoveryAWSOrganizationFlag, config.DefaultDiscoveryAWSOrganization, "Discover all active accounts of the AWS Organization")
+	cmd.Flags().String(config.DiscoveryAWSRoleNameFlag, config.DefaultDiscoveryAWSRoleName, "Name of the role that is assumed in other AWS accounts")
+	cmd.Flags().StringSlice(config.DiscoveryAWSRegionsFlag, []string{}, "AWS regions to discover, separated by comma. Use \"all\" to discover all enabled regions")
+	cmd.Flags().StringSlice(config.DiscoveryAzureSubscriptionsFlag, []string{}, "Azure subscriptions to discover, separated by comma. Use \"all\" to discover all accessible subscriptions")
+	cmd.Flags().String(config.DiscoveryAzureManagementGroupFlag, "", "Discover all Azure subscriptions below the management group")
+	cmd.Flags().StringSlice(config.DiscoveryK8sContextsFlag, []string{}, "Kubernetes contexts of the kubeconfig to discover, separated by comma. Resources are labelled with the context name")
+	cmd.Flags().StringSlice(config.DiscoveryK8sNamespacesFlag, []string{}, "Limit the Kubernetes discovery to these namespaces, separated by comma")
+	cmd.Flags().StringSlice(config.DiscoveryK8sExcludedNamespacesFlag, []string{}, "Kubernetes namespaces to exclude from the discovery, separated by comma")
+	cmd.Flags().String(config.DiscoveryK8sManifestsPathFlag, "", "File or directory containing the Kubernetes manifests, e.g., rendered Helm charts, if the k8s-manifests discovery is enabled")
+	cmd.Flags().StringSlice(config.DiscoveryGCPProjectsFlag, []string{}, "GCP projects to discover, separated by comma. If empty, all active projects accessible with the credentials are discovered")
+	cmd.Flags().String(config.DiscoveryFilePathFlag, "", "Directory containing the JSON or YAML files of the resources, if the file discovery is enabled")
+	cmd.Flags().StringSlice(config.DiscoveryTerraformPathsFlag, []string{}, "Terraform state files, outputs of `terraform show -json` or directories containing them, separated by comma")
+	cmd.Flags().StringSlice(config.DiscoveryGitPathsFlag, []string{}, "Local git repositories or directories containing them, separated by comma, if the git discovery is enabled")
+	cmd.Flags().String(config.DiscoveryGitKeyRingFlag, "", "Armored OpenPGP key ring with the trusted keys to verify commit signatures")
 	if cmd.Flag(config.APIgRPCPortFlag) == nil {
 		cmd.Flags().Uint16(config.APIgRPCPortFlag, config.DefaultAPIgRPCPortDiscovery, "Specifies the port used for the Clouditor gRPC API")
 	}
@@ -82,6 +97,21 @@ func BindFlags(cmd *cobra.Command) {
 	_ = viper.BindPFlag(config.DiscoveryProviderFlag, cmd.Flags().Lookup(config.DiscoveryProviderFlag))
 	_ = viper.BindPFlag(config.DiscoveryResourceGroupFlag, cmd.Flags().Lookup(config.DiscoveryResourceGroupFlag))
 	_ = viper.BindPFlag(config.DiscoveryCSAFDomainFlag, cmd.Flags().Lookup(config.DefaultCSAFDomain))
+	_ = viper.BindPFlag(config.DiscoveryAWSAccountsFlag, cmd.Flags().Lookup(config.DiscoveryAWSAccountsFlag))
+	_ = viper.BindPFlag(config.DiscoveryAWSOrganizationFlag, cmd.Flags().Lookup(config.DiscoveryAWSOrganizationFlag))
+	_ = viper.BindPFlag(config.DiscoveryAWSRoleNameFlag, cmd.Flags().Lookup(config.DiscoveryAWSRoleNameFlag))
+	_ = viper.BindPFlag(config.DiscoveryAWSRegionsFlag, cmd.Flags().Lookup(config.DiscoveryAWSRegionsFlag))
+	_ = viper.BindPFlag(config.DiscoveryAzureSubscriptionsFlag, cmd.Flags().Lookup(config.DiscoveryAzureSubscriptionsFlag))
+	_ = viper.BindPFlag(config.DiscoveryAzureManagementGroupFlag, cmd.Flags().Lookup(config.DiscoveryAzureManagementGroupFlag))
+	_ = viper.BindPFlag(config.DiscoveryK8sContextsFlag, cmd.Flags().Lookup(config.DiscoveryK8sContextsFlag))
+	_ = viper.BindPFlag(config.DiscoveryK8sNamespacesFlag, cmd.Flags().Lookup(config.DiscoveryK8sNamespacesFlag))
+	_ = viper.BindPFlag(config.DiscoveryK8sExcludedNamespacesFlag, cmd.Flags().Lookup(config.DiscoveryK8sExcludedNamespacesFlag))
+	_ = viper.BindPFlag(config.DiscoveryK8sManifestsPathFlag, cmd.Flags().Lookup(config.DiscoveryK8sManifestsPathFlag))
+	_ = viper.BindPFlag(config.DiscoveryGCPProjectsFlag, cmd.Flags().Lookup(config.DiscoveryGCPProjectsFlag))
+	_ = viper.BindPFlag(config.DiscoveryFilePathFlag, cmd.Flags().Lookup(config.DiscoveryFilePathFlag))
+	_ = viper.BindPFlag(config.DiscoveryTerraformPathsFlag, cmd.Flags().Lookup(config.DiscoveryTerraformPathsFlag))
+	_ = viper.BindPFlag(config.DiscoveryGitPathsFlag, cmd.Flags().Lookup(config.DiscoveryGitPathsFlag))
+	_ = viper.BindPFlag(config.DiscoveryGitKeyRingFlag, cmd.Flags().Lookup(config.DiscoveryGitKeyRingFlag))
 	_ = viper.BindPFlag(config.APIgRPCPortFlag, cmd.Flags().Lookup(config.APIgRPCPortFlag))
 	_ = viper.BindPFlag(config.APIHTTPPortFlag, cmd.Flags().Lookup(config.APIHTTPPortFlag))
 }
@@ -32,6 +32,7 @@ import (
 
 	"clouditor.io/clouditor/v2/api/evidence"
 	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/persistence"
 	service_evidence "clouditor.io/clouditor/v2/service/evidence"
 	service_orchestrator "clouditor.io/clouditor/v2/service/orchestrator"
 
@@ -49,6 +50,24 @@ func bufConnDialer(context.Context, string) (net.Conn, error) {
 	return bufConnListener.Dial()
 }
 
+// newBufConnDialer starts a separate orchestrator on its own bufconn listener, which is backed by the given storage.
+func newBufConnDialer(storage persistence.Storage) func(context.Context, string) (net.Conn, error) {
+	lis := bufconn.Listen(DefaultBufferSize)
+
+	server := grpc.NewServer()
+	orchestrator.RegisterOrchestratorServer(server, service_orchestrator.NewService(service_orchestrator.WithStorage(storage)))
+
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Fatalf("Server exited with error: %v", err)
+		}
+	}()
+
+	return func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+}
+
 func connectionRefusedDialer(context.Context, string) (net.Conn, error) {
 	return nil, syscall.ECONNREFUSED
 }
@@ -0,0 +1,161 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CalculateCompliance calculates the compliance of a single control on demand. It retrieves the metrics of the control
+// and its sub-controls from the orchestrator and checks the latest assessment result of each resource for these
+// metrics. In contrast to the evaluation service, the result is not stored but only returned to the caller.
+func (svc *Service) CalculateCompliance(ctx context.Context, req *assessment.CalculateComplianceRequest) (res *assessment.CalculateComplianceResponse, err error) {
+	var (
+		metrics []*assessment.Metric
+		results []*assessment.AssessmentResult
+		byID    map[string]*assessment.MetricCompliance
+	)
+
+	// Validate request
+	err = api.Validate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// We are retrieving the assessment results with our own credentials, so we need to make sure that the caller is
+	// allowed to see the results of the target of evaluation. If no target of evaluation is specified, the caller needs
+	// to have access to all of them.
+	if !svc.authz.CheckAccess(ctx, service.AccessRead, req) {
+		return nil, service.ErrPermissionDenied
+	}
+
+	metrics, err = svc.controlMetrics(ctx, req.CatalogId, req.CategoryName, req.ControlId)
+	if err != nil {
+		err = fmt.Errorf("could not retrieve metrics for control '%s': %w", req.ControlId, err)
+		log.Error(err)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	res = &assessment.CalculateComplianceResponse{
+		ControlId:            req.ControlId,
+		CategoryName:         req.CategoryName,
+		CatalogId:            req.CatalogId,
+		TargetOfEvaluationId: req.TargetOfEvaluationId,
+		Metrics:              make([]*assessment.MetricCompliance, 0, len(metrics)),
+	}
+
+	// Without any metrics, there is nothing we can base our compliance on
+	if len(metrics) == 0 {
+		log.Debugf("No metrics are available for control '%s'", req.ControlId)
+		return res, nil
+	}
+
+	byID = make(map[string]*assessment.MetricCompliance)
+	for _, m := range metrics {
+		if _, ok := byID[m.GetId()]; ok {
+			continue
+		}
+
+		mc := &assessment.MetricCompliance{
+			MetricId:            m.GetId(),
+			AssessmentResultIds: []string{},
+		}
+		byID[m.GetId()] = mc
+		res.Metrics = append(res.Metrics, mc)
+	}
+
+	// Get latest assessment results by resource ID for all our metrics
+	results, err = api.ListAllPaginated(&orchestrator.ListAssessmentResultsRequest{
+		Filter: &orchestrator.ListAssessmentResultsRequest_Filter{
+			TargetOfEvaluationId: req.TargetOfEvaluationId,
+			MetricIds:            getMetricIds(metrics),
+		},
+		LatestByResourceId: util.Ref(true),
+	}, svc.orchestrator.Client.ListAssessmentResults, func(res *orchestrator.ListAssessmentResultsResponse) []*assessment.AssessmentResult {
+		return res.Results
+	})
+	if err != nil {
+		err = fmt.Errorf("could not retrieve assessment results for control '%s': %w", req.ControlId, err)
+		log.Error(err)
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	for _, r := range results {
+		mc, ok := byID[r.GetMetricId()]
+		if !ok {
+			continue
+		}
+
+		if r.Compliant {
+			mc.CompliantCount++
+		} else {
+			mc.NonCompliantCount++
+		}
+		mc.AssessmentResultIds = append(mc.AssessmentResultIds, r.GetId())
+	}
+
+	// A metric (and the control as a whole) is only compliant if we have results and none of them is non-compliant
+	res.Compliant = true
+	for _, mc := range res.Metrics {
+		mc.Compliant = mc.CompliantCount > 0 && mc.NonCompliantCount == 0
+		if !mc.Compliant {
+			res.Compliant = false
+		}
+	}
+
+	return res, nil
+}
+
+// controlMetrics retrieves all metrics of a control from the orchestrator. If the control has sub-controls, the metrics
+// of the sub-controls are also included.
+func (svc *Service) controlMetrics(ctx context.Context, catalogId, categoryName, controlId string) (metrics []*assessment.Metric, err error) {
+	return orchestrator.AllMetricsFromControl(func(catalogId, categoryName, controlId string) (*orchestrator.Control, error) {
+		return svc.orchestrator.Client.GetControl(ctx, &orchestrator.GetControlRequest{
+			CatalogId:    catalogId,
+			CategoryName: categoryName,
+			ControlId:    controlId,
+		})
+	}, catalogId, categoryName, controlId)
+}
+
+// getMetricIds returns the metric IDs for the given metrics
+func getMetricIds(metrics []*assessment.Metric) (ids []string) {
+	for _, m := range metrics {
+		ids = append(ids, m.GetId())
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package assessment
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api"
+	"clouditor.io/clouditor/v2/api/assessment"
+	"clouditor.io/clouditor/v2/api/orchestrator"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/orchestratortest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"clouditor.io/clouditor/v2/persistence"
+	"clouditor.io/clouditor/v2/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestService_CalculateCompliance(t *testing.T) {
+	type fields struct {
+		orchestrator *api.RPCConnection[orchestrator.OrchestratorClient]
+		authz        service.AuthorizationStrategy
+	}
+	type args struct {
+		req *assessment.CalculateComplianceRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantRes assert.Want[*assessment.CalculateComplianceResponse]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "validation error",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &assessment.CalculateComplianceRequest{},
+			},
+			wantRes: assert.Nil[*assessment.CalculateComplianceResponse],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "control_id: value length must be at least 1 characters")
+			},
+		},
+		{
+			name: "permission denied",
+			fields: fields{
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockTargetOfEvaluationID2),
+			},
+			args: args{
+				req: &assessment.CalculateComplianceRequest{
+					ControlId:            testdata.MockControlID1,
+					CategoryName:         testdata.MockCategoryName,
+					CatalogId:            testdata.MockCatalogID1,
+					TargetOfEvaluationId: util.Ref(testdata.MockTargetOfEvaluationID1),
+				},
+			},
+			wantRes: assert.Nil[*assessment.CalculateComplianceResponse],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, service.ErrPermissionDenied)
+			},
+		},
+		{
+			name: "control not found",
+			fields: fields{
+				orchestrator: api.NewRPCConnection(testdata.MockGRPCTarget, orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t)))),
+				authz:        servicetest.NewAuthorizationStrategy(true),
+			},
+			args: args{
+				req: &assessment.CalculateComplianceRequest{
+					ControlId:    testdata.MockControlID1,
+					CategoryName: testdata.MockCategoryName,
+					CatalogId:    testdata.MockCatalogID1,
+				},
+			},
+			wantRes: assert.Nil[*assessment.CalculateComplianceResponse],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				assert.Equal(t, codes.Internal, status.Code(err))
+				return assert.ErrorContains(t, err, "control not found")
+			},
+		},
+		{
+			name: "happy path",
+			fields: fields{
+				orchestrator: api.NewRPCConnection(testdata.MockGRPCTarget, orchestrator.NewOrchestratorClient, grpc.WithContextDialer(newBufConnDialer(testutil.NewInMemoryStorage(t, func(s persistence.Storage) {
+					assert.NoError(t, s.Create(orchestratortest.NewCatalog()))
+					assert.NoError(t, s.Create(orchestratortest.MockAssessmentResults))
+				})))),
+				authz: servicetest.NewAuthorizationStrategy(false, testdata.MockTargetOfEvaluationID1),
+			},
+			args: args{
+				req: &assessment.CalculateComplianceRequest{
+					ControlId:            testdata.MockControlID1,
+					CategoryName:         testdata.MockCategoryName,
+					CatalogId:            testdata.MockCatalogID1,
+					TargetOfEvaluationId: util.Ref(testdata.MockTargetOfEvaluationID1),
+				},
+			},
+			wantRes: func(t *testing.T, got *assessment.CalculateComplianceResponse) bool {
+				assert.True(t, got.Compliant)
+				assert.Equal(t, testdata.MockControlID1, got.ControlId)
+				if !assert.Equal(t, 1, len(got.Metrics)) {
+					return false
+				}
+
+				return assert.Equal(t, &assessment.MetricCompliance{
+					MetricId:            testdata.MockMetricID1,
+					Compliant:           true,
+					CompliantCount:      1,
+					NonCompliantCount:   0,
+					AssessmentResultIds: []string{testdata.MockAssessmentResult1ID},
+				}, got.Metrics[0])
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &Service{
+				orchestrator: tt.fields.orchestrator,
+				authz:        tt.fields.authz,
+			}
+			gotRes, err := svc.CalculateCompliance(context.Background(), tt.args.req)
+
+			tt.wantErr(t, err)
+			tt.wantRes(t, gotRes)
+		})
+	}
+}
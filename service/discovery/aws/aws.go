@@ -31,14 +31,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+
+	clouditorconfig "clouditor.io/clouditor/v2/internal/config"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// AllRegions can be passed to [WithRegions] to discover all regions that are enabled in an account.
+	AllRegions = "all"
+
+	// RoleSessionName is the session name used when assuming a role in another account.
+	RoleSessionName = "clouditor-discovery"
+)
+
 var log = logrus.WithField("component", "aws-discovery")
 
 // loadDefaultConfig holds config.LoadDefaultConfig() so that NewClient() can use it and test function can mock it
@@ -47,6 +62,13 @@ var loadDefaultConfig = config.LoadDefaultConfig
 // newFromConfigSTS holds sts.NewFromConfig() so that NewClient() can use it and test function can mock it
 var newFromConfigSTS = loadSTSClient
 
+// newFromConfigOrganizations holds organizations.NewFromConfig() so that NewClients() can use it and test function can
+// mock it
+var newFromConfigOrganizations = loadOrganizationsClient
+
+// newFromConfigRegions holds ec2.NewFromConfig() so that NewClients() can use it and test function can mock it
+var newFromConfigRegions = loadRegionsClient
+
 // Client holds configurations across all services within AWS
 type Client struct {
 	cfg aws.Config
@@ -57,6 +79,63 @@ type Client struct {
 // STSAPI describes the STS api interface which is implemented by the official AWS client and mock clients in tests
 type STSAPI interface {
 	GetCallerIdentity(ctx context.Context, params *sts.GetCallerIdentityInput, optFns ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+	AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
+}
+
+// OrganizationsAPI describes the AWS Organizations api interface which is implemented by the official AWS client and
+// mock clients in tests
+type OrganizationsAPI interface {
+	ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error)
+}
+
+// RegionsAPI describes the EC2 api interface that is used to retrieve the enabled regions of an account. It is
+// implemented by the official AWS client and mock clients in tests
+type RegionsAPI interface {
+	DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+}
+
+// ClientOption is a functional option type to configure the clients created by [NewClients].
+type ClientOption func(*clientOptions)
+
+// clientOptions contains the accounts and regions that [NewClients] creates clients for.
+type clientOptions struct {
+	accounts     []string
+	organization bool
+	roleName     string
+	regions      []string
+}
+
+// WithAccounts is an option to discover the given accounts. A role with the name configured by [WithRoleName] is
+// assumed in each account that differs from the account of the default credentials.
+func WithAccounts(accounts []string) ClientOption {
+	return func(o *clientOptions) {
+		o.accounts = append(o.accounts, accounts...)
+	}
+}
+
+// WithOrganization is an option to discover all active accounts of the AWS Organization the default credentials
+// belong to. The credentials need to be allowed to list the accounts of the organization.
+func WithOrganization() ClientOption {
+	return func(o *clientOptions) {
+		o.organization = true
+	}
+}
+
+// WithRoleName is an option to set the name of the role that is assumed in other accounts. If not set,
+// [clouditorconfig.DefaultDiscoveryAWSRoleName] is used, which is the role AWS Organizations creates in every account that is
+// created within the organization.
+func WithRoleName(name string) ClientOption {
+	return func(o *clientOptions) {
+		o.roleName = name
+	}
+}
+
+// WithRegions is an option to discover the given regions. If regions contains [AllRegions], all regions that are
+// enabled in an account are discovered. If not set, only the region of the default config is discovered.
+func WithRegions(regions []string) ClientOption {
+	return func(o *clientOptions) {
+		o.regions = append(o.regions, regions...)
+	}
 }
 
 // NewClient constructs a new AwsClient
@@ -82,6 +161,143 @@ func NewClient() (*Client, error) {
 	return c, err
 }
 
+// NewClients constructs a [Client] for every combination of account and region that is configured by opts. Without
+// any options, this is equal to calling [NewClient]. Accounts that cannot be accessed are skipped, an error is only
+// returned if no client could be created at all.
+func NewClients(opts ...ClientOption) (clients []*Client, err error) {
+	var (
+		o        = &clientOptions{roleName: clouditorconfig.DefaultDiscoveryAWSRoleName}
+		base     *Client
+		accounts []string
+		regions  []string
+	)
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	base, err = NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err = o.listAccounts(base)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		cfg := base.assumeRole(account, o.roleName)
+
+		regions, err = o.listRegions(cfg)
+		if err != nil {
+			log.Errorf("Could not retrieve regions of account %s, skipping it: %v", account, err)
+			continue
+		}
+
+		for _, region := range regions {
+			c := &Client{
+				cfg:       cfg.Copy(),
+				accountID: aws.String(account),
+			}
+			c.cfg.Region = region
+
+			clients = append(clients, c)
+		}
+	}
+
+	if len(clients) == 0 {
+		return nil, errors.New("could not access any of the configured accounts")
+	}
+
+	log.Infof("Created %d client(s) for the configured accounts and regions", len(clients))
+
+	return clients, nil
+}
+
+// listAccounts returns the IDs of all accounts that should be discovered. If no accounts are configured, only the
+// account of the default credentials is returned.
+func (o *clientOptions) listAccounts(base *Client) (accounts []string, err error) {
+	accounts = slices.Clone(o.accounts)
+
+	if o.organization {
+		var (
+			api       = newFromConfigOrganizations(base.cfg)
+			nextToken *string
+			resp      *organizations.ListAccountsOutput
+		)
+
+		for {
+			resp, err = api.ListAccounts(context.Background(), &organizations.ListAccountsInput{
+				NextToken: nextToken,
+			})
+			if err != nil {
+				return nil, prettyError(err)
+			}
+
+			for _, account := range resp.Accounts {
+				if account.Status == orgtypes.AccountStatusActive {
+					accounts = append(accounts, aws.ToString(account.Id))
+				}
+			}
+
+			if resp.NextToken == nil {
+				break
+			}
+			nextToken = resp.NextToken
+		}
+	}
+
+	if len(accounts) == 0 {
+		return []string{aws.ToString(base.accountID)}, nil
+	}
+
+	slices.Sort(accounts)
+	return slices.Compact(accounts), nil
+}
+
+// listRegions returns the regions that should be discovered using the given config.
+func (o *clientOptions) listRegions(cfg aws.Config) (regions []string, err error) {
+	if len(o.regions) == 0 {
+		return []string{cfg.Region}, nil
+	}
+
+	if !slices.Contains(o.regions, AllRegions) {
+		return o.regions, nil
+	}
+
+	// DescribeRegions only returns the regions that are enabled in the account unless AllRegions is set
+	resp, err := newFromConfigRegions(cfg).DescribeRegions(context.Background(), &ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+
+	for _, region := range resp.Regions {
+		regions = append(regions, aws.ToString(region.RegionName))
+	}
+	slices.Sort(regions)
+
+	return regions, nil
+}
+
+// assumeRole returns a config for the given account. If the account differs from the account of the client, the
+// credentials of the returned config assume the role roleName in that account.
+func (c *Client) assumeRole(account string, roleName string) aws.Config {
+	cfg := c.cfg.Copy()
+
+	if account == aws.ToString(c.accountID) {
+		return cfg
+	}
+
+	roleARN := fmt.Sprintf("arn:aws:iam::%s:role/%s", account, roleName)
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(newFromConfigSTS(c.cfg), roleARN,
+		func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = RoleSessionName
+		}))
+
+	return cfg
+}
+
 // formatError returns AWS API specific error code transformed into the default error type
 func formatError(ae smithy.APIError) error {
 	return fmt.Errorf("code: %v, fault: %v, message: %v", ae.ErrorCode(), ae.ErrorFault(), ae.ErrorMessage())
@@ -101,3 +317,13 @@ func loadSTSClient(cfg aws.Config) STSAPI {
 	client := sts.NewFromConfig(cfg)
 	return client
 }
+
+// loadOrganizationsClient creates the AWS Organizations client using the OrganizationsAPI interface (for mock testing)
+func loadOrganizationsClient(cfg aws.Config) OrganizationsAPI {
+	return organizations.NewFromConfig(cfg)
+}
+
+// loadRegionsClient creates the EC2 client using the RegionsAPI interface (for mock testing)
+func loadRegionsClient(cfg aws.Config) RegionsAPI {
+	return ec2.NewFromConfig(cfg)
+}
@@ -32,9 +32,14 @@ import (
 	"errors"
 	"testing"
 
+	clouditorconfig "clouditor.io/clouditor/v2/internal/config"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	typesOrganizations "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 )
@@ -105,8 +110,18 @@ func (mockSTSClient) GetCallerIdentity(_ context.Context,
 	}, nil
 }
 
+func (mockSTSClient) AssumeRole(_ context.Context,
+	_ *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	return &sts.AssumeRoleOutput{}, nil
+}
+
 type mockSTSClientWithAPIError struct{}
 
+func (mockSTSClientWithAPIError) AssumeRole(_ context.Context,
+	_ *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
 func (mockSTSClientWithAPIError) GetCallerIdentity(_ context.Context,
 	_ *sts.GetCallerIdentityInput, _ ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
 	return nil, &smithy.OperationError{
@@ -115,3 +130,152 @@ func (mockSTSClientWithAPIError) GetCallerIdentity(_ context.Context,
 		Err:           errors.New("MaxAttemptsError"),
 	}
 }
+
+type mockOrganizationsAPI struct{}
+
+func (mockOrganizationsAPI) ListAccounts(_ context.Context, params *organizations.ListAccountsInput, _ ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	if params.NextToken == nil {
+		return &organizations.ListAccountsOutput{
+			Accounts: []typesOrganizations.Account{
+				{Id: aws.String("12345"), Status: typesOrganizations.AccountStatusActive},
+				{Id: aws.String("67890"), Status: typesOrganizations.AccountStatusActive},
+			},
+			NextToken: aws.String("next"),
+		}, nil
+	}
+
+	return &organizations.ListAccountsOutput{
+		Accounts: []typesOrganizations.Account{
+			{Id: aws.String("11111"), Status: typesOrganizations.AccountStatusSuspended},
+		},
+	}, nil
+}
+
+type mockOrganizationsAPIWithErrors struct{}
+
+func (mockOrganizationsAPIWithErrors) ListAccounts(_ context.Context, _ *organizations.ListAccountsInput, _ ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+type mockRegionsAPI struct{}
+
+func (mockRegionsAPI) DescribeRegions(_ context.Context, _ *ec2.DescribeRegionsInput, _ ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	return &ec2.DescribeRegionsOutput{
+		Regions: []typesEC2.Region{
+			{RegionName: aws.String("eu-west-1")},
+			{RegionName: aws.String("eu-central-1")},
+		},
+	}, nil
+}
+
+type mockRegionsAPIWithErrors struct{}
+
+func (mockRegionsAPIWithErrors) DescribeRegions(_ context.Context, _ *ec2.DescribeRegionsInput, _ ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+// clientAccountsAndRegions returns the account and region of each client in the form "account/region"
+func clientAccountsAndRegions(clients []*Client) (got []string) {
+	for _, c := range clients {
+		got = append(got, aws.ToString(c.accountID)+"/"+c.cfg.Region)
+	}
+	return
+}
+
+func TestNewClients(t *testing.T) {
+	oldLoadDefaultConfig := loadDefaultConfig
+	oldNewFromConfigSTS := newFromConfigSTS
+	oldNewFromConfigOrganizations := newFromConfigOrganizations
+	oldNewFromConfigRegions := newFromConfigRegions
+	defer func() {
+		loadDefaultConfig = oldLoadDefaultConfig
+		newFromConfigSTS = oldNewFromConfigSTS
+		newFromConfigOrganizations = oldNewFromConfigOrganizations
+		newFromConfigRegions = oldNewFromConfigRegions
+	}()
+
+	loadDefaultConfig = func(ctx context.Context,
+		opt ...func(options *config.LoadOptions) error) (cfg aws.Config, err error) {
+		return aws.Config{Region: mockRegion}, nil
+	}
+	newFromConfigSTS = func(cfg aws.Config) STSAPI {
+		return mockSTSClient{}
+	}
+
+	tests := []struct {
+		name          string
+		opts          []ClientOption
+		organizations OrganizationsAPI
+		regions       RegionsAPI
+		want          []string
+		wantErr       assert.WantErr
+	}{
+		{
+			name: "default account and region",
+			want: []string{"12345/" + mockRegion},
+		},
+		{
+			name: "accounts and regions",
+			opts: []ClientOption{WithAccounts([]string{"67890", "12345", "67890"}), WithRegions([]string{"eu-west-1", "us-east-1"})},
+			want: []string{"12345/eu-west-1", "12345/us-east-1", "67890/eu-west-1", "67890/us-east-1"},
+		},
+		{
+			name:          "organization and all regions",
+			opts:          []ClientOption{WithOrganization(), WithRegions([]string{AllRegions})},
+			organizations: mockOrganizationsAPI{},
+			regions:       mockRegionsAPI{},
+			want:          []string{"12345/eu-central-1", "12345/eu-west-1", "67890/eu-central-1", "67890/eu-west-1"},
+		},
+		{
+			name:          "error listing accounts",
+			opts:          []ClientOption{WithOrganization()},
+			organizations: mockOrganizationsAPIWithErrors{},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not retrieve accounts")
+			},
+		},
+		{
+			name:    "error listing regions",
+			opts:    []ClientOption{WithRegions([]string{AllRegions})},
+			regions: mockRegionsAPIWithErrors{},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not access any of the configured accounts")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFromConfigOrganizations = func(cfg aws.Config) OrganizationsAPI {
+				return tt.organizations
+			}
+			newFromConfigRegions = func(cfg aws.Config) RegionsAPI {
+				return tt.regions
+			}
+
+			got, err := NewClients(tt.opts...)
+			if tt.wantErr != nil {
+				tt.wantErr(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, clientAccountsAndRegions(got))
+		})
+	}
+}
+
+func TestClient_assumeRole(t *testing.T) {
+	c := &Client{
+		cfg:       aws.Config{Region: mockRegion, Credentials: aws.AnonymousCredentials{}},
+		accountID: aws.String("12345"),
+	}
+
+	// Same account keeps the original credentials
+	cfg := c.assumeRole("12345", clouditorconfig.DefaultDiscoveryAWSRoleName)
+	assert.Equal[aws.CredentialsProvider](t, aws.AnonymousCredentials{}, cfg.Credentials)
+
+	// Other accounts assume a role
+	cfg = c.assumeRole("67890", clouditorconfig.DefaultDiscoveryAWSRoleName)
+	assert.Is[*aws.CredentialsCache](t, cfg.Credentials)
+	assert.Equal(t, mockRegion, cfg.Region)
+}
@@ -30,6 +30,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/ontology"
@@ -47,6 +48,9 @@ import (
 type computeDiscovery struct {
 	virtualMachineAPI EC2API
 	functionAPI       LambdaAPI
+	trailAPI          CloudTrailAPI
+	logsAPI           CloudWatchLogsAPI
+	ssmAPI            SSMAPI
 	isDiscovering     bool
 	awsConfig         *Client
 	ctID              string
@@ -65,6 +69,10 @@ type EC2API interface {
 	DescribeNetworkInterfaces(ctx context.Context,
 		params *ec2.DescribeNetworkInterfacesInput,
 		optFns ...func(options *ec2.Options)) (*ec2.DescribeNetworkInterfacesOutput, error)
+
+	DescribeSecurityGroups(ctx context.Context,
+		params *ec2.DescribeSecurityGroupsInput,
+		optFns ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
 }
 
 // LambdaAPI describes the lambda api interface which is implemented by the official AWS client and mock clients in tests
@@ -84,6 +92,9 @@ func NewAwsComputeDiscovery(client *Client, TargetOfEvaluationID string) discove
 	return &computeDiscovery{
 		virtualMachineAPI: newFromConfigEC2(client.cfg),
 		functionAPI:       newFromConfigLambda(client.cfg),
+		trailAPI:          newFromConfigCloudTrail(client.cfg),
+		logsAPI:           newFromConfigCloudWatchLogs(client.cfg),
+		ssmAPI:            newFromConfigSSM(client.cfg),
 		isDiscovering:     true,
 		awsConfig:         client,
 		ctID:              TargetOfEvaluationID,
@@ -180,16 +191,47 @@ func (d *computeDiscovery) discoverVolumes() ([]*ontology.BlockStorage, error) {
 	return blocks, nil
 }
 
-// discoverNetworkInterfaces discovers all network interfaces (in the current region)
+// discoverNetworkInterfaces discovers all network interfaces (in the current region). A network interface belongs to
+// its subnet and is protected by the security groups attached to it ([LabelSecurityGroupIDs]). The layer 3 firewall is
+// derived from the rules of these security groups. If the security groups cannot be retrieved, the network interfaces
+// are discovered without them.
 func (d *computeDiscovery) discoverNetworkInterfaces() ([]*ontology.NetworkInterface, error) {
 	res, err := d.virtualMachineAPI.DescribeNetworkInterfaces(context.TODO(), &ec2.DescribeNetworkInterfacesInput{})
 	if err != nil {
 		return nil, prettyError(err)
 	}
 
+	groups, err := d.securityGroups()
+	if err != nil {
+		log.Warnf("Could not retrieve security groups, the network interfaces are discovered without them: %v", err)
+	}
+
 	var ifcs []*ontology.NetworkInterface
 	for i := range res.NetworkInterfaces {
-		ifc := &res.NetworkInterfaces[i]
+		var (
+			ifc    = &res.NetworkInterfaces[i]
+			labels = d.labels(ifc.TagSet)
+			access *ontology.AccessRestriction
+		)
+
+		if groups != nil {
+			var attached []*typesEC2.SecurityGroup
+			for _, g := range ifc.Groups {
+				if group, ok := groups[aws.ToString(g.GroupId)]; ok {
+					attached = append(attached, group)
+				}
+			}
+
+			if len(ifc.Groups) > 0 {
+				labels[LabelSecurityGroupIDs] = d.securityGroupIDs(ifc.Groups)
+			}
+
+			access = &ontology.AccessRestriction{
+				Type: &ontology.AccessRestriction_L3Firewall{
+					L3Firewall: securityGroupsFirewall(attached),
+				},
+			}
+		}
 
 		ifcs = append(ifcs, &ontology.NetworkInterface{
 			Id:   d.arnify("network-interface", ifc.NetworkInterfaceId),
@@ -197,38 +239,93 @@ func (d *computeDiscovery) discoverNetworkInterfaces() ([]*ontology.NetworkInter
 			GeoLocation: &ontology.GeoLocation{
 				Region: d.awsConfig.cfg.Region,
 			},
-			Labels: d.labels(ifc.TagSet),
-			Raw:    discovery.Raw(&res.NetworkInterfaces[i]),
+			Labels:            labels,
+			ParentId:          d.subnetID(ifc.SubnetId),
+			AccessRestriction: access,
+			Raw:               discovery.Raw(&res.NetworkInterfaces[i]),
 		})
 	}
 
 	return ifcs, nil
 }
 
+// securityGroups retrieves all security groups (in the current region) indexed by their ID
+func (d *computeDiscovery) securityGroups() (groups map[string]*typesEC2.SecurityGroup, err error) {
+	var (
+		resp  *ec2.DescribeSecurityGroupsOutput
+		token *string
+	)
+
+	groups = make(map[string]*typesEC2.SecurityGroup)
+
+	for {
+		resp, err = d.virtualMachineAPI.DescribeSecurityGroups(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.SecurityGroups {
+			groups[aws.ToString(resp.SecurityGroups[i].GroupId)] = &resp.SecurityGroups[i]
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
 // discoverVirtualMachines discovers all VMs (in the current region)
 func (d *computeDiscovery) discoverVirtualMachines() ([]*ontology.VirtualMachine, error) {
 	resp, err := d.virtualMachineAPI.DescribeInstances(context.TODO(), &ec2.DescribeInstancesInput{})
 	if err != nil {
 		return nil, prettyError(err)
 	}
+
+	info := d.loggingInfo()
+
 	var resources []*ontology.VirtualMachine
 	for _, reservation := range resp.Reservations {
 		for i := range reservation.Instances {
 			vm := &reservation.Instances[i]
 
-			resources = append(resources, &ontology.VirtualMachine{
+			labels := d.labels(vm.Tags)
+			if len(vm.SecurityGroups) > 0 {
+				labels[LabelSecurityGroupIDs] = d.securityGroupIDs(vm.SecurityGroups)
+			}
+
+			machine := &ontology.VirtualMachine{
 				Id:   d.arnify("instance", vm.InstanceId),
 				Name: d.getNameOfVM(vm),
 				GeoLocation: &ontology.GeoLocation{
 					Region: d.awsConfig.cfg.Region,
 				},
-				Labels:              d.labels(vm.Tags),
+				Labels:              labels,
+				ParentId:            d.subnetID(vm.SubnetId),
 				NetworkInterfaceIds: d.getNetworkInterfacesOfVM(vm),
 				BlockStorageIds:     d.mapBlockStorageIDsOfVM(vm),
-				BootLogging:         d.getBootLog(vm),
-				OsLogging:           d.getOSLog(vm),
 				Raw:                 discovery.Raw(&reservation),
-			})
+			}
+
+			// The logging properties are left unset, if the logging information could not be retrieved
+			if info.trailsAvailable {
+				machine.ActivityLogging = d.getActivityLog(info)
+			}
+
+			if info.logsAvailable {
+				groups, err := d.vmLogGroups(vm, info)
+				if err != nil {
+					log.Warnf("Could not retrieve log groups of VM %s: %v", aws.ToString(vm.InstanceId), err)
+				} else {
+					machine.BootLogging = d.getBootLog(groups, info)
+					machine.OsLogging = d.getOSLog(groups, info)
+				}
+			}
+
+			resources = append(resources, machine)
 		}
 	}
 
@@ -275,24 +372,6 @@ func (d *computeDiscovery) mapFunctionResources(functions []typesLambda.Function
 	return
 }
 
-// getBootLog checks if boot logging is enabled
-// Currently there is no option to find out if any logs are enabled -> Assign default zero values
-func (*computeDiscovery) getBootLog(_ *typesEC2.Instance) (l *ontology.BootLogging) {
-	l = &ontology.BootLogging{
-		Enabled: false,
-	}
-	return
-}
-
-// getOSLog checks if OS logging is enabled
-// Currently there is no option to find out if any logs are enabled -> Assign default zero values
-func (*computeDiscovery) getOSLog(_ *typesEC2.Instance) (l *ontology.OSLogging) {
-	l = &ontology.OSLogging{
-		Enabled: false,
-	}
-	return
-}
-
 // mapBlockStorageIDsOfVM returns block storages IDs by iterating the VMs block storages
 func (d *computeDiscovery) mapBlockStorageIDsOfVM(vm *typesEC2.Instance) (blockStorageIDs []string) {
 	// Loop through mappings using an index, since BlockDeviceMappings is an array of a struct
@@ -315,6 +394,17 @@ func (d *computeDiscovery) getNetworkInterfacesOfVM(vm *typesEC2.Instance) (netw
 	return
 }
 
+// securityGroupIDs returns the comma-separated ARNs of the security groups
+func (d *computeDiscovery) securityGroupIDs(groups []typesEC2.GroupIdentifier) string {
+	var ids []string
+
+	for _, g := range groups {
+		ids = append(ids, d.arnify("security-group", g.GroupId))
+	}
+
+	return strings.Join(ids, ",")
+}
+
 // getNameOfVM returns the name if exists (i.e. a tag with key 'name' exists), otherwise instance ID is used
 func (*computeDiscovery) getNameOfVM(vm *typesEC2.Instance) string {
 	for _, tag := range vm.Tags {
@@ -348,6 +438,15 @@ func (*computeDiscovery) labels(tags []typesEC2.Tag) (labels map[string]string)
 	return
 }
 
+// subnetID returns the ARN of the subnet with the given ID or nil, if the resource is not placed in a subnet
+func (d *computeDiscovery) subnetID(ID *string) *string {
+	if ID == nil {
+		return nil
+	}
+
+	return util.Ref(d.arnify("subnet", ID))
+}
+
 // addARNToVolume generates the ARN of a volume instance
 func (d *computeDiscovery) arnify(typ string, ID *string) string {
 	return "arn:aws:ec2:" +
@@ -37,10 +37,13 @@ import (
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	lambdaTypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/middleware"
 	"github.com/google/go-cmp/cmp"
@@ -174,8 +177,12 @@ func (mockEC2API) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesI
 				BlockDeviceMappings: blockDeviceMappings,
 				InstanceId:          aws.String(mockVM1ID),
 				NetworkInterfaces:   networkInterfaces,
-				Tags:                tags,
-				LaunchTime:          aws.Time(launchTime),
+				SubnetId:            aws.String(mockSubnetID),
+				SecurityGroups: []types.GroupIdentifier{
+					{GroupId: aws.String(mockSecurityGroupID)},
+				},
+				Tags:       tags,
+				LaunchTime: aws.Time(launchTime),
 			}},
 			OwnerId:       nil,
 			RequesterId:   nil,
@@ -216,6 +223,10 @@ func (mockEC2API) DescribeNetworkInterfaces(_ context.Context, _ *ec2.DescribeNe
 		NetworkInterfaces: []types.NetworkInterface{
 			{
 				NetworkInterfaceId: aws.String(networkInterfaceId),
+				SubnetId:           aws.String(mockSubnetID),
+				Groups: []types.GroupIdentifier{
+					{GroupId: aws.String(mockSecurityGroupID)},
+				},
 				TagSet: []types.Tag{
 					{Key: aws.String("Name"), Value: aws.String("My Network Interface")},
 				},
@@ -227,6 +238,11 @@ func (mockEC2API) DescribeNetworkInterfaces(_ context.Context, _ *ec2.DescribeNe
 	return output, nil
 }
 
+// DescribeSecurityGroups is the method implementation of the EC2API interface
+func (mockEC2API) DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return mockNetworkAPI{}.DescribeSecurityGroups(ctx, params, optFns...)
+}
+
 // DescribeInstances is the method implementation of the EC2API interface
 func (mockEC2APIWithErrors) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(options *ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
 	err := &smithy.GenericAPIError{
@@ -254,10 +270,33 @@ func (mockEC2APIWithErrors) DescribeNetworkInterfaces(_ context.Context, _ *ec2.
 	return nil, err
 }
 
+// DescribeSecurityGroups is the method implementation of the EC2API interface
+func (mockEC2APIWithErrors) DescribeSecurityGroups(_ context.Context, _ *ec2.DescribeSecurityGroupsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	err := &smithy.GenericAPIError{
+		Code:    "ConnectionError",
+		Message: "Couldn't resolve host. Bad connection?",
+	}
+	return nil, err
+}
+
+// mockEC2APIWithoutSecurityGroups implements the EC2API interface (DescribeSecurityGroups returning error) for mock
+// testing
+type mockEC2APIWithoutSecurityGroups struct {
+	mockEC2API
+}
+
+// DescribeSecurityGroups is the method implementation of the EC2API interface
+func (mockEC2APIWithoutSecurityGroups) DescribeSecurityGroups(ctx context.Context, params *ec2.DescribeSecurityGroupsInput, optFns ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return mockEC2APIWithErrors{}.DescribeSecurityGroups(ctx, params, optFns...)
+}
+
 func TestComputeDiscovery_List(t *testing.T) {
 	d := computeDiscovery{
 		virtualMachineAPI: mockEC2API{},
 		functionAPI:       mockLambdaAPI{},
+		trailAPI:          mockCloudTrailAPI{},
+		logsAPI:           mockCloudWatchLogsAPI{},
+		ssmAPI:            mockSSMAPI{},
 		isDiscovering:     true,
 		awsConfig: &Client{
 			cfg: aws.Config{
@@ -279,6 +318,9 @@ func TestComputeDiscovery_List(t *testing.T) {
 	d = computeDiscovery{
 		virtualMachineAPI: mockEC2API{},
 		functionAPI:       mockLambdaAPIWithErrors{},
+		trailAPI:          mockCloudTrailAPI{},
+		logsAPI:           mockCloudWatchLogsAPI{},
+		ssmAPI:            mockSSMAPI{},
 		isDiscovering:     true,
 		awsConfig: &Client{
 			cfg: aws.Config{
@@ -294,6 +336,9 @@ func TestComputeDiscovery_List(t *testing.T) {
 func TestComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 	d := computeDiscovery{
 		virtualMachineAPI: mockEC2API{},
+		trailAPI:          mockCloudTrailAPI{},
+		logsAPI:           mockCloudWatchLogsAPI{},
+		ssmAPI:            mockSSMAPI{},
 		isDiscovering:     true,
 		awsConfig: &Client{
 			cfg: aws.Config{
@@ -307,10 +352,19 @@ func TestComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 	testMachine := machines[0]
 	assert.Equal(t, mockVM1, testMachine.Name)
 	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:instance/mockVM1ID", testMachine.Id)
-	assert.False(t, testMachine.BootLogging.Enabled)
-	assert.False(t, testMachine.OsLogging.Enabled)
+	assert.True(t, testMachine.BootLogging.Enabled)
+	assert.Equal(t, []string{mockBootLogGroupARN}, testMachine.BootLogging.LoggingServiceIds)
+	assert.Equal(t, 7*24*time.Hour, testMachine.BootLogging.RetentionPeriod.AsDuration())
+	assert.True(t, testMachine.OsLogging.Enabled)
+	assert.Equal(t, []string{mockOSLogGroupARN}, testMachine.OsLogging.LoggingServiceIds)
+	assert.Equal(t, 30*24*time.Hour, testMachine.OsLogging.RetentionPeriod.AsDuration())
+	assert.True(t, testMachine.ActivityLogging.Enabled)
+	assert.Equal(t, []string{mockTrailARN}, testMachine.ActivityLogging.LoggingServiceIds)
+	assert.Equal(t, 365*24*time.Hour, testMachine.ActivityLogging.RetentionPeriod.AsDuration())
 	assert.Nil(t, testMachine.CreationTime)
 	assert.Equal(t, mockFunction1Region, testMachine.GeoLocation.Region)
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:subnet/"+mockSubnetID, testMachine.GetParentId())
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:security-group/"+mockSecurityGroupID, testMachine.Labels[LabelSecurityGroupIDs])
 
 	d = computeDiscovery{
 		virtualMachineAPI: mockEC2APIWithErrors{},
@@ -318,6 +372,58 @@ func TestComputeDiscovery_discoverVirtualMachines(t *testing.T) {
 	_, err = d.discoverVirtualMachines()
 	assert.Error(t, err)
 
+	// Errors retrieving the logging information do not fail the discovery, the logging properties are left unset
+	d = computeDiscovery{
+		virtualMachineAPI: mockEC2API{},
+		trailAPI:          mockCloudTrailAPIWithErrors{},
+		logsAPI:           mockCloudWatchLogsAPIWithErrors{},
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	machines, err = d.discoverVirtualMachines()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(machines))
+	assert.Nil(t, machines[0].ActivityLogging)
+	assert.Nil(t, machines[0].BootLogging)
+	assert.Nil(t, machines[0].OsLogging)
+}
+
+func TestComputeDiscovery_discoverNetworkInterfaces(t *testing.T) {
+	d := computeDiscovery{
+		virtualMachineAPI: mockEC2API{},
+		isDiscovering:     true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	ifcs, err := d.discoverNetworkInterfaces()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ifcs))
+	assert.Equal(t, "My Network Interface", ifcs[0].Name)
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:subnet/"+mockSubnetID, ifcs[0].GetParentId())
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:security-group/"+mockSecurityGroupID, ifcs[0].Labels[LabelSecurityGroupIDs])
+	assert.Equal(t, &ontology.L3Firewall{Enabled: true, Inbound: true}, ifcs[0].GetAccessRestriction().GetL3Firewall())
+
+	// If the security groups cannot be retrieved, the network interfaces are discovered without them
+	d.virtualMachineAPI = mockEC2APIWithoutSecurityGroups{}
+	ifcs, err = d.discoverNetworkInterfaces()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(ifcs))
+	assert.Empty(t, ifcs[0].Labels[LabelSecurityGroupIDs])
+	assert.Nil(t, ifcs[0].GetAccessRestriction())
+
+	d = computeDiscovery{
+		virtualMachineAPI: mockEC2APIWithErrors{},
+	}
+	_, err = d.discoverNetworkInterfaces()
+	assert.Error(t, err)
 }
 
 func TestComputeDiscover_Name(t *testing.T) {
@@ -463,6 +569,12 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 	defer func() { newFromConfigEC2 = oldEC2 }()
 	oldLambda := newFromConfigLambda
 	defer func() { newFromConfigLambda = oldLambda }()
+	oldCloudTrail := newFromConfigCloudTrail
+	defer func() { newFromConfigCloudTrail = oldCloudTrail }()
+	oldCloudWatchLogs := newFromConfigCloudWatchLogs
+	defer func() { newFromConfigCloudWatchLogs = oldCloudWatchLogs }()
+	oldSSM := newFromConfigSSM
+	defer func() { newFromConfigSSM = oldSSM }()
 
 	newFromConfigEC2 = func(cfg aws.Config, optFns ...func(*ec2.Options)) *ec2.Client {
 		return &ec2.Client{}
@@ -470,6 +582,15 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 	newFromConfigLambda = func(cfg aws.Config, optFns ...func(*lambda.Options)) *lambda.Client {
 		return &lambda.Client{}
 	}
+	newFromConfigCloudTrail = func(cfg aws.Config, optFns ...func(*cloudtrail.Options)) *cloudtrail.Client {
+		return &cloudtrail.Client{}
+	}
+	newFromConfigCloudWatchLogs = func(cfg aws.Config, optFns ...func(*cloudwatchlogs.Options)) *cloudwatchlogs.Client {
+		return &cloudwatchlogs.Client{}
+	}
+	newFromConfigSSM = func(cfg aws.Config, optFns ...func(*ssm.Options)) *ssm.Client {
+		return &ssm.Client{}
+	}
 
 	type args struct {
 		client *Client
@@ -491,6 +612,9 @@ func TestComputeDiscovery_NewComputeDiscovery(t *testing.T) {
 			want: &computeDiscovery{
 				virtualMachineAPI: &ec2.Client{},
 				functionAPI:       &lambda.Client{},
+				trailAPI:          &cloudtrail.Client{},
+				logsAPI:           &cloudwatchlogs.Client{},
+				ssmAPI:            &ssm.Client{},
 				isDiscovering:     true,
 				awsConfig:         mockClient,
 				ctID:              testdata.MockTargetOfEvaluationID1,
@@ -0,0 +1,504 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/util"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	typesRDS "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// databaseDiscovery handles the AWS API requests regarding the database services (RDS and Aurora)
+type databaseDiscovery struct {
+	databaseAPI   RDSAPI
+	isDiscovering bool
+	awsConfig     *Client
+	ctID          string
+
+	// sslGroups caches whether a (cluster) parameter group enforces SSL/TLS, so that groups shared by several
+	// instances or clusters are only described once per discovery run
+	sslGroups map[string]bool
+}
+
+// RDSAPI describes the RDS api interface which is implemented by the official AWS client and mock clients in tests
+type RDSAPI interface {
+	DescribeDBInstances(ctx context.Context,
+		params *rds.DescribeDBInstancesInput,
+		optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+
+	DescribeDBClusters(ctx context.Context,
+		params *rds.DescribeDBClustersInput,
+		optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+
+	DescribeDBParameters(ctx context.Context,
+		params *rds.DescribeDBParametersInput,
+		optFns ...func(*rds.Options)) (*rds.DescribeDBParametersOutput, error)
+
+	DescribeDBClusterParameters(ctx context.Context,
+		params *rds.DescribeDBClusterParametersInput,
+		optFns ...func(*rds.Options)) (*rds.DescribeDBClusterParametersOutput, error)
+}
+
+// newFromConfigRDS holds rds.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigRDS = rds.NewFromConfig
+
+// NewAwsDatabaseDiscovery constructs a new databaseDiscovery initializing the rds-databaseAPI and isDiscovering with
+// true
+func NewAwsDatabaseDiscovery(client *Client, TargetOfEvaluationID string) discovery.Discoverer {
+	return &databaseDiscovery{
+		databaseAPI:   newFromConfigRDS(client.cfg),
+		isDiscovering: true,
+		awsConfig:     client,
+		ctID:          TargetOfEvaluationID,
+	}
+}
+
+// Name is the method implementation defined in the discovery.Discoverer interface
+func (*databaseDiscovery) Name() string {
+	return "AWS Database"
+}
+
+// List is the method implementation defined in the discovery.Discoverer interface
+func (d *databaseDiscovery) List() (resources []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	d.sslGroups = make(map[string]bool)
+
+	// Aurora clusters need to be discovered first, since the storage of their member instances belongs to the cluster
+	clusters, err := d.discoverClusters()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover database clusters: %w", err)
+	}
+	resources = append(resources, clusters...)
+
+	instances, err := d.discoverInstances()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover database instances: %w", err)
+	}
+	resources = append(resources, instances...)
+
+	return
+}
+
+func (d *databaseDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// discoverClusters discovers all Aurora (and Multi-AZ DB) clusters (in the current region). Each cluster is mapped to a
+// relational database service and the database storage (the cluster volume) it uses.
+func (d *databaseDiscovery) discoverClusters() (resources []ontology.IsResource, err error) {
+	var (
+		resp   *rds.DescribeDBClustersOutput
+		marker *string
+	)
+
+	for {
+		resp, err = d.databaseAPI.DescribeDBClusters(context.TODO(), &rds.DescribeDBClustersInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.DBClusters {
+			cluster := &resp.DBClusters[i]
+
+			forceSSL, err := d.clusterForcesSSL(cluster)
+			if err != nil {
+				return nil, err
+			}
+
+			storage := &ontology.DatabaseStorage{
+				Id:               storageID(cluster.DBClusterArn),
+				Name:             aws.ToString(cluster.DBClusterIdentifier),
+				CreationTime:     timestamppb.New(util.Deref(cluster.ClusterCreateTime)),
+				GeoLocation:      d.location(),
+				Labels:           d.labels(cluster.TagList),
+				ParentId:         cluster.DBClusterArn,
+				AtRestEncryption: d.atRestEncryption(cluster.StorageEncrypted, cluster.KmsKeyId),
+				Backups:          d.backups(cluster.BackupRetentionPeriod),
+				Redundancies:     d.redundancies(cluster.MultiAZ, nil),
+				Raw:              discovery.Raw(&resp.DBClusters[i]),
+			}
+
+			service := &ontology.RelationalDatabaseService{
+				Id:                         aws.ToString(cluster.DBClusterArn),
+				Name:                       aws.ToString(cluster.DBClusterIdentifier),
+				CreationTime:               timestamppb.New(util.Deref(cluster.ClusterCreateTime)),
+				GeoLocation:                d.location(),
+				Labels:                     d.labels(cluster.TagList),
+				InternetAccessibleEndpoint: util.Deref(cluster.PubliclyAccessible),
+				Ports:                      ports(cluster.Port),
+				StorageIds:                 []string{storage.Id},
+				TransportEncryption:        transportEncryption(forceSSL),
+				Redundancies:               d.redundancies(cluster.MultiAZ, nil),
+				Raw:                        discovery.Raw(&resp.DBClusters[i]),
+			}
+
+			resources = append(resources, service, storage)
+		}
+
+		if marker = resp.Marker; marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverInstances discovers all RDS instances (in the current region). Stand-alone instances are mapped to a
+// relational database service and a database storage. Instances that are members of a cluster do not have their own
+// storage, they refer to the storage of the cluster instead.
+func (d *databaseDiscovery) discoverInstances() (resources []ontology.IsResource, err error) {
+	var (
+		resp   *rds.DescribeDBInstancesOutput
+		marker *string
+	)
+
+	for {
+		resp, err = d.databaseAPI.DescribeDBInstances(context.TODO(), &rds.DescribeDBInstancesInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.DBInstances {
+			instance := &resp.DBInstances[i]
+
+			forceSSL, err := d.instanceForcesSSL(instance)
+			if err != nil {
+				return nil, err
+			}
+
+			service := &ontology.RelationalDatabaseService{
+				Id:                         aws.ToString(instance.DBInstanceArn),
+				Name:                       aws.ToString(instance.DBInstanceIdentifier),
+				CreationTime:               timestamppb.New(util.Deref(instance.InstanceCreateTime)),
+				GeoLocation:                d.location(),
+				Labels:                     d.labels(instance.TagList),
+				InternetAccessibleEndpoint: util.Deref(instance.PubliclyAccessible),
+				TransportEncryption:        transportEncryption(forceSSL),
+				Redundancies:               d.redundancies(instance.MultiAZ, instance.ReadReplicaDBInstanceIdentifiers),
+				Raw:                        discovery.Raw(&resp.DBInstances[i]),
+			}
+
+			if instance.Endpoint != nil {
+				service.Ports = ports(instance.Endpoint.Port)
+			}
+
+			// Members of a cluster use the cluster volume as their storage
+			if instance.DBClusterIdentifier != nil {
+				clusterARN := d.arnify("cluster", instance.DBClusterIdentifier)
+
+				service.ParentId = &clusterARN
+				service.StorageIds = []string{storageID(&clusterARN)}
+
+				resources = append(resources, service)
+				continue
+			}
+
+			storage := &ontology.DatabaseStorage{
+				Id:               storageID(instance.DBInstanceArn),
+				Name:             aws.ToString(instance.DBInstanceIdentifier),
+				CreationTime:     timestamppb.New(util.Deref(instance.InstanceCreateTime)),
+				GeoLocation:      d.location(),
+				Labels:           d.labels(instance.TagList),
+				ParentId:         instance.DBInstanceArn,
+				AtRestEncryption: d.atRestEncryption(instance.StorageEncrypted, instance.KmsKeyId),
+				Backups:          d.backups(instance.BackupRetentionPeriod),
+				Redundancies:     d.redundancies(instance.MultiAZ, instance.ReadReplicaDBInstanceIdentifiers),
+				Raw:              discovery.Raw(&resp.DBInstances[i]),
+			}
+
+			service.StorageIds = []string{storage.Id}
+
+			resources = append(resources, service, storage)
+		}
+
+		if marker = resp.Marker; marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// instanceForcesSSL checks whether one of the DB parameter groups of the instance enforces SSL/TLS connections
+func (d *databaseDiscovery) instanceForcesSSL(instance *typesRDS.DBInstance) (bool, error) {
+	for _, group := range instance.DBParameterGroups {
+		name := aws.ToString(group.DBParameterGroupName)
+
+		forced, err := d.cachedForcesSSL("db:"+name, func() (bool, error) {
+			return d.parameterGroupForcesSSL(group.DBParameterGroupName)
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if forced {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// clusterForcesSSL checks whether the DB cluster parameter group of the cluster enforces SSL/TLS connections
+func (d *databaseDiscovery) clusterForcesSSL(cluster *typesRDS.DBCluster) (bool, error) {
+	if cluster.DBClusterParameterGroup == nil {
+		return false, nil
+	}
+
+	return d.cachedForcesSSL("cluster:"+aws.ToString(cluster.DBClusterParameterGroup), func() (bool, error) {
+		return d.clusterParameterGroupForcesSSL(cluster.DBClusterParameterGroup)
+	})
+}
+
+// cachedForcesSSL returns the cached result for the parameter group key or calls lookup and caches its result
+func (d *databaseDiscovery) cachedForcesSSL(key string, lookup func() (bool, error)) (bool, error) {
+	if forced, ok := d.sslGroups[key]; ok {
+		return forced, nil
+	}
+
+	forced, err := lookup()
+	if err != nil {
+		return false, err
+	}
+
+	if d.sslGroups == nil {
+		d.sslGroups = make(map[string]bool)
+	}
+	d.sslGroups[key] = forced
+
+	return forced, nil
+}
+
+// parameterGroupForcesSSL checks whether the DB parameter group enforces SSL/TLS connections
+func (d *databaseDiscovery) parameterGroupForcesSSL(name *string) (bool, error) {
+	var marker *string
+
+	for {
+		resp, err := d.databaseAPI.DescribeDBParameters(context.TODO(), &rds.DescribeDBParametersInput{
+			DBParameterGroupName: name,
+			Marker:               marker,
+		})
+		if err != nil {
+			return false, prettyError(err)
+		}
+
+		if forcesSSL(resp.Parameters) {
+			return true, nil
+		}
+
+		if marker = resp.Marker; marker == nil {
+			break
+		}
+	}
+
+	return false, nil
+}
+
+// clusterParameterGroupForcesSSL checks whether the DB cluster parameter group enforces SSL/TLS connections
+func (d *databaseDiscovery) clusterParameterGroupForcesSSL(name *string) (bool, error) {
+	var marker *string
+
+	for {
+		resp, err := d.databaseAPI.DescribeDBClusterParameters(context.TODO(), &rds.DescribeDBClusterParametersInput{
+			DBClusterParameterGroupName: name,
+			Marker:                      marker,
+		})
+		if err != nil {
+			return false, prettyError(err)
+		}
+
+		if forcesSSL(resp.Parameters) {
+			return true, nil
+		}
+
+		if marker = resp.Marker; marker == nil {
+			break
+		}
+	}
+
+	return false, nil
+}
+
+// forcesSSL checks the engine-specific parameters that enforce SSL/TLS connections, i.e., 'rds.force_ssl' for
+// PostgreSQL and SQL Server and 'require_secure_transport' for MySQL and MariaDB
+func forcesSSL(params []typesRDS.Parameter) bool {
+	for _, p := range params {
+		switch aws.ToString(p.ParameterName) {
+		case "rds.force_ssl", "require_secure_transport":
+			switch strings.ToLower(aws.ToString(p.ParameterValue)) {
+			case "1", "on", "true":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// transportEncryption returns the transport encryption of a database. RDS always supports TLS connections, but only
+// enforces them if configured in the parameter group.
+func transportEncryption(enforced bool) *ontology.TransportEncryption {
+	return &ontology.TransportEncryption{
+		Enabled:  true,
+		Enforced: enforced,
+		Protocol: constants.TLS,
+	}
+}
+
+// atRestEncryption returns the at-rest encryption of a database storage. RDS always uses KMS for storage encryption,
+// so we use the ARN of the KMS key as key URL.
+func (*databaseDiscovery) atRestEncryption(encrypted *bool, kmsKeyID *string) *ontology.AtRestEncryption {
+	if !util.Deref(encrypted) {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Enabled: false,
+				},
+			},
+		}
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+				Enabled:   true,
+				Algorithm: constants.AES256,
+				KeyUrl:    aws.ToString(kmsKeyID),
+			},
+		},
+	}
+}
+
+// backups returns the automated backups of a database. Automated backups are taken daily and are disabled if the
+// retention period is 0.
+func (*databaseDiscovery) backups(retentionDays *int32) []*ontology.Backup {
+	days := util.Deref(retentionDays)
+
+	return []*ontology.Backup{
+		{
+			Enabled:         days > 0,
+			Interval:        durationpb.New(24 * time.Hour),
+			RetentionPeriod: durationpb.New(time.Duration(days) * 24 * time.Hour),
+		},
+	}
+}
+
+// redundancies returns the redundancies of a database. A Multi-AZ deployment is zone-redundant, read replicas in
+// other regions make a database geo-redundant.
+func (d *databaseDiscovery) redundancies(multiAZ *bool, replicas []string) (r []*ontology.Redundancy) {
+	var regions []*ontology.GeoLocation
+
+	if util.Deref(multiAZ) {
+		r = append(r, &ontology.Redundancy{
+			Type: &ontology.Redundancy_ZoneRedundancy{
+				ZoneRedundancy: &ontology.ZoneRedundancy{
+					GeoLocations: []*ontology.GeoLocation{d.location()},
+				},
+			},
+		})
+	}
+
+	// Read replicas in the same region are identified by name, cross-region replicas by their ARN
+	// (arn:aws:rds:<region>:<account>:db:<name>)
+	for _, replica := range replicas {
+		parts := strings.Split(replica, ":")
+		if len(parts) < 4 || parts[3] == d.awsConfig.cfg.Region {
+			continue
+		}
+
+		regions = append(regions, &ontology.GeoLocation{Region: parts[3]})
+	}
+
+	if len(regions) > 0 {
+		r = append(r, &ontology.Redundancy{
+			Type: &ontology.Redundancy_GeoRedundancy{
+				GeoRedundancy: &ontology.GeoRedundancy{
+					GeoLocations: append([]*ontology.GeoLocation{d.location()}, regions...),
+				},
+			},
+		})
+	}
+
+	return
+}
+
+func (d *databaseDiscovery) location() *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: d.awsConfig.cfg.Region,
+	}
+}
+
+func (*databaseDiscovery) labels(tags []typesRDS.Tag) (labels map[string]string) {
+	labels = map[string]string{}
+
+	for _, tag := range tags {
+		labels[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return
+}
+
+// arnify generates the ARN of an RDS resource
+func (d *databaseDiscovery) arnify(typ string, ID *string) string {
+	return "arn:aws:rds:" +
+		d.awsConfig.cfg.Region + ":" +
+		aws.ToString(d.awsConfig.accountID) +
+		":" + typ + ":" +
+		aws.ToString(ID)
+}
+
+// storageID returns the ID of the database storage that belongs to the database (instance or cluster) with the given
+// ARN. RDS does not expose the storage as a resource of its own, so we derive it from the database.
+func storageID(arn *string) string {
+	return aws.ToString(arn) + "/storage"
+}
+
+func ports(port *int32) []uint32 {
+	if port == nil {
+		return nil
+	}
+
+	return []uint32{uint32(*port)}
+}
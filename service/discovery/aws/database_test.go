@@ -0,0 +1,343 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	typesRDS "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	mockDBInstanceARN     = "arn:aws:rds:eu-central-1:MockAccountID1234:db:mock-db"
+	mockDBMemberARN       = "arn:aws:rds:eu-central-1:MockAccountID1234:db:mock-aurora-1"
+	mockDBClusterARN      = "arn:aws:rds:eu-central-1:MockAccountID1234:cluster:mock-aurora"
+	mockDBReplicaARN      = "arn:aws:rds:eu-west-1:MockAccountID1234:db:mock-db-replica"
+	mockDBKMSKeyARN       = "arn:aws:kms:eu-central-1:MockAccountID1234:key/mock-key"
+	mockDBParameterGroup  = "mock-postgres"
+	mockDBClusterParamGrp = "mock-aurora-mysql"
+)
+
+// mockRDSAPI implements the RDSAPI interface for mock testing
+type mockRDSAPI struct{}
+
+// mockRDSAPIWithErrors implements the RDSAPI interface (API call returning error) for mock testing
+type mockRDSAPIWithErrors struct{}
+
+func (mockRDSAPI) DescribeDBInstances(_ context.Context, _ *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return &rds.DescribeDBInstancesOutput{
+		DBInstances: []typesRDS.DBInstance{
+			{
+				DBInstanceArn:         aws.String(mockDBInstanceARN),
+				DBInstanceIdentifier:  aws.String("mock-db"),
+				InstanceCreateTime:    aws.Time(time.Unix(1, 0)),
+				StorageEncrypted:      aws.Bool(true),
+				KmsKeyId:              aws.String(mockDBKMSKeyARN),
+				BackupRetentionPeriod: aws.Int32(7),
+				MultiAZ:               aws.Bool(true),
+				PubliclyAccessible:    aws.Bool(true),
+				Endpoint:              &typesRDS.Endpoint{Port: aws.Int32(5432)},
+				DBParameterGroups: []typesRDS.DBParameterGroupStatus{
+					{DBParameterGroupName: aws.String(mockDBParameterGroup)},
+				},
+				ReadReplicaDBInstanceIdentifiers: []string{"mock-db-local-replica", mockDBReplicaARN},
+				TagList: []typesRDS.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				},
+			},
+			{
+				DBInstanceArn:        aws.String(mockDBMemberARN),
+				DBInstanceIdentifier: aws.String("mock-aurora-1"),
+				DBClusterIdentifier:  aws.String("mock-aurora"),
+			},
+		},
+	}, nil
+}
+
+func (mockRDSAPI) DescribeDBClusters(_ context.Context, _ *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return &rds.DescribeDBClustersOutput{
+		DBClusters: []typesRDS.DBCluster{
+			{
+				DBClusterArn:            aws.String(mockDBClusterARN),
+				DBClusterIdentifier:     aws.String("mock-aurora"),
+				StorageEncrypted:        aws.Bool(false),
+				BackupRetentionPeriod:   aws.Int32(0),
+				DBClusterParameterGroup: aws.String(mockDBClusterParamGrp),
+				Port:                    aws.Int32(3306),
+			},
+		},
+	}, nil
+}
+
+func (mockRDSAPI) DescribeDBParameters(_ context.Context, params *rds.DescribeDBParametersInput, _ ...func(*rds.Options)) (*rds.DescribeDBParametersOutput, error) {
+	// Simulate a second page of parameters containing the relevant one
+	if params.Marker == nil {
+		return &rds.DescribeDBParametersOutput{
+			Parameters: []typesRDS.Parameter{
+				{ParameterName: aws.String("max_connections"), ParameterValue: aws.String("100")},
+			},
+			Marker: aws.String("next"),
+		}, nil
+	}
+
+	return &rds.DescribeDBParametersOutput{
+		Parameters: []typesRDS.Parameter{
+			{ParameterName: aws.String("rds.force_ssl"), ParameterValue: aws.String("1")},
+		},
+	}, nil
+}
+
+func (mockRDSAPI) DescribeDBClusterParameters(_ context.Context, _ *rds.DescribeDBClusterParametersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClusterParametersOutput, error) {
+	return &rds.DescribeDBClusterParametersOutput{
+		Parameters: []typesRDS.Parameter{
+			{ParameterName: aws.String("require_secure_transport"), ParameterValue: aws.String("OFF")},
+		},
+	}, nil
+}
+
+func (mockRDSAPIWithErrors) DescribeDBInstances(_ context.Context, _ *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockRDSAPIWithErrors) DescribeDBClusters(_ context.Context, _ *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockRDSAPIWithErrors) DescribeDBParameters(_ context.Context, _ *rds.DescribeDBParametersInput, _ ...func(*rds.Options)) (*rds.DescribeDBParametersOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockRDSAPIWithErrors) DescribeDBClusterParameters(_ context.Context, _ *rds.DescribeDBClusterParametersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClusterParametersOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func TestDatabaseDiscovery_List(t *testing.T) {
+	d := databaseDiscovery{
+		databaseAPI:   mockRDSAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	list, err := d.List()
+	assert.NoError(t, err)
+	// cluster service + cluster storage + instance service + instance storage + member service
+	assert.Equal(t, 5, len(list))
+
+	d = databaseDiscovery{
+		databaseAPI: mockRDSAPIWithErrors{},
+	}
+	_, err = d.List()
+	assert.ErrorContains(t, err, "could not discover database clusters")
+}
+
+func TestDatabaseDiscovery_discoverInstances(t *testing.T) {
+	d := databaseDiscovery{
+		databaseAPI:   mockRDSAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	resources, err := d.discoverInstances()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(resources))
+
+	service, ok := resources[0].(*ontology.RelationalDatabaseService)
+	assert.True(t, ok)
+	assert.Equal(t, mockDBInstanceARN, service.Id)
+	assert.Equal(t, "mock-db", service.Name)
+	assert.True(t, service.InternetAccessibleEndpoint)
+	assert.True(t, service.TransportEncryption.Enforced)
+	assert.Equal(t, []uint32{5432}, service.Ports)
+	assert.Equal(t, []string{mockDBInstanceARN + "/storage"}, service.StorageIds)
+	assert.Equal(t, "prod", service.Labels["env"])
+
+	storage, ok := resources[1].(*ontology.DatabaseStorage)
+	assert.True(t, ok)
+	assert.Equal(t, mockDBInstanceARN+"/storage", storage.Id)
+	assert.Equal(t, mockDBInstanceARN, storage.GetParentId())
+	assert.Equal(t, mockDBKMSKeyARN, storage.AtRestEncryption.GetCustomerKeyEncryption().GetKeyUrl())
+	assert.True(t, storage.Backups[0].Enabled)
+	assert.Equal(t, 7*24*time.Hour, storage.Backups[0].RetentionPeriod.AsDuration())
+	assert.Equal(t, 2, len(storage.Redundancies))
+	assert.NotNil(t, storage.Redundancies[0].GetZoneRedundancy())
+	assert.Equal(t, "eu-west-1", storage.Redundancies[1].GetGeoRedundancy().GetGeoLocations()[1].GetRegion())
+
+	member, ok := resources[2].(*ontology.RelationalDatabaseService)
+	assert.True(t, ok)
+	assert.Equal(t, mockDBClusterARN, member.GetParentId())
+	assert.Equal(t, []string{mockDBClusterARN + "/storage"}, member.StorageIds)
+
+	d = databaseDiscovery{
+		databaseAPI: mockRDSAPIWithErrors{},
+	}
+	_, err = d.discoverInstances()
+	assert.Error(t, err)
+}
+
+func TestDatabaseDiscovery_discoverClusters(t *testing.T) {
+	d := databaseDiscovery{
+		databaseAPI:   mockRDSAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	resources, err := d.discoverClusters()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resources))
+
+	service, ok := resources[0].(*ontology.RelationalDatabaseService)
+	assert.True(t, ok)
+	assert.Equal(t, mockDBClusterARN, service.Id)
+	assert.False(t, service.TransportEncryption.Enforced)
+	assert.Equal(t, []uint32{3306}, service.Ports)
+
+	storage, ok := resources[1].(*ontology.DatabaseStorage)
+	assert.True(t, ok)
+	assert.False(t, storage.AtRestEncryption.GetManagedKeyEncryption().GetEnabled())
+	assert.False(t, storage.Backups[0].Enabled)
+	assert.Empty(t, storage.Redundancies)
+}
+
+func Test_forcesSSL(t *testing.T) {
+	tests := []struct {
+		name   string
+		params []typesRDS.Parameter
+		want   bool
+	}{
+		{
+			name: "PostgreSQL enforced",
+			params: []typesRDS.Parameter{
+				{ParameterName: aws.String("rds.force_ssl"), ParameterValue: aws.String("1")},
+			},
+			want: true,
+		},
+		{
+			name: "MySQL enforced",
+			params: []typesRDS.Parameter{
+				{ParameterName: aws.String("require_secure_transport"), ParameterValue: aws.String("ON")},
+			},
+			want: true,
+		},
+		{
+			name: "not enforced",
+			params: []typesRDS.Parameter{
+				{ParameterName: aws.String("rds.force_ssl"), ParameterValue: aws.String("0")},
+				{ParameterName: aws.String("other"), ParameterValue: aws.String("1")},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, forcesSSL(tt.params))
+		})
+	}
+}
+
+// mockRDSAPICounting counts the calls to DescribeDBParameters
+type mockRDSAPICounting struct {
+	mockRDSAPI
+	calls int
+}
+
+func (m *mockRDSAPICounting) DescribeDBParameters(ctx context.Context, params *rds.DescribeDBParametersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBParametersOutput, error) {
+	m.calls++
+	return m.mockRDSAPI.DescribeDBParameters(ctx, params, optFns...)
+}
+
+func TestDatabaseDiscovery_instanceForcesSSL_cached(t *testing.T) {
+	api := &mockRDSAPICounting{}
+	d := databaseDiscovery{databaseAPI: api}
+
+	instance := &typesRDS.DBInstance{
+		DBParameterGroups: []typesRDS.DBParameterGroupStatus{
+			{DBParameterGroupName: aws.String(mockDBParameterGroup)},
+		},
+	}
+
+	for range 3 {
+		forced, err := d.instanceForcesSSL(instance)
+		assert.NoError(t, err)
+		assert.True(t, forced)
+	}
+
+	// Two pages for the first lookup, none afterwards
+	assert.Equal(t, 2, api.calls)
+}
+
+func TestDatabaseDiscovery_Name(t *testing.T) {
+	d := databaseDiscovery{}
+	assert.Equal(t, "AWS Database", d.Name())
+}
+
+func TestNewAwsDatabaseDiscovery(t *testing.T) {
+	// Mock newFromConfigRDS and store the original function back at the end of the test
+	old := newFromConfigRDS
+	defer func() { newFromConfigRDS = old }()
+
+	newFromConfigRDS = func(cfg aws.Config, optFns ...func(*rds.Options)) *rds.Client {
+		return &rds.Client{}
+	}
+
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("1234"),
+	}
+
+	got := NewAwsDatabaseDiscovery(mockClient, testdata.MockTargetOfEvaluationID1)
+	assert.Equal(t, discovery.Discoverer(&databaseDiscovery{
+		databaseAPI:   &rds.Client{},
+		isDiscovering: true,
+		awsConfig:     mockClient,
+		ctID:          testdata.MockTargetOfEvaluationID1,
+	}), got, assert.CompareAllUnexported())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, got.TargetOfEvaluationID())
+}
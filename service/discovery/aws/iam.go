@@ -0,0 +1,659 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	typesIAM "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+const (
+	// LabelPasswordMinimumLength is the label that contains the minimum length of passwords required by the account
+	// password policy
+	LabelPasswordMinimumLength = "aws:password-minimum-length"
+
+	// LabelPasswordReusePrevention is the label that contains the number of previous passwords that cannot be reused
+	LabelPasswordReusePrevention = "aws:password-reuse-prevention"
+
+	// LabelPasswordMaxAgeDays is the label that contains the number of days after which passwords expire
+	LabelPasswordMaxAgeDays = "aws:password-max-age-days"
+)
+
+// mfaConditionKey is the condition key that is true, if the principal authenticated with MFA
+const mfaConditionKey = "aws:MultiFactorAuthPresent"
+
+// iamDiscovery handles the AWS API requests regarding the IAM service
+type iamDiscovery struct {
+	iamAPI        IAMAPI
+	isDiscovering bool
+	awsConfig     *Client
+	ctID          string
+}
+
+// IAMAPI describes the IAM api interface which is implemented by the official AWS client and mock clients in tests
+type IAMAPI interface {
+	GetAccountAuthorizationDetails(ctx context.Context,
+		params *iam.GetAccountAuthorizationDetailsInput,
+		optFns ...func(*iam.Options)) (*iam.GetAccountAuthorizationDetailsOutput, error)
+
+	GetAccountPasswordPolicy(ctx context.Context,
+		params *iam.GetAccountPasswordPolicyInput,
+		optFns ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+
+	ListMFADevices(ctx context.Context,
+		params *iam.ListMFADevicesInput,
+		optFns ...func(*iam.Options)) (*iam.ListMFADevicesOutput, error)
+
+	ListAccessKeys(ctx context.Context,
+		params *iam.ListAccessKeysInput,
+		optFns ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error)
+
+	GetAccessKeyLastUsed(ctx context.Context,
+		params *iam.GetAccessKeyLastUsedInput,
+		optFns ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error)
+}
+
+// newFromConfigIAM holds iam.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigIAM = iam.NewFromConfig
+
+// authorizationDetails holds the (paginated) result of GetAccountAuthorizationDetails
+type authorizationDetails struct {
+	users    []typesIAM.UserDetail
+	roles    []typesIAM.RoleDetail
+	groups   []typesIAM.GroupDetail
+	policies []typesIAM.ManagedPolicyDetail
+}
+
+// NewAwsIAMDiscovery constructs a new iamDiscovery initializing the iamAPI and isDiscovering with true
+func NewAwsIAMDiscovery(client *Client, TargetOfEvaluationID string) discovery.Discoverer {
+	return &iamDiscovery{
+		iamAPI:        newFromConfigIAM(client.cfg),
+		isDiscovering: true,
+		awsConfig:     client,
+		ctID:          TargetOfEvaluationID,
+	}
+}
+
+// Name is the method implementation defined in the discovery.Discoverer interface
+func (*iamDiscovery) Name() string {
+	return "AWS IAM"
+}
+
+// List is the method implementation defined in the discovery.Discoverer interface. IAM is a global service, therefore
+// the discovered resources do not have a geo location.
+func (d *iamDiscovery) List() (resources []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	details, err := d.authorizationDetails()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover IAM authorization details: %w", err)
+	}
+
+	passwordPolicy, err := d.discoverPasswordPolicy()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover password policy: %w", err)
+	}
+	if passwordPolicy != nil {
+		resources = append(resources, passwordPolicy)
+	}
+
+	policies := d.discoverPolicies(details.policies)
+	resources = append(resources, policies...)
+
+	groups := d.discoverGroups(details)
+	resources = append(resources, groups...)
+
+	users, err := d.discoverUsers(details, passwordPolicy != nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not discover users: %w", err)
+	}
+	resources = append(resources, users...)
+
+	roles := d.discoverRoles(details)
+	resources = append(resources, roles...)
+
+	return
+}
+
+func (d *iamDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// authorizationDetails retrieves all users, roles, groups and managed policies (including their policy documents) of
+// the account
+func (d *iamDiscovery) authorizationDetails() (details *authorizationDetails, err error) {
+	var (
+		resp   *iam.GetAccountAuthorizationDetailsOutput
+		marker *string
+	)
+
+	details = &authorizationDetails{}
+
+	for {
+		resp, err = d.iamAPI.GetAccountAuthorizationDetails(context.TODO(), &iam.GetAccountAuthorizationDetailsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		details.users = append(details.users, resp.UserDetailList...)
+		details.roles = append(details.roles, resp.RoleDetailList...)
+		details.groups = append(details.groups, resp.GroupDetailList...)
+		details.policies = append(details.policies, resp.Policies...)
+
+		if marker = resp.Marker; !resp.IsTruncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverPasswordPolicy discovers the password policy of the account. It returns nil, if no password policy is set.
+// The minimum length, reuse prevention and expiry of passwords are added as labels.
+func (d *iamDiscovery) discoverPasswordPolicy() (*ontology.PolicyDocument, error) {
+	var noSuchEntity *typesIAM.NoSuchEntityException
+
+	resp, err := d.iamAPI.GetAccountPasswordPolicy(context.TODO(), &iam.GetAccountPasswordPolicyInput{})
+	if errors.As(err, &noSuchEntity) {
+		return nil, nil
+	} else if err != nil {
+		return nil, prettyError(err)
+	}
+
+	return &ontology.PolicyDocument{
+		Id:       d.arnify("account-password-policy"),
+		Name:     "Account password policy",
+		Filetype: "JSON",
+		Labels:   passwordPolicyLabels(resp.PasswordPolicy),
+		Raw:      discovery.Raw(resp.PasswordPolicy),
+	}, nil
+}
+
+// passwordPolicyLabels returns the labels of the password policy. Passwords do not expire and can be reused, if the
+// respective setting is missing.
+func passwordPolicyLabels(policy *typesIAM.PasswordPolicy) (labels map[string]string) {
+	labels = map[string]string{}
+
+	if policy == nil {
+		return
+	}
+
+	if policy.MinimumPasswordLength != nil {
+		labels[LabelPasswordMinimumLength] = strconv.Itoa(int(*policy.MinimumPasswordLength))
+	}
+
+	labels[LabelPasswordReusePrevention] = strconv.Itoa(int(aws.ToInt32(policy.PasswordReusePrevention)))
+
+	if policy.ExpirePasswords {
+		labels[LabelPasswordMaxAgeDays] = strconv.Itoa(int(aws.ToInt32(policy.MaxPasswordAge)))
+	}
+
+	return
+}
+
+// discoverPolicies maps all managed policies that are attached to at least one user, role or group to policy
+// documents. AWS provides several hundred managed policies, therefore unused ones are skipped.
+func (d *iamDiscovery) discoverPolicies(policies []typesIAM.ManagedPolicyDetail) (resources []ontology.IsResource) {
+	for i := range policies {
+		policy := &policies[i]
+
+		if util.Deref(policy.AttachmentCount) == 0 {
+			continue
+		}
+
+		resources = append(resources, &ontology.PolicyDocument{
+			Id:           aws.ToString(policy.Arn),
+			Name:         aws.ToString(policy.PolicyName),
+			Description:  aws.ToString(policy.Description),
+			CreationTime: timestamppb.New(util.Deref(policy.CreateDate)),
+			Filetype:     "JSON",
+			LeastPrivilegePolicy: &ontology.LeastPrivilegePolicy{
+				IsDefined: !grantsFullAccess(defaultPolicyDocument(policy)),
+			},
+			Raw: discovery.Raw(policy),
+		})
+	}
+
+	return
+}
+
+// discoverGroups maps the IAM groups to identities together with the role assignments of their policies
+func (d *iamDiscovery) discoverGroups(details *authorizationDetails) (resources []ontology.IsResource) {
+	for i := range details.groups {
+		group := &details.groups[i]
+
+		resources = append(resources, &ontology.Identity{
+			Id:           aws.ToString(group.Arn),
+			Name:         aws.ToString(group.GroupName),
+			CreationTime: timestamppb.New(util.Deref(group.CreateDate)),
+			Activated:    true,
+			Privileged:   d.privileged(details, group.AttachedManagedPolicies, group.GroupPolicyList),
+			Raw:          discovery.Raw(group),
+		})
+
+		resources = append(resources, d.roleAssignments(group.Arn, group.AttachedManagedPolicies, group.GroupPolicyList)...)
+	}
+
+	return
+}
+
+// discoverUsers maps the IAM users to identities together with the role assignments of their policies and their
+// access keys. An IAM user is privileged if it has full access, either directly or by one of its groups.
+func (d *iamDiscovery) discoverUsers(details *authorizationDetails, hasPasswordPolicy bool) (resources []ontology.IsResource, err error) {
+	for i := range details.users {
+		user := &details.users[i]
+
+		mfaDevices, err := d.mfaDevices(user.UserName)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, lastActivity, err := d.accessKeys(user)
+		if err != nil {
+			return nil, err
+		}
+
+		privileged := d.privileged(details, user.AttachedManagedPolicies, user.UserPolicyList)
+		for _, name := range user.GroupList {
+			group := details.group(name)
+			if group != nil && d.privileged(details, group.AttachedManagedPolicies, group.GroupPolicyList) {
+				privileged = true
+			}
+		}
+
+		identity := &ontology.Identity{
+			Id:                    aws.ToString(user.Arn),
+			Name:                  aws.ToString(user.UserName),
+			CreationTime:          timestamppb.New(util.Deref(user.CreateDate)),
+			Labels:                d.labels(user.Tags),
+			Activated:             true,
+			Privileged:            privileged,
+			EnforceMfa:            len(mfaDevices) > 0,
+			DisablePasswordPolicy: !hasPasswordPolicy,
+			Raw:                   discovery.Raw(user, mfaDevices),
+		}
+
+		if lastActivity != nil {
+			identity.LastActivity = timestamppb.New(*lastActivity)
+		}
+
+		resources = append(resources, identity)
+		resources = append(resources, d.roleAssignments(user.Arn, user.AttachedManagedPolicies, user.UserPolicyList)...)
+		resources = append(resources, keys...)
+	}
+
+	return
+}
+
+// discoverRoles maps the IAM roles to identities together with the role assignments of their policies. A role enforces
+// MFA if its trust policy only allows MFA authenticated principals to assume it.
+func (d *iamDiscovery) discoverRoles(details *authorizationDetails) (resources []ontology.IsResource) {
+	for i := range details.roles {
+		role := &details.roles[i]
+
+		identity := &ontology.Identity{
+			Id:           aws.ToString(role.Arn),
+			Name:         aws.ToString(role.RoleName),
+			Description:  "IAM role",
+			CreationTime: timestamppb.New(util.Deref(role.CreateDate)),
+			Labels:       d.labels(role.Tags),
+			Activated:    true,
+			Privileged:   d.privileged(details, role.AttachedManagedPolicies, role.RolePolicyList),
+			EnforceMfa:   requiresMFA(decodePolicyDocument(role.AssumeRolePolicyDocument)),
+			Raw:          discovery.Raw(role),
+		}
+
+		if role.RoleLastUsed != nil && role.RoleLastUsed.LastUsedDate != nil {
+			identity.LastActivity = timestamppb.New(*role.RoleLastUsed.LastUsedDate)
+		}
+
+		resources = append(resources, identity)
+		resources = append(resources, d.roleAssignments(role.Arn, role.AttachedManagedPolicies, role.RolePolicyList)...)
+	}
+
+	return
+}
+
+// roleAssignments returns a role assignment for each managed policy that is attached to the principal (user, role or
+// group) as well as the inline policies of the principal. The inline policies are also returned as policy documents,
+// since they are not part of the managed policies.
+func (d *iamDiscovery) roleAssignments(principalARN *string, attached []typesIAM.AttachedPolicy, inline []typesIAM.PolicyDetail) (resources []ontology.IsResource) {
+	for _, policy := range attached {
+		resources = append(resources, &ontology.RoleAssignment{
+			Id:          aws.ToString(principalARN) + "/attached-policy/" + aws.ToString(policy.PolicyArn),
+			Name:        aws.ToString(policy.PolicyName),
+			Description: fmt.Sprintf("Policy %s attached to %s", aws.ToString(policy.PolicyArn), aws.ToString(principalARN)),
+			Activated:   true,
+			ParentId:    principalARN,
+			Raw:         discovery.Raw(&policy),
+		})
+	}
+
+	for _, policy := range inline {
+		document := &ontology.PolicyDocument{
+			Id:       aws.ToString(principalARN) + "/inline-policy/" + aws.ToString(policy.PolicyName),
+			Name:     aws.ToString(policy.PolicyName),
+			Filetype: "JSON",
+			LeastPrivilegePolicy: &ontology.LeastPrivilegePolicy{
+				IsDefined: !grantsFullAccess(decodePolicyDocument(policy.PolicyDocument)),
+			},
+			ParentId: principalARN,
+			Raw:      discovery.Raw(&policy),
+		}
+
+		resources = append(resources, document, &ontology.RoleAssignment{
+			Id:          aws.ToString(principalARN) + "/inline-policy-assignment/" + aws.ToString(policy.PolicyName),
+			Name:        aws.ToString(policy.PolicyName),
+			Description: fmt.Sprintf("Inline policy %s of %s", aws.ToString(policy.PolicyName), aws.ToString(principalARN)),
+			Activated:   true,
+			ParentId:    principalARN,
+			Raw:         discovery.Raw(&policy),
+		})
+	}
+
+	return
+}
+
+// mfaDevices returns the MFA devices assigned to the user
+func (d *iamDiscovery) mfaDevices(userName *string) (devices []typesIAM.MFADevice, err error) {
+	var (
+		resp   *iam.ListMFADevicesOutput
+		marker *string
+	)
+
+	for {
+		resp, err = d.iamAPI.ListMFADevices(context.TODO(), &iam.ListMFADevicesInput{
+			UserName: userName,
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		devices = append(devices, resp.MFADevices...)
+
+		if marker = resp.Marker; !resp.IsTruncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// accessKeys maps the access keys of the user to keys. The creation time of a key reflects its age. Additionally, the
+// last time one of the keys was used is returned, which is the last activity of the user.
+func (d *iamDiscovery) accessKeys(user *typesIAM.UserDetail) (resources []ontology.IsResource, lastActivity *time.Time, err error) {
+	var (
+		resp   *iam.ListAccessKeysOutput
+		marker *string
+	)
+
+	for {
+		resp, err = d.iamAPI.ListAccessKeys(context.TODO(), &iam.ListAccessKeysInput{
+			UserName: user.UserName,
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, nil, prettyError(err)
+		}
+
+		for i := range resp.AccessKeyMetadata {
+			key := &resp.AccessKeyMetadata[i]
+
+			used, err := d.iamAPI.GetAccessKeyLastUsed(context.TODO(), &iam.GetAccessKeyLastUsedInput{
+				AccessKeyId: key.AccessKeyId,
+			})
+			if err != nil {
+				return nil, nil, prettyError(err)
+			}
+
+			if used.AccessKeyLastUsed != nil && used.AccessKeyLastUsed.LastUsedDate != nil &&
+				(lastActivity == nil || used.AccessKeyLastUsed.LastUsedDate.After(*lastActivity)) {
+				lastActivity = used.AccessKeyLastUsed.LastUsedDate
+			}
+
+			resources = append(resources, &ontology.Key{
+				Id:           aws.ToString(user.Arn) + "/access-key/" + aws.ToString(key.AccessKeyId),
+				Name:         aws.ToString(key.AccessKeyId),
+				Description:  "IAM access key",
+				CreationTime: timestamppb.New(util.Deref(key.CreateDate)),
+				Enabled:      key.Status == typesIAM.StatusTypeActive,
+				ParentId:     user.Arn,
+				Raw:          discovery.Raw(key, used.AccessKeyLastUsed),
+			})
+		}
+
+		if marker = resp.Marker; !resp.IsTruncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// privileged checks whether one of the attached managed policies or the inline policies grants full access
+func (*iamDiscovery) privileged(details *authorizationDetails, attached []typesIAM.AttachedPolicy, inline []typesIAM.PolicyDetail) bool {
+	for _, a := range attached {
+		policy := details.policy(a.PolicyArn)
+		if policy != nil && grantsFullAccess(defaultPolicyDocument(policy)) {
+			return true
+		}
+	}
+
+	for _, policy := range inline {
+		if grantsFullAccess(decodePolicyDocument(policy.PolicyDocument)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (details *authorizationDetails) group(name string) *typesIAM.GroupDetail {
+	for i := range details.groups {
+		if aws.ToString(details.groups[i].GroupName) == name {
+			return &details.groups[i]
+		}
+	}
+
+	return nil
+}
+
+func (details *authorizationDetails) policy(arn *string) *typesIAM.ManagedPolicyDetail {
+	for i := range details.policies {
+		if aws.ToString(details.policies[i].Arn) == aws.ToString(arn) {
+			return &details.policies[i]
+		}
+	}
+
+	return nil
+}
+
+// defaultPolicyDocument returns the decoded document of the default version of the managed policy
+func defaultPolicyDocument(policy *typesIAM.ManagedPolicyDetail) string {
+	for _, version := range policy.PolicyVersionList {
+		if version.IsDefaultVersion {
+			return decodePolicyDocument(version.Document)
+		}
+	}
+
+	return ""
+}
+
+// decodePolicyDocument decodes a policy document, which IAM returns URL-encoded
+func decodePolicyDocument(document *string) string {
+	decoded, err := url.PathUnescape(aws.ToString(document))
+	if err != nil {
+		return aws.ToString(document)
+	}
+
+	return decoded
+}
+
+// policyStatements holds the statements of a policy document. A policy document either contains a single statement or
+// a list of statements.
+type policyStatements []policyStatement
+
+type policyStatement struct {
+	Effect   string
+	Action   stringOrList
+	Resource stringOrList
+	// Condition maps condition operators (e.g., "Bool") to condition keys and their values, which are either a string,
+	// a boolean or a list of these
+	Condition map[string]map[string]any
+}
+
+// stringOrList holds a policy element that is either a single string or a list of strings
+type stringOrList []string
+
+func (s *policyStatements) UnmarshalJSON(b []byte) error {
+	var statement policyStatement
+
+	if err := json.Unmarshal(b, &statement); err == nil {
+		*s = policyStatements{statement}
+		return nil
+	}
+
+	return json.Unmarshal(b, (*[]policyStatement)(s))
+}
+
+func (s *stringOrList) UnmarshalJSON(b []byte) error {
+	var str string
+
+	if err := json.Unmarshal(b, &str); err == nil {
+		*s = stringOrList{str}
+		return nil
+	}
+
+	return json.Unmarshal(b, (*[]string)(s))
+}
+
+// grantsFullAccess checks whether the policy document allows all actions on all resources, such as the
+// AdministratorAccess policy
+func grantsFullAccess(document string) bool {
+	var doc struct {
+		Statement policyStatements
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return false
+	}
+
+	for _, statement := range doc.Statement {
+		if statement.Effect == "Allow" && slices.Contains(statement.Action, "*") && slices.Contains(statement.Resource, "*") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiresMFA checks whether the (trust) policy document only allows MFA authenticated principals, i.e., each statement
+// that allows access requires the condition key aws:MultiFactorAuthPresent to be true. The operator BoolIfExists is not
+// sufficient, since the key is missing for requests signed with long-term access keys.
+func requiresMFA(document string) bool {
+	var (
+		doc struct {
+			Statement policyStatements
+		}
+		allowed bool
+	)
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return false
+	}
+
+	for _, statement := range doc.Statement {
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		allowed = true
+
+		if !conditionTrue(statement.Condition["Bool"], mfaConditionKey) {
+			return false
+		}
+	}
+
+	return allowed
+}
+
+// conditionTrue checks whether all values of the condition key (which is case-insensitive) are true
+func conditionTrue(condition map[string]any, key string) bool {
+	for k, v := range condition {
+		if !strings.EqualFold(k, key) {
+			continue
+		}
+
+		values, ok := v.([]any)
+		if !ok {
+			values = []any{v}
+		}
+
+		return len(values) > 0 && !slices.ContainsFunc(values, func(value any) bool {
+			return !strings.EqualFold(fmt.Sprint(value), "true")
+		})
+	}
+
+	return false
+}
+
+func (*iamDiscovery) labels(tags []typesIAM.Tag) (labels map[string]string) {
+	labels = map[string]string{}
+
+	for _, tag := range tags {
+		labels[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return
+}
+
+// arnify generates the ARN of an IAM resource. IAM is a global service, so its ARNs do not contain a region.
+func (d *iamDiscovery) arnify(resource string) string {
+	return "arn:aws:iam::" +
+		aws.ToString(d.awsConfig.accountID) +
+		":" + resource
+}
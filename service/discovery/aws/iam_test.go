@@ -0,0 +1,434 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	typesIAM "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	mockIAMUserARN     = "arn:aws:iam::MockAccountID1234:user/mock-user"
+	mockIAMAdminARN    = "arn:aws:iam::MockAccountID1234:user/mock-admin"
+	mockIAMRoleARN     = "arn:aws:iam::MockAccountID1234:role/mock-role"
+	mockIAMGroupARN    = "arn:aws:iam::MockAccountID1234:group/mock-admins"
+	mockIAMAdminPolicy = "arn:aws:iam::aws:policy/AdministratorAccess"
+	mockIAMReadPolicy  = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	mockIAMUnusedPol   = "arn:aws:iam::aws:policy/AmazonS3FullAccess"
+)
+
+var (
+	mockIAMAdminDocument = url.PathEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`)
+	mockIAMReadDocument  = url.PathEscape(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":["s3:Get*","ec2:Describe*"],"Resource":"*"}}`)
+	mockIAMTrustDocument = url.PathEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::MockAccountID1234:root"},"Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}}]}`)
+)
+
+// mockIAMAPI implements the IAMAPI interface for mock testing
+type mockIAMAPI struct{}
+
+// mockIAMAPIWithErrors implements the IAMAPI interface (API call returning error) for mock testing
+type mockIAMAPIWithErrors struct{}
+
+// mockIAMAPIWithoutPasswordPolicy implements the IAMAPI interface for an account without a password policy
+type mockIAMAPIWithoutPasswordPolicy struct {
+	mockIAMAPI
+}
+
+func (mockIAMAPI) GetAccountAuthorizationDetails(_ context.Context, _ *iam.GetAccountAuthorizationDetailsInput, _ ...func(*iam.Options)) (*iam.GetAccountAuthorizationDetailsOutput, error) {
+	return &iam.GetAccountAuthorizationDetailsOutput{
+		UserDetailList: []typesIAM.UserDetail{
+			{
+				Arn:        aws.String(mockIAMUserARN),
+				UserName:   aws.String("mock-user"),
+				CreateDate: aws.Time(time.Unix(1, 0)),
+				AttachedManagedPolicies: []typesIAM.AttachedPolicy{
+					{PolicyArn: aws.String(mockIAMReadPolicy), PolicyName: aws.String("ReadOnlyAccess")},
+				},
+				Tags: []typesIAM.Tag{
+					{Key: aws.String("team"), Value: aws.String("dev")},
+				},
+			},
+			{
+				Arn:       aws.String(mockIAMAdminARN),
+				UserName:  aws.String("mock-admin"),
+				GroupList: []string{"mock-admins"},
+			},
+		},
+		RoleDetailList: []typesIAM.RoleDetail{
+			{
+				Arn:                      aws.String(mockIAMRoleARN),
+				RoleName:                 aws.String("mock-role"),
+				AssumeRolePolicyDocument: aws.String(mockIAMTrustDocument),
+				RoleLastUsed:             &typesIAM.RoleLastUsed{LastUsedDate: aws.Time(time.Unix(3, 0))},
+				RolePolicyList: []typesIAM.PolicyDetail{
+					{PolicyName: aws.String("inline-admin"), PolicyDocument: aws.String(mockIAMAdminDocument)},
+				},
+			},
+		},
+		GroupDetailList: []typesIAM.GroupDetail{
+			{
+				Arn:       aws.String(mockIAMGroupARN),
+				GroupName: aws.String("mock-admins"),
+				AttachedManagedPolicies: []typesIAM.AttachedPolicy{
+					{PolicyArn: aws.String(mockIAMAdminPolicy), PolicyName: aws.String("AdministratorAccess")},
+				},
+			},
+		},
+		Policies: []typesIAM.ManagedPolicyDetail{
+			{
+				Arn:             aws.String(mockIAMAdminPolicy),
+				PolicyName:      aws.String("AdministratorAccess"),
+				AttachmentCount: aws.Int32(1),
+				PolicyVersionList: []typesIAM.PolicyVersion{
+					{Document: aws.String(mockIAMReadDocument), IsDefaultVersion: false},
+					{Document: aws.String(mockIAMAdminDocument), IsDefaultVersion: true},
+				},
+			},
+			{
+				Arn:             aws.String(mockIAMReadPolicy),
+				PolicyName:      aws.String("ReadOnlyAccess"),
+				AttachmentCount: aws.Int32(1),
+				PolicyVersionList: []typesIAM.PolicyVersion{
+					{Document: aws.String(mockIAMReadDocument), IsDefaultVersion: true},
+				},
+			},
+			{
+				Arn:             aws.String(mockIAMUnusedPol),
+				PolicyName:      aws.String("AmazonS3FullAccess"),
+				AttachmentCount: aws.Int32(0),
+			},
+		},
+	}, nil
+}
+
+func (mockIAMAPI) GetAccountPasswordPolicy(_ context.Context, _ *iam.GetAccountPasswordPolicyInput, _ ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error) {
+	return &iam.GetAccountPasswordPolicyOutput{
+		PasswordPolicy: &typesIAM.PasswordPolicy{
+			MinimumPasswordLength:   aws.Int32(14),
+			RequireSymbols:          true,
+			PasswordReusePrevention: aws.Int32(24),
+			ExpirePasswords:         true,
+			MaxPasswordAge:          aws.Int32(90),
+		},
+	}, nil
+}
+
+func (mockIAMAPI) ListMFADevices(_ context.Context, params *iam.ListMFADevicesInput, _ ...func(*iam.Options)) (*iam.ListMFADevicesOutput, error) {
+	if aws.ToString(params.UserName) != "mock-admin" {
+		return &iam.ListMFADevicesOutput{}, nil
+	}
+
+	return &iam.ListMFADevicesOutput{
+		MFADevices: []typesIAM.MFADevice{
+			{SerialNumber: aws.String("arn:aws:iam::MockAccountID1234:mfa/mock-admin"), UserName: params.UserName},
+		},
+	}, nil
+}
+
+func (mockIAMAPI) ListAccessKeys(_ context.Context, params *iam.ListAccessKeysInput, _ ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error) {
+	if aws.ToString(params.UserName) != "mock-user" {
+		return &iam.ListAccessKeysOutput{}, nil
+	}
+
+	return &iam.ListAccessKeysOutput{
+		AccessKeyMetadata: []typesIAM.AccessKeyMetadata{
+			{AccessKeyId: aws.String("AKIAOLD"), CreateDate: aws.Time(time.Unix(1, 0)), Status: typesIAM.StatusTypeInactive, UserName: params.UserName},
+			{AccessKeyId: aws.String("AKIANEW"), CreateDate: aws.Time(time.Unix(2, 0)), Status: typesIAM.StatusTypeActive, UserName: params.UserName},
+		},
+	}, nil
+}
+
+func (mockIAMAPI) GetAccessKeyLastUsed(_ context.Context, params *iam.GetAccessKeyLastUsedInput, _ ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error) {
+	if aws.ToString(params.AccessKeyId) == "AKIAOLD" {
+		return &iam.GetAccessKeyLastUsedOutput{AccessKeyLastUsed: &typesIAM.AccessKeyLastUsed{}}, nil
+	}
+
+	return &iam.GetAccessKeyLastUsedOutput{
+		AccessKeyLastUsed: &typesIAM.AccessKeyLastUsed{LastUsedDate: aws.Time(time.Unix(5, 0))},
+	}, nil
+}
+
+func (mockIAMAPIWithoutPasswordPolicy) GetAccountPasswordPolicy(_ context.Context, _ *iam.GetAccountPasswordPolicyInput, _ ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error) {
+	return nil, &typesIAM.NoSuchEntityException{Message: aws.String("The Password Policy with domain name MockAccountID1234 cannot be found.")}
+}
+
+func (mockIAMAPIWithErrors) GetAccountAuthorizationDetails(_ context.Context, _ *iam.GetAccountAuthorizationDetailsInput, _ ...func(*iam.Options)) (*iam.GetAccountAuthorizationDetailsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockIAMAPIWithErrors) GetAccountPasswordPolicy(_ context.Context, _ *iam.GetAccountPasswordPolicyInput, _ ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockIAMAPIWithErrors) ListMFADevices(_ context.Context, _ *iam.ListMFADevicesInput, _ ...func(*iam.Options)) (*iam.ListMFADevicesOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockIAMAPIWithErrors) ListAccessKeys(_ context.Context, _ *iam.ListAccessKeysInput, _ ...func(*iam.Options)) (*iam.ListAccessKeysOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockIAMAPIWithErrors) GetAccessKeyLastUsed(_ context.Context, _ *iam.GetAccessKeyLastUsedInput, _ ...func(*iam.Options)) (*iam.GetAccessKeyLastUsedOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func TestIAMDiscovery_List(t *testing.T) {
+	d := iamDiscovery{
+		iamAPI:        mockIAMAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	list, err := d.List()
+	assert.NoError(t, err)
+
+	identities := map[string]*ontology.Identity{}
+	documents := map[string]*ontology.PolicyDocument{}
+	assignments := map[string]*ontology.RoleAssignment{}
+	keys := map[string]*ontology.Key{}
+	for _, r := range list {
+		switch v := r.(type) {
+		case *ontology.Identity:
+			identities[v.Id] = v
+		case *ontology.PolicyDocument:
+			documents[v.Id] = v
+		case *ontology.RoleAssignment:
+			assignments[v.Id] = v
+		case *ontology.Key:
+			keys[v.Id] = v
+		}
+	}
+
+	// password policy + 2 attached managed policies + 1 inline policy
+	assert.Equal(t, 4, len(documents))
+	assert.Equal(t, map[string]string{
+		LabelPasswordMinimumLength:   "14",
+		LabelPasswordReusePrevention: "24",
+		LabelPasswordMaxAgeDays:      "90",
+	}, documents["arn:aws:iam::MockAccountID1234:account-password-policy"].GetLabels())
+	assert.False(t, documents[mockIAMAdminPolicy].LeastPrivilegePolicy.IsDefined)
+	assert.True(t, documents[mockIAMReadPolicy].LeastPrivilegePolicy.IsDefined)
+	assert.Nil(t, documents[mockIAMUnusedPol])
+	assert.Equal(t, mockIAMRoleARN, documents[mockIAMRoleARN+"/inline-policy/inline-admin"].GetParentId())
+
+	// group, 2 users and role
+	assert.Equal(t, 4, len(identities))
+
+	user := identities[mockIAMUserARN]
+	assert.False(t, user.Privileged)
+	assert.False(t, user.EnforceMfa)
+	assert.False(t, user.DisablePasswordPolicy)
+	assert.Equal(t, "dev", user.Labels["team"])
+	assert.Equal(t, time.Unix(5, 0).UTC(), user.LastActivity.AsTime())
+
+	admin := identities[mockIAMAdminARN]
+	assert.True(t, admin.Privileged)
+	assert.True(t, admin.EnforceMfa)
+	assert.Nil(t, admin.LastActivity)
+
+	assert.True(t, identities[mockIAMGroupARN].Privileged)
+
+	role := identities[mockIAMRoleARN]
+	assert.True(t, role.Privileged)
+	assert.True(t, role.EnforceMfa)
+	assert.Equal(t, time.Unix(3, 0).UTC(), role.LastActivity.AsTime())
+
+	assert.Equal(t, 3, len(assignments))
+	assert.Equal(t, mockIAMGroupARN, assignments[mockIAMGroupARN+"/attached-policy/"+mockIAMAdminPolicy].GetParentId())
+	assert.Equal(t, mockIAMUserARN, assignments[mockIAMUserARN+"/attached-policy/"+mockIAMReadPolicy].GetParentId())
+
+	assert.Equal(t, 2, len(keys))
+	key := keys[mockIAMUserARN+"/access-key/AKIAOLD"]
+	assert.False(t, key.Enabled)
+	assert.Equal(t, time.Unix(1, 0).UTC(), key.CreationTime.AsTime())
+	assert.Equal(t, mockIAMUserARN, key.GetParentId())
+	assert.True(t, keys[mockIAMUserARN+"/access-key/AKIANEW"].Enabled)
+
+	d = iamDiscovery{
+		iamAPI: mockIAMAPIWithErrors{},
+	}
+	_, err = d.List()
+	assert.ErrorContains(t, err, "could not discover IAM authorization details")
+}
+
+func TestIAMDiscovery_discoverPasswordPolicy(t *testing.T) {
+	d := iamDiscovery{
+		iamAPI: mockIAMAPIWithoutPasswordPolicy{},
+		awsConfig: &Client{
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	policy, err := d.discoverPasswordPolicy()
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	// Users of an account without password policy have the password policy disabled
+	resources, err := d.discoverUsers(&authorizationDetails{
+		users: []typesIAM.UserDetail{{Arn: aws.String(mockIAMAdminARN), UserName: aws.String("mock-admin")}},
+	}, false)
+	assert.NoError(t, err)
+	assert.True(t, resources[0].(*ontology.Identity).DisablePasswordPolicy)
+
+	d.iamAPI = mockIAMAPIWithErrors{}
+	_, err = d.discoverPasswordPolicy()
+	assert.ErrorContains(t, err, "Internal Server Error")
+}
+
+func Test_grantsFullAccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     bool
+	}{
+		{
+			name:     "full access",
+			document: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			want:     true,
+		},
+		{
+			name:     "full access in single statement with lists",
+			document: `{"Statement":{"Effect":"Allow","Action":["s3:*","*"],"Resource":["*"]}}`,
+			want:     true,
+		},
+		{
+			name:     "denied",
+			document: `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*"}]}`,
+			want:     false,
+		},
+		{
+			name:     "restricted",
+			document: `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+			want:     false,
+		},
+		{
+			name:     "invalid",
+			document: `not a policy`,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, grantsFullAccess(tt.document))
+		})
+	}
+}
+
+func Test_requiresMFA(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     bool
+	}{
+		{
+			name:     "MFA required",
+			document: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":true}}}]}`,
+			want:     true,
+		},
+		{
+			name:     "MFA required in single statement with list",
+			document: `{"Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Condition":{"Bool":{"aws:multifactorauthpresent":["true"]}}}}`,
+			want:     true,
+		},
+		{
+			name:     "MFA must not be present",
+			document: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"false"}}}]}`,
+			want:     false,
+		},
+		{
+			name:     "MFA only required if the key exists",
+			document: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Condition":{"BoolIfExists":{"aws:MultiFactorAuthPresent":"true"}}}]}`,
+			want:     false,
+		},
+		{
+			name:     "MFA mentioned in a deny statement only",
+			document: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"},{"Effect":"Deny","Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}}]}`,
+			want:     false,
+		},
+		{
+			name:     "no statement",
+			document: `{"Statement":[]}`,
+			want:     false,
+		},
+		{
+			name:     "invalid",
+			document: `not a policy`,
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requiresMFA(tt.document))
+		})
+	}
+}
+
+func TestIAMDiscovery_Name(t *testing.T) {
+	d := iamDiscovery{}
+	assert.Equal(t, "AWS IAM", d.Name())
+}
+
+func TestNewAwsIAMDiscovery(t *testing.T) {
+	// Mock newFromConfigIAM and store the original function back at the end of the test
+	old := newFromConfigIAM
+	defer func() { newFromConfigIAM = old }()
+
+	newFromConfigIAM = func(cfg aws.Config, optFns ...func(*iam.Options)) *iam.Client {
+		return &iam.Client{}
+	}
+
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("1234"),
+	}
+
+	got := NewAwsIAMDiscovery(mockClient, testdata.MockTargetOfEvaluationID1)
+	assert.Equal(t, discovery.Discoverer(&iamDiscovery{
+		iamAPI:        &iam.Client{},
+		isDiscovering: true,
+		awsConfig:     mockClient,
+		ctID:          testdata.MockTargetOfEvaluationID1,
+	}), got, assert.CompareAllUnexported())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, got.TargetOfEvaluationID())
+}
@@ -0,0 +1,429 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	typesKMS "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	typesSecretsManager "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// keyDiscovery handles the AWS API requests regarding the key management services (KMS and Secrets Manager)
+type keyDiscovery struct {
+	kmsAPI        KMSAPI
+	secretsAPI    SecretsManagerAPI
+	isDiscovering bool
+	awsConfig     *Client
+	ctID          string
+}
+
+// KMSAPI describes the KMS api interface which is implemented by the official AWS client and mock clients in tests
+type KMSAPI interface {
+	ListKeys(ctx context.Context,
+		params *kms.ListKeysInput,
+		optFns ...func(*kms.Options)) (*kms.ListKeysOutput, error)
+
+	ListAliases(ctx context.Context,
+		params *kms.ListAliasesInput,
+		optFns ...func(*kms.Options)) (*kms.ListAliasesOutput, error)
+
+	DescribeKey(ctx context.Context,
+		params *kms.DescribeKeyInput,
+		optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+
+	GetKeyRotationStatus(ctx context.Context,
+		params *kms.GetKeyRotationStatusInput,
+		optFns ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error)
+
+	ListResourceTags(ctx context.Context,
+		params *kms.ListResourceTagsInput,
+		optFns ...func(*kms.Options)) (*kms.ListResourceTagsOutput, error)
+}
+
+// SecretsManagerAPI describes the Secrets Manager api interface which is implemented by the official AWS client and
+// mock clients in tests
+type SecretsManagerAPI interface {
+	ListSecrets(ctx context.Context,
+		params *secretsmanager.ListSecretsInput,
+		optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+}
+
+// newFromConfigKMS holds kms.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigKMS = kms.NewFromConfig
+
+// newFromConfigSecretsManager holds secretsmanager.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigSecretsManager = secretsmanager.NewFromConfig
+
+// NewAwsKeyDiscovery constructs a new keyDiscovery initializing the kmsAPI, secretsAPI and isDiscovering with true
+func NewAwsKeyDiscovery(client *Client, TargetOfEvaluationID string) discovery.Discoverer {
+	return &keyDiscovery{
+		kmsAPI:        newFromConfigKMS(client.cfg),
+		secretsAPI:    newFromConfigSecretsManager(client.cfg),
+		isDiscovering: true,
+		awsConfig:     client,
+		ctID:          TargetOfEvaluationID,
+	}
+}
+
+// Name is the method implementation defined in the discovery.Discoverer interface
+func (*keyDiscovery) Name() string {
+	return "AWS Key Management"
+}
+
+// List is the method implementation defined in the discovery.Discoverer interface. KMS and Secrets Manager do not have
+// a resource that holds the keys or secrets, so each service (in the current region) is mapped to a key vault that is
+// the parent of its keys or secrets.
+func (d *keyDiscovery) List() (resources []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	keys, err := d.discoverKeys()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover keys: %w", err)
+	}
+	resources = append(resources, d.keyVault(d.kmsVaultID(), "KMS", keys))
+	resources = append(resources, keys...)
+
+	secrets, err := d.discoverSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover secrets: %w", err)
+	}
+	resources = append(resources, d.keyVault(d.secretsVaultID(), "Secrets Manager", secrets))
+	resources = append(resources, secrets...)
+
+	return
+}
+
+func (d *keyDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// keyVault returns the key vault of a key management service containing the given credentials
+func (d *keyDiscovery) keyVault(ID string, name string, credentials []ontology.IsResource) *ontology.KeyVault {
+	vault := &ontology.KeyVault{
+		Id:          ID,
+		Name:        name,
+		GeoLocation: d.location(),
+	}
+
+	for _, c := range credentials {
+		vault.CredentialIds = append(vault.CredentialIds, c.GetId())
+	}
+
+	return vault
+}
+
+// discoverKeys discovers all KMS keys (in the current region). The expiration date of a key is the date its key
+// material expires (for imported key material) or is rotated next (for keys with automatic rotation). Keys that cannot
+// be described are skipped.
+func (d *keyDiscovery) discoverKeys() (resources []ontology.IsResource, err error) {
+	var (
+		resp   *kms.ListKeysOutput
+		marker *string
+	)
+
+	aliases, err := d.aliases()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		resp, err = d.kmsAPI.ListKeys(context.TODO(), &kms.ListKeysInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for _, entry := range resp.Keys {
+			// A single key might not be accessible, e.g., if its key policy does not grant access to it
+			key, err := d.discoverKey(entry.KeyId, aliases)
+			if err != nil {
+				log.Warnf("Could not discover key '%s', skipping it: %v", aws.ToString(entry.KeyId), err)
+				continue
+			}
+
+			resources = append(resources, key)
+		}
+
+		if marker = resp.NextMarker; !resp.Truncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverKey retrieves the metadata, rotation status and tags of a single KMS key and maps it to a key
+func (d *keyDiscovery) discoverKey(keyID *string, aliases map[string]string) (*ontology.Key, error) {
+	desc, err := d.kmsAPI.DescribeKey(context.TODO(), &kms.DescribeKeyInput{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+	meta := desc.KeyMetadata
+
+	rotation, err := d.rotationStatus(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := d.keyLabels(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &ontology.Key{
+		Id:           aws.ToString(meta.Arn),
+		Name:         aws.ToString(keyID),
+		Description:  aws.ToString(meta.Description),
+		CreationTime: timestamppb.New(util.Deref(meta.CreationDate)),
+		GeoLocation:  d.location(),
+		Labels:       labels,
+		Enabled:      meta.KeyState == typesKMS.KeyStateEnabled,
+		Algorithm:    string(meta.KeySpec),
+		KeySize:      keySize(meta.KeySpec),
+		IsManaged:    meta.KeyManager == typesKMS.KeyManagerTypeAws,
+		ParentId:     util.Ref(d.kmsVaultID()),
+		Raw:          discovery.Raw(meta, rotation),
+	}
+
+	if alias, ok := aliases[aws.ToString(keyID)]; ok {
+		key.Name = alias
+	}
+
+	if meta.ValidTo != nil {
+		key.ExpirationDate = timestamppb.New(*meta.ValidTo)
+	} else if rotation != nil && rotation.KeyRotationEnabled && rotation.NextRotationDate != nil {
+		key.ExpirationDate = timestamppb.New(*rotation.NextRotationDate)
+	}
+
+	return key, nil
+}
+
+// rotationStatus retrieves the automatic rotation status of a key. Automatic rotation is only supported for symmetric
+// keys with key material generated by KMS, for all other keys nil is returned.
+func (d *keyDiscovery) rotationStatus(meta *typesKMS.KeyMetadata) (*kms.GetKeyRotationStatusOutput, error) {
+	if meta.KeySpec != typesKMS.KeySpecSymmetricDefault ||
+		meta.Origin != typesKMS.OriginTypeAwsKms ||
+		meta.KeyState == typesKMS.KeyStatePendingDeletion ||
+		meta.KeyState == typesKMS.KeyStatePendingReplicaDeletion {
+		return nil, nil
+	}
+
+	resp, err := d.kmsAPI.GetKeyRotationStatus(context.TODO(), &kms.GetKeyRotationStatusInput{
+		KeyId: meta.KeyId,
+	})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+
+	return resp, nil
+}
+
+// keyLabels retrieves the tags of a customer managed key. AWS managed keys cannot be tagged.
+func (d *keyDiscovery) keyLabels(meta *typesKMS.KeyMetadata) (labels map[string]string, err error) {
+	var (
+		resp   *kms.ListResourceTagsOutput
+		marker *string
+	)
+
+	labels = map[string]string{}
+
+	if meta.KeyManager != typesKMS.KeyManagerTypeCustomer {
+		return
+	}
+
+	for {
+		resp, err = d.kmsAPI.ListResourceTags(context.TODO(), &kms.ListResourceTagsInput{
+			KeyId:  meta.KeyId,
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for _, tag := range resp.Tags {
+			labels[aws.ToString(tag.TagKey)] = aws.ToString(tag.TagValue)
+		}
+
+		if marker = resp.NextMarker; !resp.Truncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// aliases returns the (first) alias name of each key, indexed by the key ID
+func (d *keyDiscovery) aliases() (aliases map[string]string, err error) {
+	var (
+		resp   *kms.ListAliasesOutput
+		marker *string
+	)
+
+	aliases = map[string]string{}
+
+	for {
+		resp, err = d.kmsAPI.ListAliases(context.TODO(), &kms.ListAliasesInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for _, alias := range resp.Aliases {
+			if _, ok := aliases[aws.ToString(alias.TargetKeyId)]; alias.TargetKeyId == nil || ok {
+				continue
+			}
+
+			aliases[aws.ToString(alias.TargetKeyId)] = strings.TrimPrefix(aws.ToString(alias.AliasName), "alias/")
+		}
+
+		if marker = resp.NextMarker; !resp.Truncated || marker == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverSecrets discovers all secrets (in the current region) of the Secrets Manager. The expiration date of a secret
+// is the date of its next rotation and its not before date is the date of its last rotation. Values of secrets are
+// never retrieved.
+func (d *keyDiscovery) discoverSecrets() (resources []ontology.IsResource, err error) {
+	var (
+		resp  *secretsmanager.ListSecretsOutput
+		token *string
+	)
+
+	for {
+		resp, err = d.secretsAPI.ListSecrets(context.TODO(), &secretsmanager.ListSecretsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.SecretList {
+			secret := &resp.SecretList[i]
+
+			s := &ontology.Secret{
+				Id:           aws.ToString(secret.ARN),
+				Name:         aws.ToString(secret.Name),
+				Description:  aws.ToString(secret.Description),
+				CreationTime: timestamppb.New(util.Deref(secret.CreatedDate)),
+				GeoLocation:  d.location(),
+				Labels:       d.secretLabels(secret.Tags),
+				Enabled:      secret.DeletedDate == nil,
+				IsManaged:    secret.OwningService != nil,
+				ParentId:     util.Ref(d.secretsVaultID()),
+				Raw:          discovery.Raw(&resp.SecretList[i]),
+			}
+
+			if util.Deref(secret.RotationEnabled) && secret.NextRotationDate != nil {
+				s.ExpirationDate = timestamppb.New(*secret.NextRotationDate)
+			}
+
+			if secret.LastRotatedDate != nil {
+				s.NotBeforeDate = timestamppb.New(*secret.LastRotatedDate)
+			}
+
+			resources = append(resources, s)
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// keySize returns the key size in bits of a KMS key spec. ML-DSA keys are identified by their security level instead of
+// a key size, so 0 is returned for them.
+func keySize(spec typesKMS.KeySpec) int32 {
+	switch spec {
+	case typesKMS.KeySpecHmac224:
+		return 224
+	case typesKMS.KeySpecSymmetricDefault, typesKMS.KeySpecEccNistP256, typesKMS.KeySpecEccSecgP256k1,
+		typesKMS.KeySpecHmac256, typesKMS.KeySpecSm2:
+		return 256
+	case typesKMS.KeySpecEccNistP384, typesKMS.KeySpecHmac384:
+		return 384
+	case typesKMS.KeySpecHmac512:
+		return 512
+	case typesKMS.KeySpecEccNistP521:
+		return 521
+	case typesKMS.KeySpecRsa2048:
+		return 2048
+	case typesKMS.KeySpecRsa3072:
+		return 3072
+	case typesKMS.KeySpecRsa4096:
+		return 4096
+	default:
+		return 0
+	}
+}
+
+func (d *keyDiscovery) location() *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: d.awsConfig.cfg.Region,
+	}
+}
+
+func (*keyDiscovery) secretLabels(tags []typesSecretsManager.Tag) (labels map[string]string) {
+	labels = map[string]string{}
+
+	for _, tag := range tags {
+		labels[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return
+}
+
+// kmsVaultID returns the ID of the key vault representing KMS in the current region
+func (d *keyDiscovery) kmsVaultID() string {
+	return "arn:aws:kms:" + d.awsConfig.cfg.Region + ":" + aws.ToString(d.awsConfig.accountID)
+}
+
+// secretsVaultID returns the ID of the key vault representing the Secrets Manager in the current region
+func (d *keyDiscovery) secretsVaultID() string {
+	return "arn:aws:secretsmanager:" + d.awsConfig.cfg.Region + ":" + aws.ToString(d.awsConfig.accountID)
+}
@@ -0,0 +1,373 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	typesKMS "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	typesSecretsManager "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	mockKMSKeyID         = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	mockKMSKeyARN        = "arn:aws:kms:eu-central-1:MockAccountID1234:key/" + mockKMSKeyID
+	mockKMSAsymKeyID     = "0987dcba-09fe-87dc-65ba-ab0987654321"
+	mockKMSAsymKeyARN    = "arn:aws:kms:eu-central-1:MockAccountID1234:key/" + mockKMSAsymKeyID
+	mockSecretARN        = "arn:aws:secretsmanager:eu-central-1:MockAccountID1234:secret:mock-secret-a1b2c3"
+	mockKMSVaultID       = "arn:aws:kms:eu-central-1:MockAccountID1234"
+	mockSecretsVaultID   = "arn:aws:secretsmanager:eu-central-1:MockAccountID1234"
+	mockKMSRotationEpoch = 100
+)
+
+// mockKMSAPI implements the KMSAPI interface for mock testing
+type mockKMSAPI struct{}
+
+// mockKMSAPIWithErrors implements the KMSAPI interface (API call returning error) for mock testing
+type mockKMSAPIWithErrors struct{}
+
+// mockKMSAPIWithInaccessibleKey implements the KMSAPI interface for mock testing, but the asymmetric key cannot be
+// described
+type mockKMSAPIWithInaccessibleKey struct {
+	mockKMSAPI
+}
+
+// mockSecretsManagerAPI implements the SecretsManagerAPI interface for mock testing
+type mockSecretsManagerAPI struct{}
+
+// mockSecretsManagerAPIWithErrors implements the SecretsManagerAPI interface (API call returning error) for mock testing
+type mockSecretsManagerAPIWithErrors struct{}
+
+func (mockKMSAPI) ListKeys(_ context.Context, _ *kms.ListKeysInput, _ ...func(*kms.Options)) (*kms.ListKeysOutput, error) {
+	return &kms.ListKeysOutput{
+		Keys: []typesKMS.KeyListEntry{
+			{KeyId: aws.String(mockKMSKeyID), KeyArn: aws.String(mockKMSKeyARN)},
+			{KeyId: aws.String(mockKMSAsymKeyID), KeyArn: aws.String(mockKMSAsymKeyARN)},
+		},
+	}, nil
+}
+
+func (mockKMSAPI) ListAliases(_ context.Context, _ *kms.ListAliasesInput, _ ...func(*kms.Options)) (*kms.ListAliasesOutput, error) {
+	return &kms.ListAliasesOutput{
+		Aliases: []typesKMS.AliasListEntry{
+			{AliasName: aws.String("alias/aws/s3")},
+			{AliasName: aws.String("alias/mock-key"), TargetKeyId: aws.String(mockKMSKeyID)},
+			{AliasName: aws.String("alias/other-alias"), TargetKeyId: aws.String(mockKMSKeyID)},
+		},
+	}, nil
+}
+
+func (mockKMSAPI) DescribeKey(_ context.Context, params *kms.DescribeKeyInput, _ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	if aws.ToString(params.KeyId) == mockKMSAsymKeyID {
+		return &kms.DescribeKeyOutput{
+			KeyMetadata: &typesKMS.KeyMetadata{
+				KeyId:      aws.String(mockKMSAsymKeyID),
+				Arn:        aws.String(mockKMSAsymKeyARN),
+				KeySpec:    typesKMS.KeySpecRsa4096,
+				KeyState:   typesKMS.KeyStateDisabled,
+				KeyManager: typesKMS.KeyManagerTypeAws,
+				Origin:     typesKMS.OriginTypeAwsKms,
+			},
+		}, nil
+	}
+
+	return &kms.DescribeKeyOutput{
+		KeyMetadata: &typesKMS.KeyMetadata{
+			KeyId:        aws.String(mockKMSKeyID),
+			Arn:          aws.String(mockKMSKeyARN),
+			Description:  aws.String("My key"),
+			CreationDate: aws.Time(time.Unix(1, 0)),
+			KeySpec:      typesKMS.KeySpecSymmetricDefault,
+			KeyState:     typesKMS.KeyStateEnabled,
+			KeyManager:   typesKMS.KeyManagerTypeCustomer,
+			Origin:       typesKMS.OriginTypeAwsKms,
+		},
+	}, nil
+}
+
+func (mockKMSAPI) GetKeyRotationStatus(_ context.Context, params *kms.GetKeyRotationStatusInput, _ ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	return &kms.GetKeyRotationStatusOutput{
+		KeyId:                params.KeyId,
+		KeyRotationEnabled:   true,
+		NextRotationDate:     aws.Time(time.Unix(mockKMSRotationEpoch, 0)),
+		RotationPeriodInDays: aws.Int32(365),
+	}, nil
+}
+
+func (mockKMSAPI) ListResourceTags(_ context.Context, _ *kms.ListResourceTagsInput, _ ...func(*kms.Options)) (*kms.ListResourceTagsOutput, error) {
+	return &kms.ListResourceTagsOutput{
+		Tags: []typesKMS.Tag{
+			{TagKey: aws.String("env"), TagValue: aws.String("prod")},
+		},
+	}, nil
+}
+
+func (m mockKMSAPIWithInaccessibleKey) DescribeKey(ctx context.Context, params *kms.DescribeKeyInput, optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	if aws.ToString(params.KeyId) == mockKMSAsymKeyID {
+		return nil, &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "Access Denied"}
+	}
+
+	return m.mockKMSAPI.DescribeKey(ctx, params, optFns...)
+}
+
+func (mockKMSAPIWithErrors) ListKeys(_ context.Context, _ *kms.ListKeysInput, _ ...func(*kms.Options)) (*kms.ListKeysOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockKMSAPIWithErrors) ListAliases(_ context.Context, _ *kms.ListAliasesInput, _ ...func(*kms.Options)) (*kms.ListAliasesOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockKMSAPIWithErrors) DescribeKey(_ context.Context, _ *kms.DescribeKeyInput, _ ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockKMSAPIWithErrors) GetKeyRotationStatus(_ context.Context, _ *kms.GetKeyRotationStatusInput, _ ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockKMSAPIWithErrors) ListResourceTags(_ context.Context, _ *kms.ListResourceTagsInput, _ ...func(*kms.Options)) (*kms.ListResourceTagsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockSecretsManagerAPI) ListSecrets(_ context.Context, _ *secretsmanager.ListSecretsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	return &secretsmanager.ListSecretsOutput{
+		SecretList: []typesSecretsManager.SecretListEntry{
+			{
+				ARN:              aws.String(mockSecretARN),
+				Name:             aws.String("mock-secret"),
+				CreatedDate:      aws.Time(time.Unix(1, 0)),
+				KmsKeyId:         aws.String(mockKMSKeyARN),
+				RotationEnabled:  aws.Bool(true),
+				LastRotatedDate:  aws.Time(time.Unix(50, 0)),
+				NextRotationDate: aws.Time(time.Unix(150, 0)),
+				Tags: []typesSecretsManager.Tag{
+					{Key: aws.String("team"), Value: aws.String("dev")},
+				},
+			},
+			{
+				ARN:  aws.String(mockSecretARN + "-unrotated"),
+				Name: aws.String("mock-secret-unrotated"),
+			},
+		},
+	}, nil
+}
+
+func (mockSecretsManagerAPIWithErrors) ListSecrets(_ context.Context, _ *secretsmanager.ListSecretsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func TestKeyDiscovery_List(t *testing.T) {
+	d := keyDiscovery{
+		kmsAPI:        mockKMSAPI{},
+		secretsAPI:    mockSecretsManagerAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	list, err := d.List()
+	assert.NoError(t, err)
+	// KMS vault + 2 keys + Secrets Manager vault + 2 secrets
+	assert.Equal(t, 6, len(list))
+
+	kmsVault, ok := list[0].(*ontology.KeyVault)
+	assert.True(t, ok)
+	assert.Equal(t, mockKMSVaultID, kmsVault.Id)
+	assert.Equal(t, []string{mockKMSKeyARN, mockKMSAsymKeyARN}, kmsVault.CredentialIds)
+
+	secretsVault, ok := list[3].(*ontology.KeyVault)
+	assert.True(t, ok)
+	assert.Equal(t, mockSecretsVaultID, secretsVault.Id)
+	assert.Equal(t, []string{mockSecretARN, mockSecretARN + "-unrotated"}, secretsVault.CredentialIds)
+
+	d = keyDiscovery{
+		kmsAPI: mockKMSAPIWithErrors{},
+	}
+	_, err = d.List()
+	assert.ErrorContains(t, err, "could not discover keys")
+
+	d = keyDiscovery{
+		kmsAPI:     mockKMSAPI{},
+		secretsAPI: mockSecretsManagerAPIWithErrors{},
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	_, err = d.List()
+	assert.ErrorContains(t, err, "could not discover secrets")
+}
+
+func TestKeyDiscovery_discoverKeys(t *testing.T) {
+	d := keyDiscovery{
+		kmsAPI:        mockKMSAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	resources, err := d.discoverKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resources))
+
+	key, ok := resources[0].(*ontology.Key)
+	assert.True(t, ok)
+	assert.Equal(t, mockKMSKeyARN, key.Id)
+	assert.Equal(t, "mock-key", key.Name)
+	assert.Equal(t, "My key", key.Description)
+	assert.True(t, key.Enabled)
+	assert.False(t, key.IsManaged)
+	assert.Equal(t, "SYMMETRIC_DEFAULT", key.Algorithm)
+	assert.Equal(t, int32(256), key.KeySize)
+	assert.Equal(t, time.Unix(mockKMSRotationEpoch, 0).UTC(), key.ExpirationDate.AsTime())
+	assert.Equal(t, "prod", key.Labels["env"])
+	assert.Equal(t, mockKMSVaultID, key.GetParentId())
+
+	// Asymmetric keys do not support automatic rotation and AWS managed keys cannot be tagged
+	key, ok = resources[1].(*ontology.Key)
+	assert.True(t, ok)
+	assert.Equal(t, mockKMSAsymKeyID, key.Name)
+	assert.False(t, key.Enabled)
+	assert.True(t, key.IsManaged)
+	assert.Equal(t, int32(4096), key.KeySize)
+	assert.Nil(t, key.ExpirationDate)
+	assert.Empty(t, key.Labels)
+
+	// Keys that cannot be described are skipped
+	d.kmsAPI = mockKMSAPIWithInaccessibleKey{}
+	resources, err = d.discoverKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{mockKMSKeyARN}, ontology.ResourceIDs(resources))
+}
+
+func TestKeyDiscovery_discoverSecrets(t *testing.T) {
+	d := keyDiscovery{
+		secretsAPI:    mockSecretsManagerAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	resources, err := d.discoverSecrets()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resources))
+
+	secret, ok := resources[0].(*ontology.Secret)
+	assert.True(t, ok)
+	assert.Equal(t, mockSecretARN, secret.Id)
+	assert.True(t, secret.Enabled)
+	assert.Equal(t, time.Unix(150, 0).UTC(), secret.ExpirationDate.AsTime())
+	assert.Equal(t, time.Unix(50, 0).UTC(), secret.NotBeforeDate.AsTime())
+	assert.Equal(t, "dev", secret.Labels["team"])
+	assert.Equal(t, mockSecretsVaultID, secret.GetParentId())
+
+	secret, ok = resources[1].(*ontology.Secret)
+	assert.True(t, ok)
+	assert.Nil(t, secret.ExpirationDate)
+	assert.Nil(t, secret.NotBeforeDate)
+}
+
+func Test_keySize(t *testing.T) {
+	tests := []struct {
+		spec typesKMS.KeySpec
+		want int32
+	}{
+		{spec: typesKMS.KeySpecSymmetricDefault, want: 256},
+		{spec: typesKMS.KeySpecRsa2048, want: 2048},
+		{spec: typesKMS.KeySpecEccNistP384, want: 384},
+		{spec: typesKMS.KeySpecEccNistP521, want: 521},
+		{spec: typesKMS.KeySpecHmac224, want: 224},
+		{spec: typesKMS.KeySpecMlDsa65, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.spec), func(t *testing.T) {
+			assert.Equal(t, tt.want, keySize(tt.spec))
+		})
+	}
+}
+
+func TestKeyDiscovery_Name(t *testing.T) {
+	d := keyDiscovery{}
+	assert.Equal(t, "AWS Key Management", d.Name())
+}
+
+func TestNewAwsKeyDiscovery(t *testing.T) {
+	// Mock newFromConfigKMS and newFromConfigSecretsManager and store the original functions back at the end of the test
+	oldKMS := newFromConfigKMS
+	oldSecretsManager := newFromConfigSecretsManager
+	defer func() {
+		newFromConfigKMS = oldKMS
+		newFromConfigSecretsManager = oldSecretsManager
+	}()
+
+	newFromConfigKMS = func(cfg aws.Config, optFns ...func(*kms.Options)) *kms.Client {
+		return &kms.Client{}
+	}
+	newFromConfigSecretsManager = func(cfg aws.Config, optFns ...func(*secretsmanager.Options)) *secretsmanager.Client {
+		return &secretsmanager.Client{}
+	}
+
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("1234"),
+	}
+
+	got := NewAwsKeyDiscovery(mockClient, testdata.MockTargetOfEvaluationID1)
+	assert.Equal(t, discovery.Discoverer(&keyDiscovery{
+		kmsAPI:        &kms.Client{},
+		secretsAPI:    &secretsmanager.Client{},
+		isDiscovering: true,
+		awsConfig:     mockClient,
+		ctID:          testdata.MockTargetOfEvaluationID1,
+	}), got, assert.CompareAllUnexported())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, got.TargetOfEvaluationID())
+}
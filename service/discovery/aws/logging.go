@@ -0,0 +1,397 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	typesCloudTrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	typesCloudWatchLogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+// eventHistoryRetentionPeriod is the retention period of the CloudTrail event history, which records management events
+// of all AWS services, even if no trail is configured
+const eventHistoryRetentionPeriod = 90 * 24 * time.Hour
+
+// cloudWatchAgentName is the (normalized) name of the application inventory entry of the CloudWatch agent
+const cloudWatchAgentName = "amazon-cloudwatch-agent"
+
+// serviceLogGroupPrefix is the prefix of the log groups AWS services (such as Lambda or VPC flow logs) send their logs
+// to. These log groups do not contain logs of the CloudWatch agent.
+const serviceLogGroupPrefix = "/aws/"
+
+// CloudTrailAPI describes the CloudTrail api interface which is implemented by the official AWS client and mock clients
+// in tests
+type CloudTrailAPI interface {
+	DescribeTrails(ctx context.Context,
+		params *cloudtrail.DescribeTrailsInput,
+		optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error)
+
+	GetTrailStatus(ctx context.Context,
+		params *cloudtrail.GetTrailStatusInput,
+		optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error)
+}
+
+// CloudWatchLogsAPI describes the CloudWatch Logs api interface which is implemented by the official AWS client and
+// mock clients in tests
+type CloudWatchLogsAPI interface {
+	DescribeLogGroups(ctx context.Context,
+		params *cloudwatchlogs.DescribeLogGroupsInput,
+		optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+
+	DescribeLogStreams(ctx context.Context,
+		params *cloudwatchlogs.DescribeLogStreamsInput,
+		optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
+}
+
+// SSMAPI describes the SSM api interface which is implemented by the official AWS client and mock clients in tests
+type SSMAPI interface {
+	DescribeInstanceInformation(ctx context.Context,
+		params *ssm.DescribeInstanceInformationInput,
+		optFns ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error)
+
+	ListInventoryEntries(ctx context.Context,
+		params *ssm.ListInventoryEntriesInput,
+		optFns ...func(*ssm.Options)) (*ssm.ListInventoryEntriesOutput, error)
+}
+
+// newFromConfigCloudTrail holds cloudtrail.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigCloudTrail = cloudtrail.NewFromConfig
+
+// newFromConfigCloudWatchLogs holds cloudwatchlogs.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigCloudWatchLogs = cloudwatchlogs.NewFromConfig
+
+// newFromConfigSSM holds ssm.NewFromConfig(...) allowing a test function to mock it
+var newFromConfigSSM = ssm.NewFromConfig
+
+// loggingInfo holds the logging configuration of the current region that is needed to determine the logging of the
+// individual VMs
+type loggingInfo struct {
+	// trails contains the active trails that record events of the current region
+	trails []typesCloudTrail.Trail
+	// logGroups contains all CloudWatch log groups, indexed by their ARN
+	logGroups map[string]*typesCloudWatchLogs.LogGroup
+	// agentLogGroups contains the ARNs of the log groups the CloudWatch agent might send logs to, i.e., all log groups
+	// except those of AWS services and trails
+	agentLogGroups []string
+	// managedInstances contains the IDs of all instances managed by SSM
+	managedInstances map[string]bool
+
+	// trailsAvailable is false, if the trails could not be retrieved. The activity logging of the VMs is then unknown.
+	trailsAvailable bool
+	// logsAvailable is false, if the log groups or SSM managed instances could not be retrieved. The boot and OS
+	// logging of the VMs is then unknown.
+	logsAvailable bool
+}
+
+// loggingInfo retrieves the trails, log groups and SSM managed instances of the current region. Since logging is only
+// one aspect of the VMs, errors are logged and the affected logging information is marked as unavailable instead of
+// failing the discovery of the VMs.
+func (d *computeDiscovery) loggingInfo() (info *loggingInfo) {
+	var err error
+
+	info = &loggingInfo{
+		logGroups:        map[string]*typesCloudWatchLogs.LogGroup{},
+		managedInstances: map[string]bool{},
+	}
+
+	if info.trails, err = d.activeTrails(); err != nil {
+		log.Warnf("Could not retrieve trails, activity logging of VMs is not discovered: %v", err)
+	} else {
+		info.trailsAvailable = true
+	}
+
+	if err = d.logGroups(info); err != nil {
+		log.Warnf("Could not retrieve log groups, boot and OS logging of VMs is not discovered: %v", err)
+		return
+	}
+
+	if err = d.managedInstances(info); err != nil {
+		log.Warnf("Could not retrieve SSM managed instances, boot and OS logging of VMs is not discovered: %v", err)
+		return
+	}
+
+	for arn, group := range info.logGroups {
+		if strings.HasPrefix(aws.ToString(group.LogGroupName), serviceLogGroupPrefix) ||
+			slices.ContainsFunc(info.trails, func(trail typesCloudTrail.Trail) bool {
+				return logGroupARN(trail.CloudWatchLogsLogGroupArn) == arn
+			}) {
+			continue
+		}
+
+		info.agentLogGroups = append(info.agentLogGroups, arn)
+	}
+	slices.Sort(info.agentLogGroups)
+
+	info.logsAvailable = true
+
+	return
+}
+
+// activeTrails returns all trails that are currently logging events of the current region. This includes multi-region
+// trails created in other regions.
+func (d *computeDiscovery) activeTrails() (trails []typesCloudTrail.Trail, err error) {
+	resp, err := d.trailAPI.DescribeTrails(context.TODO(), &cloudtrail.DescribeTrailsInput{})
+	if err != nil {
+		return nil, prettyError(err)
+	}
+
+	for _, trail := range resp.TrailList {
+		if !util.Deref(trail.IsMultiRegionTrail) && aws.ToString(trail.HomeRegion) != d.awsConfig.cfg.Region {
+			continue
+		}
+
+		status, err := d.trailAPI.GetTrailStatus(context.TODO(), &cloudtrail.GetTrailStatusInput{
+			Name: trail.TrailARN,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		if util.Deref(status.IsLogging) {
+			trails = append(trails, trail)
+		}
+	}
+
+	return
+}
+
+// logGroups retrieves all CloudWatch log groups (in the current region)
+func (d *computeDiscovery) logGroups(info *loggingInfo) error {
+	var token *string
+
+	for {
+		resp, err := d.logsAPI.DescribeLogGroups(context.TODO(), &cloudwatchlogs.DescribeLogGroupsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return prettyError(err)
+		}
+
+		for i := range resp.LogGroups {
+			group := &resp.LogGroups[i]
+			info.logGroups[logGroupARN(group.Arn)] = group
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// managedInstances retrieves the IDs of all instances managed by SSM (in the current region). Only for these
+// instances, an inventory of the installed applications is available.
+func (d *computeDiscovery) managedInstances(info *loggingInfo) error {
+	var token *string
+
+	for {
+		resp, err := d.ssmAPI.DescribeInstanceInformation(context.TODO(), &ssm.DescribeInstanceInformationInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return prettyError(err)
+		}
+
+		for _, instance := range resp.InstanceInformationList {
+			info.managedInstances[aws.ToString(instance.InstanceId)] = true
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// hasCloudWatchAgent checks whether the CloudWatch agent is installed on the VM according to the SSM inventory
+func (d *computeDiscovery) hasCloudWatchAgent(vm *typesEC2.Instance, info *loggingInfo) (bool, error) {
+	var token *string
+
+	if !info.managedInstances[aws.ToString(vm.InstanceId)] {
+		return false, nil
+	}
+
+	for {
+		resp, err := d.ssmAPI.ListInventoryEntries(context.TODO(), &ssm.ListInventoryEntriesInput{
+			InstanceId: vm.InstanceId,
+			TypeName:   aws.String("AWS:Application"),
+			NextToken:  token,
+		})
+		if err != nil {
+			return false, prettyError(err)
+		}
+
+		// The agent is called 'amazon-cloudwatch-agent' on Linux and 'Amazon CloudWatch Agent' on Windows
+		for _, entry := range resp.Entries {
+			if strings.ReplaceAll(strings.ToLower(entry["Name"]), " ", "-") == cloudWatchAgentName {
+				return true, nil
+			}
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return false, nil
+}
+
+// vmLogGroups returns the ARNs of the log groups the CloudWatch agent of the VM sends logs to. By default, the agent
+// names the log streams after the instance ID (optionally followed by other parts, e.g., '{instance_id}-{hostname}'),
+// so only the log streams with the instance ID as prefix are queried.
+func (d *computeDiscovery) vmLogGroups(vm *typesEC2.Instance, info *loggingInfo) (groups []string, err error) {
+	agent, err := d.hasCloudWatchAgent(vm, info)
+	if err != nil || !agent {
+		return nil, err
+	}
+
+	for _, arn := range info.agentLogGroups {
+		resp, err := d.logsAPI.DescribeLogStreams(context.TODO(), &cloudwatchlogs.DescribeLogStreamsInput{
+			LogGroupName:        info.logGroups[arn].LogGroupName,
+			LogStreamNamePrefix: vm.InstanceId,
+			Limit:               aws.Int32(1),
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		if len(resp.LogStreams) > 0 {
+			groups = append(groups, arn)
+		}
+	}
+
+	return groups, nil
+}
+
+// getBootLog returns the boot logging of the VM. Boot logs are collected by the CloudWatch agent, if it sends the boot
+// log files (such as '/var/log/boot.log' or '/var/log/cloud-init-output.log') to a log group.
+func (*computeDiscovery) getBootLog(groups []string, info *loggingInfo) (l *ontology.BootLogging) {
+	groups = slices.DeleteFunc(slices.Clone(groups), func(arn string) bool {
+		return !isBootLogGroup(info.logGroups[arn])
+	})
+
+	l = &ontology.BootLogging{
+		Enabled:           len(groups) > 0,
+		LoggingServiceIds: groups,
+		RetentionPeriod:   shortestRetention(groups, info),
+	}
+	return
+}
+
+// getOSLog returns the OS logging of the VM. OS logs are collected by the CloudWatch agent, if it sends any other log
+// files or event logs to a log group.
+func (*computeDiscovery) getOSLog(groups []string, info *loggingInfo) (l *ontology.OSLogging) {
+	groups = slices.DeleteFunc(slices.Clone(groups), func(arn string) bool {
+		return isBootLogGroup(info.logGroups[arn])
+	})
+
+	l = &ontology.OSLogging{
+		Enabled:           len(groups) > 0,
+		LoggingServiceIds: groups,
+		RetentionPeriod:   shortestRetention(groups, info),
+	}
+	return
+}
+
+// getActivityLog returns the activity logging of the VM. Management events of EC2 are always recorded in the
+// CloudTrail event history for 90 days. Active trails record them for a longer period, depending on the retention of
+// the log group they deliver to.
+func (*computeDiscovery) getActivityLog(info *loggingInfo) (l *ontology.ActivityLogging) {
+	l = &ontology.ActivityLogging{
+		Enabled:           true,
+		LoggingServiceIds: []string{},
+		RetentionPeriod:   durationpb.New(eventHistoryRetentionPeriod),
+	}
+
+	for _, trail := range info.trails {
+		l.LoggingServiceIds = append(l.LoggingServiceIds, aws.ToString(trail.TrailARN))
+
+		group, ok := info.logGroups[logGroupARN(trail.CloudWatchLogsLogGroupArn)]
+		if !ok {
+			continue
+		}
+
+		if retention := retentionPeriod(group); retention.AsDuration() == 0 || l.RetentionPeriod.AsDuration() != 0 &&
+			retention.AsDuration() > l.RetentionPeriod.AsDuration() {
+			l.RetentionPeriod = retention
+		}
+	}
+
+	return
+}
+
+// isBootLogGroup checks whether the log group contains boot logs. This is a heuristic based on the log group name: the
+// CloudWatch agent names log groups after the collected log file by default, e.g., '/var/log/boot.log',
+// '/var/log/cloud-init-output.log' or '/var/log/dmesg'. Log groups with custom names that do not contain one of these
+// file names are considered to contain OS logs.
+func isBootLogGroup(group *typesCloudWatchLogs.LogGroup) bool {
+	name := strings.ToLower(aws.ToString(group.LogGroupName))
+
+	return strings.Contains(name, "boot") || strings.Contains(name, "cloud-init") || strings.Contains(name, "dmesg")
+}
+
+// shortestRetention returns the shortest retention period of the given log groups
+func shortestRetention(groups []string, info *loggingInfo) (r *durationpb.Duration) {
+	r = durationpb.New(0)
+
+	for _, arn := range groups {
+		retention := retentionPeriod(info.logGroups[arn])
+		if r.AsDuration() == 0 || retention.AsDuration() != 0 && retention.AsDuration() < r.AsDuration() {
+			r = retention
+		}
+	}
+
+	return
+}
+
+// retentionPeriod returns the retention period of the log group. Logs in log groups without a retention period never
+// expire, which is represented by a retention period of 0.
+func retentionPeriod(group *typesCloudWatchLogs.LogGroup) *durationpb.Duration {
+	return durationpb.New(time.Duration(util.Deref(group.RetentionInDays)) * 24 * time.Hour)
+}
+
+// logGroupARN returns the ARN of a log group without the ':*' suffix, which is part of the ARN returned by
+// DescribeLogGroups and the log group ARN of a trail
+func logGroupARN(arn *string) string {
+	return strings.TrimSuffix(aws.ToString(arn), ":*")
+}
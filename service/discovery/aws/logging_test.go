@@ -0,0 +1,243 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	typesCloudTrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	typesCloudWatchLogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	typesSSM "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	mockTrailARN         = "arn:aws:cloudtrail:eu-central-1:MockAccountID1234:trail/mock-trail"
+	mockTrailLogGroupARN = "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:aws-cloudtrail-logs"
+	mockBootLogGroupARN  = "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:/var/log/cloud-init-output.log"
+	mockOSLogGroupARN    = "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:/var/log/messages"
+	mockOtherLogGroupARN = "arn:aws:logs:eu-central-1:MockAccountID1234:log-group:/aws/lambda/mock-function"
+)
+
+// mockCloudTrailAPI implements the CloudTrailAPI interface for mock testing
+type mockCloudTrailAPI struct{}
+
+// mockCloudTrailAPIWithErrors implements the CloudTrailAPI interface (API call returning error) for mock testing
+type mockCloudTrailAPIWithErrors struct{}
+
+// mockCloudWatchLogsAPI implements the CloudWatchLogsAPI interface for mock testing
+type mockCloudWatchLogsAPI struct{}
+
+// mockCloudWatchLogsAPIWithErrors implements the CloudWatchLogsAPI interface (API call returning error) for mock testing
+type mockCloudWatchLogsAPIWithErrors struct{}
+
+// mockSSMAPI implements the SSMAPI interface for mock testing
+type mockSSMAPI struct{}
+
+func (mockCloudTrailAPI) DescribeTrails(_ context.Context, _ *cloudtrail.DescribeTrailsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error) {
+	return &cloudtrail.DescribeTrailsOutput{
+		TrailList: []typesCloudTrail.Trail{
+			{
+				TrailARN:                  aws.String(mockTrailARN),
+				HomeRegion:                aws.String("us-east-1"),
+				IsMultiRegionTrail:        aws.Bool(true),
+				CloudWatchLogsLogGroupArn: aws.String(mockTrailLogGroupARN + ":*"),
+			},
+			{
+				TrailARN:   aws.String("arn:aws:cloudtrail:us-east-1:MockAccountID1234:trail/other-region"),
+				HomeRegion: aws.String("us-east-1"),
+			},
+			{
+				TrailARN:   aws.String("arn:aws:cloudtrail:eu-central-1:MockAccountID1234:trail/stopped"),
+				HomeRegion: aws.String("eu-central-1"),
+			},
+		},
+	}, nil
+}
+
+func (mockCloudTrailAPI) GetTrailStatus(_ context.Context, params *cloudtrail.GetTrailStatusInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error) {
+	return &cloudtrail.GetTrailStatusOutput{
+		IsLogging: aws.Bool(aws.ToString(params.Name) == mockTrailARN),
+	}, nil
+}
+
+func (mockCloudTrailAPIWithErrors) DescribeTrails(_ context.Context, _ *cloudtrail.DescribeTrailsInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockCloudTrailAPIWithErrors) GetTrailStatus(_ context.Context, _ *cloudtrail.GetTrailStatusInput, _ ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockCloudWatchLogsAPI) DescribeLogGroups(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return &cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []typesCloudWatchLogs.LogGroup{
+			{Arn: aws.String(mockTrailLogGroupARN + ":*"), LogGroupName: aws.String("aws-cloudtrail-logs"), RetentionInDays: aws.Int32(365)},
+			{Arn: aws.String(mockBootLogGroupARN + ":*"), LogGroupName: aws.String("/var/log/cloud-init-output.log"), RetentionInDays: aws.Int32(7)},
+			{Arn: aws.String(mockOSLogGroupARN + ":*"), LogGroupName: aws.String("/var/log/messages"), RetentionInDays: aws.Int32(30)},
+			{Arn: aws.String(mockOtherLogGroupARN + ":*"), LogGroupName: aws.String("/aws/lambda/mock-function")},
+		},
+	}, nil
+}
+
+func (mockCloudWatchLogsAPI) DescribeLogStreams(_ context.Context, params *cloudwatchlogs.DescribeLogStreamsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	var streams []string
+
+	switch aws.ToString(params.LogGroupName) {
+	case "/var/log/cloud-init-output.log":
+		streams = []string{mockVM1ID}
+	case "/var/log/messages":
+		streams = []string{mockVM1ID + "-ip-10-0-0-1", "mockVM2ID"}
+	case "/aws/lambda/mock-function":
+		// Log groups of AWS services must not be queried
+		return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+	}
+
+	out := &cloudwatchlogs.DescribeLogStreamsOutput{}
+	for _, name := range streams {
+		if strings.HasPrefix(name, aws.ToString(params.LogStreamNamePrefix)) {
+			out.LogStreams = append(out.LogStreams, typesCloudWatchLogs.LogStream{LogStreamName: aws.String(name)})
+		}
+	}
+
+	return out, nil
+}
+
+func (mockCloudWatchLogsAPIWithErrors) DescribeLogGroups(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockCloudWatchLogsAPIWithErrors) DescribeLogStreams(_ context.Context, _ *cloudwatchlogs.DescribeLogStreamsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockSSMAPI) DescribeInstanceInformation(_ context.Context, _ *ssm.DescribeInstanceInformationInput, _ ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error) {
+	return &ssm.DescribeInstanceInformationOutput{
+		InstanceInformationList: []typesSSM.InstanceInformation{
+			{InstanceId: aws.String(mockVM1ID)},
+			{InstanceId: aws.String("mockVM2ID")},
+		},
+	}, nil
+}
+
+func (mockSSMAPI) ListInventoryEntries(_ context.Context, params *ssm.ListInventoryEntriesInput, _ ...func(*ssm.Options)) (*ssm.ListInventoryEntriesOutput, error) {
+	if aws.ToString(params.InstanceId) != mockVM1ID {
+		return &ssm.ListInventoryEntriesOutput{
+			Entries: []map[string]string{{"Name": "nginx"}},
+		}, nil
+	}
+
+	return &ssm.ListInventoryEntriesOutput{
+		Entries: []map[string]string{
+			{"Name": "nginx"},
+			{"Name": "Amazon CloudWatch Agent"},
+		},
+	}, nil
+}
+
+func TestComputeDiscovery_loggingInfo(t *testing.T) {
+	d := computeDiscovery{
+		trailAPI: mockCloudTrailAPI{},
+		logsAPI:  mockCloudWatchLogsAPI{},
+		ssmAPI:   mockSSMAPI{},
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	info := d.loggingInfo()
+	assert.True(t, info.trailsAvailable)
+	assert.True(t, info.logsAvailable)
+	assert.Equal(t, 1, len(info.trails))
+	assert.Equal(t, mockTrailARN, aws.ToString(info.trails[0].TrailARN))
+	assert.Equal(t, 4, len(info.logGroups))
+	assert.NotNil(t, info.logGroups[mockBootLogGroupARN])
+	assert.True(t, info.managedInstances[mockVM1ID])
+	assert.Equal(t, []string{mockBootLogGroupARN, mockOSLogGroupARN}, info.agentLogGroups)
+
+	groups, err := d.vmLogGroups(&typesEC2.Instance{InstanceId: aws.String(mockVM1ID)}, info)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{mockBootLogGroupARN, mockOSLogGroupARN}, groups)
+
+	// The agent is not installed on the second managed instance and the third instance is not managed at all
+	for _, ID := range []string{"mockVM2ID", "mockVM3ID"} {
+		groups, err := d.vmLogGroups(&typesEC2.Instance{InstanceId: aws.String(ID)}, info)
+		assert.NoError(t, err)
+		assert.Empty(t, groups)
+
+		assert.False(t, d.getBootLog(groups, info).Enabled)
+		assert.False(t, d.getOSLog(groups, info).Enabled)
+	}
+}
+
+func TestComputeDiscovery_getActivityLog(t *testing.T) {
+	d := computeDiscovery{}
+
+	// Without trails, only the event history is available
+	l := d.getActivityLog(&loggingInfo{})
+	assert.True(t, l.Enabled)
+	assert.Empty(t, l.LoggingServiceIds)
+	assert.Equal(t, eventHistoryRetentionPeriod, l.RetentionPeriod.AsDuration())
+
+	// Logs of a trail delivered to a log group without retention never expire
+	l = d.getActivityLog(&loggingInfo{
+		trails: []typesCloudTrail.Trail{
+			{TrailARN: aws.String(mockTrailARN), CloudWatchLogsLogGroupArn: aws.String(mockOtherLogGroupARN + ":*")},
+		},
+		logGroups: map[string]*typesCloudWatchLogs.LogGroup{
+			mockOtherLogGroupARN: {LogGroupName: aws.String("/aws/lambda/mock-function")},
+		},
+	})
+	assert.Equal(t, []string{mockTrailARN}, l.LoggingServiceIds)
+	assert.Equal(t, time.Duration(0), l.RetentionPeriod.AsDuration())
+}
+
+func Test_shortestRetention(t *testing.T) {
+	info := &loggingInfo{
+		logGroups: map[string]*typesCloudWatchLogs.LogGroup{
+			"a": {RetentionInDays: aws.Int32(30)},
+			"b": {},
+			"c": {RetentionInDays: aws.Int32(7)},
+		},
+	}
+
+	assert.Equal(t, 7*24*time.Hour, shortestRetention([]string{"a", "b", "c"}, info).AsDuration())
+	assert.Equal(t, time.Duration(0), shortestRetention([]string{"b"}, info).AsDuration())
+	assert.Equal(t, time.Duration(0), shortestRetention(nil, info).AsDuration())
+}
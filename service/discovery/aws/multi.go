@@ -0,0 +1,157 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"errors"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// LabelAccountID is the label that contains the ID of the account a resource was discovered in. The "aws:"
+	// prefix is reserved by AWS, so it cannot clash with user-defined tags.
+	LabelAccountID = "aws:account-id"
+
+	// LabelRegion is the label that contains the region a resource was discovered in.
+	LabelRegion = "aws:region"
+
+	// MaxParallelDiscoverers is the maximum number of accounts and regions that are discovered in parallel by a
+	// single discoverer.
+	MaxParallelDiscoverers = 16
+)
+
+// multiDiscovery runs a discoverer for each of its clients, i.e., accounts and regions, in parallel.
+type multiDiscovery struct {
+	clients     []*Client
+	discoverers []discovery.Discoverer
+	// global specifies whether the discovered service is not bound to a region, e.g., IAM
+	global bool
+	ctID   string
+}
+
+// NewAwsMultiDiscovery constructs a discoverer that creates a discoverer for each of the clients using
+// newDiscoverer and runs them in parallel. Each discovered resource is labelled with its account and region.
+func NewAwsMultiDiscovery(clients []*Client, newDiscoverer func(*Client, string) discovery.Discoverer, TargetOfEvaluationID string) discovery.Discoverer {
+	return newMultiDiscovery(clients, newDiscoverer, false, TargetOfEvaluationID)
+}
+
+// NewAwsGlobalMultiDiscovery constructs a discoverer for services that are not bound to a region, such as IAM. In
+// contrast to [NewAwsMultiDiscovery], only one discoverer per account is created and resources are only labelled
+// with their account.
+func NewAwsGlobalMultiDiscovery(clients []*Client, newDiscoverer func(*Client, string) discovery.Discoverer, TargetOfEvaluationID string) discovery.Discoverer {
+	var (
+		accounts = make(map[string]bool)
+		global   []*Client
+	)
+
+	for _, c := range clients {
+		if !accounts[aws.ToString(c.accountID)] {
+			accounts[aws.ToString(c.accountID)] = true
+			global = append(global, c)
+		}
+	}
+
+	return newMultiDiscovery(global, newDiscoverer, true, TargetOfEvaluationID)
+}
+
+func newMultiDiscovery(clients []*Client, newDiscoverer func(*Client, string) discovery.Discoverer, global bool, TargetOfEvaluationID string) *multiDiscovery {
+	d := &multiDiscovery{
+		clients: clients,
+		global:  global,
+		ctID:    TargetOfEvaluationID,
+	}
+
+	for _, c := range clients {
+		d.discoverers = append(d.discoverers, newDiscoverer(c, TargetOfEvaluationID))
+	}
+
+	return d
+}
+
+// Name is the name of the wrapped discoverers
+func (d *multiDiscovery) Name() string {
+	if len(d.discoverers) == 0 {
+		return "AWS"
+	}
+
+	return d.discoverers[0].Name()
+}
+
+func (d *multiDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// List discovers the resources of all accounts and regions in parallel. Accounts or regions that cannot be discovered
+// are logged and skipped, an error is only returned if all of them fail.
+func (d *multiDiscovery) List() (list []ontology.IsResource, err error) {
+	var (
+		g       errgroup.Group
+		results = make([][]ontology.IsResource, len(d.discoverers))
+		errs    = make([]error, len(d.discoverers))
+		failed  int
+	)
+
+	g.SetLimit(MaxParallelDiscoverers)
+
+	for i, discoverer := range d.discoverers {
+		g.Go(func() error {
+			results[i], errs[i] = discoverer.List()
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	for i, c := range d.clients {
+		if errs[i] != nil {
+			log.Errorf("Could not discover %s in account %s (region %s): %v", d.Name(), aws.ToString(c.accountID), c.cfg.Region, errs[i])
+			failed++
+			continue
+		}
+
+		for _, r := range results[i] {
+			ontology.SetLabel(r, LabelAccountID, aws.ToString(c.accountID))
+			if !d.global {
+				ontology.SetLabel(r, LabelRegion, c.cfg.Region)
+			}
+
+			list = append(list, r)
+		}
+	}
+
+	if failed > 0 && failed == len(d.discoverers) {
+		return nil, errors.Join(errs...)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// mockDiscoverer returns a single object storage, whose ID is the account ID of the client. If the region of the
+// client is "failing", an error is returned instead.
+type mockDiscoverer struct {
+	client *Client
+}
+
+func newMockDiscoverer(client *Client, _ string) discovery.Discoverer {
+	return &mockDiscoverer{client: client}
+}
+
+func (*mockDiscoverer) Name() string {
+	return "AWS Mock"
+}
+
+func (*mockDiscoverer) TargetOfEvaluationID() string {
+	return testdata.MockTargetOfEvaluationID1
+}
+
+func (d *mockDiscoverer) List() ([]ontology.IsResource, error) {
+	if d.client.cfg.Region == "failing" {
+		return nil, errors.New("some error")
+	}
+
+	return []ontology.IsResource{
+		&ontology.ObjectStorage{Id: aws.ToString(d.client.accountID)},
+	}, nil
+}
+
+func newMockClient(account string, region string) *Client {
+	return &Client{cfg: aws.Config{Region: region}, accountID: aws.String(account)}
+}
+
+func TestNewAwsGlobalMultiDiscovery(t *testing.T) {
+	d := NewAwsGlobalMultiDiscovery([]*Client{
+		newMockClient("12345", "eu-central-1"),
+		newMockClient("12345", "eu-west-1"),
+		newMockClient("67890", "eu-central-1"),
+	}, newMockDiscoverer, testdata.MockTargetOfEvaluationID1)
+
+	md := assert.Is[*multiDiscovery](t, d)
+	assert.Equal(t, []string{"12345/eu-central-1", "67890/eu-central-1"}, clientAccountsAndRegions(md.clients))
+	assert.Equal(t, 2, len(md.discoverers))
+	assert.True(t, md.global)
+	assert.Equal(t, "AWS Mock", d.Name())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, d.TargetOfEvaluationID())
+}
+
+func TestMultiDiscovery_List(t *testing.T) {
+	tests := []struct {
+		name    string
+		clients []*Client
+		global  bool
+		want    []ontology.IsResource
+		wantErr assert.WantErr
+	}{
+		{
+			name: "regional",
+			clients: []*Client{
+				newMockClient("12345", "eu-central-1"),
+				newMockClient("67890", "eu-west-1"),
+			},
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "12345", Labels: map[string]string{LabelAccountID: "12345", LabelRegion: "eu-central-1"}},
+				&ontology.ObjectStorage{Id: "67890", Labels: map[string]string{LabelAccountID: "67890", LabelRegion: "eu-west-1"}},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "global",
+			clients: []*Client{newMockClient("12345", "eu-central-1")},
+			global:  true,
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "12345", Labels: map[string]string{LabelAccountID: "12345"}},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "partial failure",
+			clients: []*Client{
+				newMockClient("12345", "failing"),
+				newMockClient("67890", "eu-west-1"),
+			},
+			want: []ontology.IsResource{
+				&ontology.ObjectStorage{Id: "67890", Labels: map[string]string{LabelAccountID: "67890", LabelRegion: "eu-west-1"}},
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "all failing",
+			clients: []*Client{newMockClient("12345", "failing")},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "some error")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newMultiDiscovery(tt.clients, newMockDiscoverer, tt.global, testdata.MockTargetOfEvaluationID1)
+
+			got, err := d.List()
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
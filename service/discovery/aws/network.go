@@ -0,0 +1,447 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// The ontology has no property to reference network security groups, therefore the security groups and network ACLs
+// are referenced by labels.
+const (
+	// LabelSecurityGroupIDs is the label that contains the comma-separated IDs of the security groups attached to a
+	// network interface or VM
+	LabelSecurityGroupIDs = "aws:security-group-ids"
+
+	// LabelNetworkACLID is the label that contains the ID of the network ACL a subnet is associated with
+	LabelNetworkACLID = "aws:network-acl-id"
+)
+
+// networkDiscovery handles the AWS API requests regarding the network resources of EC2 (VPCs, subnets, security groups
+// and network ACLs)
+type networkDiscovery struct {
+	networkAPI    NetworkAPI
+	isDiscovering bool
+	awsConfig     *Client
+	ctID          string
+}
+
+// NetworkAPI describes the EC2 network api interface which is implemented by the official AWS client and mock clients
+// in tests
+type NetworkAPI interface {
+	DescribeVpcs(ctx context.Context,
+		params *ec2.DescribeVpcsInput,
+		optFns ...func(options *ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+
+	DescribeSubnets(ctx context.Context,
+		params *ec2.DescribeSubnetsInput,
+		optFns ...func(options *ec2.Options)) (*ec2.DescribeSubnetsOutput, error)
+
+	DescribeSecurityGroups(ctx context.Context,
+		params *ec2.DescribeSecurityGroupsInput,
+		optFns ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error)
+
+	DescribeNetworkAcls(ctx context.Context,
+		params *ec2.DescribeNetworkAclsInput,
+		optFns ...func(options *ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+}
+
+// NewAwsNetworkDiscovery constructs a new networkDiscovery initializing the networkAPI and isDiscovering with true
+func NewAwsNetworkDiscovery(client *Client, TargetOfEvaluationID string) discovery.Discoverer {
+	return &networkDiscovery{
+		networkAPI:    newFromConfigEC2(client.cfg),
+		isDiscovering: true,
+		awsConfig:     client,
+		ctID:          TargetOfEvaluationID,
+	}
+}
+
+// Name is the method implementation defined in the discovery.Discoverer interface
+func (*networkDiscovery) Name() string {
+	return "AWS Network"
+}
+
+// List is the method implementation defined in the discovery.Discoverer interface
+func (d *networkDiscovery) List() (resources []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	vpcs, err := d.discoverVPCs()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover VPCs: %w", err)
+	}
+	resources = append(resources, vpcs...)
+
+	// Network ACLs are not mapped on their own, they are part of the subnet they are associated with
+	acls, err := d.networkACLs()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover network ACLs: %w", err)
+	}
+
+	subnets, err := d.discoverSubnets(acls)
+	if err != nil {
+		return nil, fmt.Errorf("could not discover subnets: %w", err)
+	}
+	resources = append(resources, subnets...)
+
+	groups, err := d.discoverSecurityGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover security groups: %w", err)
+	}
+	resources = append(resources, groups...)
+
+	return
+}
+
+func (d *networkDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// discoverVPCs discovers all VPCs (in the current region) and maps them to virtual networks
+func (d *networkDiscovery) discoverVPCs() (resources []ontology.IsResource, err error) {
+	var (
+		resp  *ec2.DescribeVpcsOutput
+		token *string
+	)
+
+	for {
+		resp, err = d.networkAPI.DescribeVpcs(context.TODO(), &ec2.DescribeVpcsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.Vpcs {
+			vpc := &resp.Vpcs[i]
+
+			resources = append(resources, &ontology.VirtualNetwork{
+				Id:          d.arnify("vpc", vpc.VpcId),
+				Name:        d.nameOrID(vpc.Tags, vpc.VpcId),
+				GeoLocation: d.location(),
+				Labels:      d.labels(vpc.Tags),
+				Raw:         discovery.Raw(&resp.Vpcs[i]),
+			})
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverSubnets discovers all subnets (in the current region) and maps them to virtual sub-networks. A subnet is
+// internet accessible, if instances launched in it get a public IP address and its network ACL allows inbound traffic
+// from any address. The network ACL associated with the subnet ([LabelNetworkACLID]) is part of the raw evidence.
+func (d *networkDiscovery) discoverSubnets(acls []typesEC2.NetworkAcl) (resources []ontology.IsResource, err error) {
+	var (
+		resp  *ec2.DescribeSubnetsOutput
+		token *string
+	)
+
+	for {
+		resp, err = d.networkAPI.DescribeSubnets(context.TODO(), &ec2.DescribeSubnetsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.Subnets {
+			subnet := &resp.Subnets[i]
+			vpcID := d.arnify("vpc", subnet.VpcId)
+
+			labels := d.labels(subnet.Tags)
+			public := util.Deref(subnet.MapPublicIpOnLaunch)
+
+			raws := []any{&resp.Subnets[i]}
+			if acl := networkACLOfSubnet(acls, subnet.SubnetId); acl != nil {
+				labels[LabelNetworkACLID] = d.arnify("network-acl", acl.NetworkAclId)
+				public = public && aclOpenToInternet(acl.Entries)
+				raws = append(raws, acl)
+			}
+
+			resources = append(resources, &ontology.VirtualSubNetwork{
+				Id:                         d.arnify("subnet", subnet.SubnetId),
+				Name:                       d.nameOrID(subnet.Tags, subnet.SubnetId),
+				GeoLocation:                d.location(),
+				Labels:                     labels,
+				InternetAccessibleEndpoint: public,
+				ParentId:                   &vpcID,
+				Raw:                        discovery.Raw(raws...),
+			})
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// discoverSecurityGroups discovers all security groups (in the current region) and maps them to network security
+// groups. The ingress and egress rules are part of the raw evidence. A security group is internet accessible, if one
+// of its ingress rules allows traffic from any address to at least one port.
+func (d *networkDiscovery) discoverSecurityGroups() (resources []ontology.IsResource, err error) {
+	var (
+		resp  *ec2.DescribeSecurityGroupsOutput
+		token *string
+	)
+
+	for {
+		resp, err = d.networkAPI.DescribeSecurityGroups(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		for i := range resp.SecurityGroups {
+			group := &resp.SecurityGroups[i]
+			vpcID := d.arnify("vpc", group.VpcId)
+
+			resources = append(resources, &ontology.NetworkSecurityGroup{
+				Id:                         d.arnify("security-group", group.GroupId),
+				Name:                       aws.ToString(group.GroupName),
+				Description:                aws.ToString(group.Description),
+				GeoLocation:                d.location(),
+				Labels:                     d.labels(group.Tags),
+				InternetAccessibleEndpoint: openToInternet(group.IpPermissions),
+				ParentId:                   &vpcID,
+				Raw:                        discovery.Raw(&resp.SecurityGroups[i]),
+			})
+		}
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// networkACLs retrieves all network ACLs (in the current region)
+func (d *networkDiscovery) networkACLs() (acls []typesEC2.NetworkAcl, err error) {
+	var (
+		resp  *ec2.DescribeNetworkAclsOutput
+		token *string
+	)
+
+	for {
+		resp, err = d.networkAPI.DescribeNetworkAcls(context.TODO(), &ec2.DescribeNetworkAclsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, prettyError(err)
+		}
+
+		acls = append(acls, resp.NetworkAcls...)
+
+		if token = resp.NextToken; token == nil {
+			break
+		}
+	}
+
+	return
+}
+
+// networkACLOfSubnet returns the network ACL the subnet is associated with
+func networkACLOfSubnet(acls []typesEC2.NetworkAcl, subnetID *string) *typesEC2.NetworkAcl {
+	for i := range acls {
+		for _, association := range acls[i].Associations {
+			if aws.ToString(association.SubnetId) == aws.ToString(subnetID) {
+				return &acls[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// openToInternet checks whether one of the (ingress) permissions allows traffic from any IPv4 or IPv6 address to at
+// least one port. ICMP permissions do not open a port and are therefore not considered.
+func openToInternet(permissions []typesEC2.IpPermission) bool {
+	return len(internetPorts(permissions)) > 0
+}
+
+// securityGroupsFirewall derives the layer 3 firewall of a network interface from the security groups attached to it.
+// Security groups only contain allow rules, so they filter inbound traffic unless one of them allows all ports from any
+// address. The restricted ports are the ports that are only allowed from specific sources.
+func securityGroupsFirewall(groups []*typesEC2.SecurityGroup) *ontology.L3Firewall {
+	var ingress, egress []typesEC2.IpPermission
+
+	for _, group := range groups {
+		ingress = append(ingress, group.IpPermissions...)
+		egress = append(egress, group.IpPermissionsEgress...)
+	}
+
+	inbound := len(groups) > 0 && !slices.Contains(internetPorts(ingress), allPorts)
+
+	return &ontology.L3Firewall{
+		Enabled:         len(groups) > 0 && (inbound || !slices.Contains(internetPorts(egress), allPorts)),
+		Inbound:         inbound,
+		RestrictedPorts: strings.Join(restrictedPorts(ingress), ","),
+	}
+}
+
+// allPorts is the port range of permissions that apply to all ports
+const allPorts = "*"
+
+// internetPorts returns the port ranges the permissions allow from (or to) any IPv4 or IPv6 address
+func internetPorts(permissions []typesEC2.IpPermission) (ports []string) {
+	for _, p := range permissions {
+		if r, ok := portRange(p); ok && fromInternet(p) && !slices.Contains(ports, r) {
+			ports = append(ports, r)
+		}
+	}
+
+	return
+}
+
+// restrictedPorts returns the port ranges the permissions only allow from specific sources, i.e., IP ranges other than
+// any address, prefix lists or other security groups
+func restrictedPorts(permissions []typesEC2.IpPermission) (ports []string) {
+	open := internetPorts(permissions)
+	if slices.Contains(open, allPorts) {
+		return nil
+	}
+
+	for _, p := range permissions {
+		if r, ok := portRange(p); ok && !fromInternet(p) && !slices.Contains(open, r) && !slices.Contains(ports, r) {
+			ports = append(ports, r)
+		}
+	}
+
+	return
+}
+
+// portRange returns the port range of the TCP or UDP permission, e.g., "22", "8000-8080" or "*" for all ports. Other
+// protocols, such as ICMP, have no ports.
+func portRange(p typesEC2.IpPermission) (string, bool) {
+	switch aws.ToString(p.IpProtocol) {
+	case "-1":
+		return allPorts, true
+	case "tcp", "udp", "6", "17":
+	default:
+		return "", false
+	}
+
+	from, to := aws.ToInt32(p.FromPort), aws.ToInt32(p.ToPort)
+	switch {
+	case from <= 0 && to >= 65535:
+		return allPorts, true
+	case from == to:
+		return strconv.Itoa(int(from)), true
+	default:
+		return fmt.Sprintf("%d-%d", from, to), true
+	}
+}
+
+// fromInternet checks whether the permission applies to any IPv4 or IPv6 address
+func fromInternet(p typesEC2.IpPermission) bool {
+	for _, r := range p.IpRanges {
+		if aws.ToString(r.CidrIp) == "0.0.0.0/0" {
+			return true
+		}
+	}
+
+	for _, r := range p.Ipv6Ranges {
+		if aws.ToString(r.CidrIpv6) == "::/0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// aclOpenToInternet checks whether one of the inbound entries of a network ACL allows traffic from any IPv4 or IPv6
+// address. Entries are evaluated in order of their rule number, but since the default entry denies all traffic, any
+// allowing entry for all addresses opens the subnet.
+func aclOpenToInternet(entries []typesEC2.NetworkAclEntry) bool {
+	for _, e := range entries {
+		if util.Deref(e.Egress) || e.RuleAction != typesEC2.RuleActionAllow {
+			continue
+		}
+
+		if aws.ToString(e.CidrBlock) == "0.0.0.0/0" || aws.ToString(e.Ipv6CidrBlock) == "::/0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *networkDiscovery) location() *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: d.awsConfig.cfg.Region,
+	}
+}
+
+// nameOrID returns the name if exists (i.e. a tag with key 'name' exists), otherwise the ID is used
+func (*networkDiscovery) nameOrID(tags []typesEC2.Tag, ID *string) string {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return aws.ToString(tag.Value)
+		}
+	}
+
+	return aws.ToString(ID)
+}
+
+func (*networkDiscovery) labels(tags []typesEC2.Tag) (labels map[string]string) {
+	labels = map[string]string{}
+
+	for _, tag := range tags {
+		labels[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return
+}
+
+// arnify generates the ARN of an EC2 network resource
+func (d *networkDiscovery) arnify(typ string, ID *string) string {
+	return "arn:aws:ec2:" +
+		d.awsConfig.cfg.Region + ":" +
+		aws.ToString(d.awsConfig.accountID) +
+		":" + typ + "/" +
+		aws.ToString(ID)
+}
@@ -0,0 +1,351 @@
+/*
+ * Copyright 2025 Fraunhofer AISEC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ *           $$\                           $$\ $$\   $$\
+ *           $$ |                          $$ |\__|  $$ |
+ *  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+ * $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+ * $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+ * $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+ * \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+ *  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+ *
+ * This file is part of Clouditor Community Edition.
+ */
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	typesEC2 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	mockVPCID           = "vpc-1234"
+	mockSubnetID        = "subnet-1234"
+	mockSecurityGroupID = "sg-1234"
+	mockNetworkACLID    = "acl-1234"
+)
+
+// mockNetworkAPI implements the NetworkAPI interface for mock testing
+type mockNetworkAPI struct{}
+
+// mockNetworkAPIWithErrors implements the NetworkAPI interface (API call returning error) for mock testing
+type mockNetworkAPIWithErrors struct{}
+
+func (mockNetworkAPI) DescribeVpcs(_ context.Context, _ *ec2.DescribeVpcsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	return &ec2.DescribeVpcsOutput{
+		Vpcs: []typesEC2.Vpc{
+			{
+				VpcId: aws.String(mockVPCID),
+				Tags: []typesEC2.Tag{
+					{Key: aws.String("Name"), Value: aws.String("My VPC")},
+				},
+			},
+		},
+	}, nil
+}
+
+func (mockNetworkAPI) DescribeSubnets(_ context.Context, _ *ec2.DescribeSubnetsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	return &ec2.DescribeSubnetsOutput{
+		Subnets: []typesEC2.Subnet{
+			{
+				SubnetId:            aws.String(mockSubnetID),
+				VpcId:               aws.String(mockVPCID),
+				MapPublicIpOnLaunch: aws.Bool(true),
+			},
+		},
+	}, nil
+}
+
+func (mockNetworkAPI) DescribeSecurityGroups(_ context.Context, _ *ec2.DescribeSecurityGroupsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return &ec2.DescribeSecurityGroupsOutput{
+		SecurityGroups: []typesEC2.SecurityGroup{
+			{
+				GroupId:     aws.String(mockSecurityGroupID),
+				GroupName:   aws.String("ssh"),
+				Description: aws.String("Allow SSH"),
+				VpcId:       aws.String(mockVPCID),
+				IpPermissions: []typesEC2.IpPermission{
+					{
+						IpProtocol: aws.String("tcp"),
+						FromPort:   aws.Int32(22),
+						ToPort:     aws.Int32(22),
+						IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+					},
+				},
+				IpPermissionsEgress: []typesEC2.IpPermission{
+					{
+						IpProtocol: aws.String("-1"),
+						IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (mockNetworkAPI) DescribeNetworkAcls(_ context.Context, _ *ec2.DescribeNetworkAclsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	return &ec2.DescribeNetworkAclsOutput{
+		NetworkAcls: []typesEC2.NetworkAcl{
+			{
+				NetworkAclId: aws.String(mockNetworkACLID),
+				VpcId:        aws.String(mockVPCID),
+				Associations: []typesEC2.NetworkAclAssociation{
+					{SubnetId: aws.String(mockSubnetID)},
+				},
+				Entries: []typesEC2.NetworkAclEntry{
+					{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(true), RuleAction: typesEC2.RuleActionAllow},
+					{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(false), RuleAction: typesEC2.RuleActionDeny},
+				},
+			},
+		},
+	}, nil
+}
+
+func (mockNetworkAPIWithErrors) DescribeVpcs(_ context.Context, _ *ec2.DescribeVpcsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeVpcsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockNetworkAPIWithErrors) DescribeSubnets(_ context.Context, _ *ec2.DescribeSubnetsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockNetworkAPIWithErrors) DescribeSecurityGroups(_ context.Context, _ *ec2.DescribeSecurityGroupsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func (mockNetworkAPIWithErrors) DescribeNetworkAcls(_ context.Context, _ *ec2.DescribeNetworkAclsInput, _ ...func(options *ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	return nil, &smithy.GenericAPIError{Code: "500", Message: "Internal Server Error"}
+}
+
+func TestNetworkDiscovery_List(t *testing.T) {
+	d := networkDiscovery{
+		networkAPI:    mockNetworkAPI{},
+		isDiscovering: true,
+		awsConfig: &Client{
+			cfg: aws.Config{
+				Region: "eu-central-1",
+			},
+			accountID: aws.String("MockAccountID1234"),
+		},
+	}
+	list, err := d.List()
+	assert.NoError(t, err)
+	// VPC + subnet + security group
+	assert.Equal(t, 3, len(list))
+
+	vpcID := "arn:aws:ec2:eu-central-1:MockAccountID1234:vpc/" + mockVPCID
+
+	vpc, ok := list[0].(*ontology.VirtualNetwork)
+	assert.True(t, ok)
+	assert.Equal(t, vpcID, vpc.Id)
+	assert.Equal(t, "My VPC", vpc.Name)
+
+	subnet, ok := list[1].(*ontology.VirtualSubNetwork)
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:subnet/"+mockSubnetID, subnet.Id)
+	// The subnet assigns public IPs, but its network ACL denies all inbound traffic
+	assert.False(t, subnet.InternetAccessibleEndpoint)
+	assert.Equal(t, vpcID, subnet.GetParentId())
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:network-acl/"+mockNetworkACLID, subnet.Labels[LabelNetworkACLID])
+	assert.Contains(t, subnet.Raw, mockNetworkACLID)
+
+	group, ok := list[2].(*ontology.NetworkSecurityGroup)
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:MockAccountID1234:security-group/"+mockSecurityGroupID, group.Id)
+	assert.Equal(t, "Allow SSH", group.Description)
+	assert.True(t, group.InternetAccessibleEndpoint)
+	assert.Contains(t, group.Raw, "IpPermissionsEgress")
+
+	d = networkDiscovery{
+		networkAPI: mockNetworkAPIWithErrors{},
+	}
+	_, err = d.List()
+	assert.ErrorContains(t, err, "could not discover VPCs")
+}
+
+func Test_openToInternet(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []typesEC2.IpPermission
+		want        bool
+	}{
+		{
+			name: "IPv4",
+			permissions: []typesEC2.IpPermission{
+				{IpProtocol: aws.String("-1"), IpRanges: []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+			},
+			want: true,
+		},
+		{
+			name: "IPv6",
+			permissions: []typesEC2.IpPermission{
+				{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int32(443),
+					ToPort:     aws.Int32(443),
+					Ipv6Ranges: []typesEC2.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ICMP only",
+			permissions: []typesEC2.IpPermission{
+				{
+					IpProtocol: aws.String("icmp"),
+					FromPort:   aws.Int32(8),
+					ToPort:     aws.Int32(-1),
+					IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "restricted",
+			permissions: []typesEC2.IpPermission{
+				{IpProtocol: aws.String("-1"), IpRanges: []typesEC2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}}},
+				{IpProtocol: aws.String("-1"), UserIdGroupPairs: []typesEC2.UserIdGroupPair{{GroupId: aws.String(mockSecurityGroupID)}}},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, openToInternet(tt.permissions))
+		})
+	}
+}
+
+func Test_securityGroupsFirewall(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []*typesEC2.SecurityGroup
+		want   *ontology.L3Firewall
+	}{
+		{
+			name: "no security groups",
+			want: &ontology.L3Firewall{},
+		},
+		{
+			name: "restricted ports",
+			groups: []*typesEC2.SecurityGroup{
+				{
+					IpPermissions: []typesEC2.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(443),
+							ToPort:     aws.Int32(443),
+							IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+						},
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(22),
+							ToPort:     aws.Int32(22),
+							IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+						},
+					},
+				},
+				{
+					IpPermissions: []typesEC2.IpPermission{
+						{
+							IpProtocol:       aws.String("udp"),
+							FromPort:         aws.Int32(8000),
+							ToPort:           aws.Int32(8080),
+							UserIdGroupPairs: []typesEC2.UserIdGroupPair{{GroupId: aws.String(mockSecurityGroupID)}},
+						},
+					},
+				},
+			},
+			want: &ontology.L3Firewall{Enabled: true, Inbound: true, RestrictedPorts: "22,8000-8080"},
+		},
+		{
+			name: "all traffic allowed",
+			groups: []*typesEC2.SecurityGroup{
+				{
+					IpPermissions: []typesEC2.IpPermission{
+						{IpProtocol: aws.String("-1"), IpRanges: []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(22),
+							ToPort:     aws.Int32(22),
+							IpRanges:   []typesEC2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+						},
+					},
+					IpPermissionsEgress: []typesEC2.IpPermission{
+						{IpProtocol: aws.String("-1"), IpRanges: []typesEC2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+					},
+				},
+			},
+			want: &ontology.L3Firewall{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, securityGroupsFirewall(tt.groups))
+		})
+	}
+}
+
+func Test_aclOpenToInternet(t *testing.T) {
+	assert.True(t, aclOpenToInternet([]typesEC2.NetworkAclEntry{
+		{Ipv6CidrBlock: aws.String("::/0"), Egress: aws.Bool(false), RuleAction: typesEC2.RuleActionAllow},
+	}))
+	assert.False(t, aclOpenToInternet([]typesEC2.NetworkAclEntry{
+		{CidrBlock: aws.String("0.0.0.0/0"), Egress: aws.Bool(true), RuleAction: typesEC2.RuleActionAllow},
+		{CidrBlock: aws.String("10.0.0.0/8"), Egress: aws.Bool(false), RuleAction: typesEC2.RuleActionAllow},
+	}))
+}
+
+func TestNetworkDiscovery_Name(t *testing.T) {
+	d := networkDiscovery{}
+	assert.Equal(t, "AWS Network", d.Name())
+}
+
+func TestNewAwsNetworkDiscovery(t *testing.T) {
+	// Mock newFromConfigEC2 and store the original function back at the end of the test
+	old := newFromConfigEC2
+	defer func() { newFromConfigEC2 = old }()
+
+	newFromConfigEC2 = func(cfg aws.Config, optFns ...func(*ec2.Options)) *ec2.Client {
+		return &ec2.Client{}
+	}
+
+	mockClient := &Client{
+		cfg: aws.Config{
+			Region: "eu-central-1",
+		},
+		accountID: aws.String("1234"),
+	}
+
+	got := NewAwsNetworkDiscovery(mockClient, testdata.MockTargetOfEvaluationID1)
+	assert.Equal(t, discovery.Discoverer(&networkDiscovery{
+		networkAPI:    &ec2.Client{},
+		isDiscovering: true,
+		awsConfig:     mockClient,
+		ctID:          testdata.MockTargetOfEvaluationID1,
+	}), got, assert.CompareAllUnexported())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, got.TargetOfEvaluationID())
+}
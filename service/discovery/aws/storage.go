@@ -32,6 +32,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"clouditor.io/clouditor/v2/api/discovery"
@@ -39,6 +40,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
@@ -47,6 +49,7 @@ import (
 // awsS3Discovery handles the AWS API requests regarding the S3 service
 type awsS3Discovery struct {
 	storageAPI    S3API
+	kmsAPI        KMSAPI
 	isDiscovering bool
 	awsConfig     *Client
 	ctID          string
@@ -180,6 +183,7 @@ func (b *bucket) String() string {
 func NewAwsStorageDiscovery(client *Client, TargetOfEvaluationID string) discovery.Discoverer {
 	return &awsS3Discovery{
 		storageAPI:    s3.NewFromConfig(client.cfg),
+		kmsAPI:        newFromConfigKMS(client.cfg),
 		isDiscovering: true,
 		awsConfig:     client,
 		ctID:          TargetOfEvaluationID,
@@ -259,8 +263,8 @@ func (d *awsS3Discovery) getEncryptionAtRest(bucket *bucket) (e *ontology.AtRest
 				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 					Algorithm: "", // not available
 					Enabled:   true,
-					// TODO(lebogg): Check in console if bucket.region is the actual region of the key arn
-					KeyUrl: "arn:aws:kms:" + bucket.region + ":" + aws.ToString(d.awsConfig.accountID) + ":key/" + aws.ToString(resp.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+					// SSE-KMS requires the key to be in the same region as the bucket
+					KeyUrl: d.kmsKeyARN(bucket.region, resp.ServerSideEncryptionConfiguration.Rules[0].ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
 				},
 			},
 		}
@@ -268,6 +272,38 @@ func (d *awsS3Discovery) getEncryptionAtRest(bucket *bucket) (e *ontology.AtRest
 	return
 }
 
+// kmsKeyARN returns the ARN of the KMS key used for the bucket encryption, which matches the ID of the key discovered
+// by the key discoverer. The key can either be specified by its ID, its ARN or an alias. An alias is resolved to the
+// key it refers to. If this fails, the alias ARN is returned instead.
+func (d *awsS3Discovery) kmsKeyARN(region string, keyID *string) string {
+	id := aws.ToString(keyID)
+
+	if !strings.HasPrefix(id, "arn:") {
+		prefix := "arn:aws:kms:" + region + ":" + aws.ToString(d.awsConfig.accountID) + ":"
+		if !strings.HasPrefix(id, "alias/") {
+			return prefix + "key/" + id
+		}
+
+		id = prefix + id
+	}
+
+	if !strings.Contains(id, ":alias/") || d.kmsAPI == nil {
+		return id
+	}
+
+	resp, err := d.kmsAPI.DescribeKey(context.TODO(), &kms.DescribeKeyInput{
+		KeyId: aws.String(id),
+	}, func(o *kms.Options) {
+		o.Region = region
+	})
+	if err != nil {
+		log.Warnf("Could not resolve KMS alias '%s': %v", id, prettyError(err))
+		return id
+	}
+
+	return aws.ToString(resp.KeyMetadata.Arn)
+}
+
 // "confirm that your bucket policies explicitly deny access to HTTP requests"
 // https://aws.amazon.com/premiumsupport/knowledge-center/s3-bucket-policy-for-config-rule/
 // getTransportEncryption loops over all statements in the bucket policy and checks if one statement denies https only == false
@@ -545,3 +545,24 @@ func Test_awsS3Discovery_TargetOfEvaluationID(t *testing.T) {
 		})
 	}
 }
+
+func Test_awsS3Discovery_kmsKeyARN(t *testing.T) {
+	d := awsS3Discovery{
+		awsConfig: &Client{
+			accountID: aws.String("123456789"),
+		},
+	}
+
+	assert.Equal(t, "arn:aws:kms:"+mockBucket2Region+":"+"123456789"+":key/"+mockBucket2KeyId, d.kmsKeyARN(mockBucket2Region, aws.String(mockBucket2KeyId)))
+	assert.Equal(t, mockKMSKeyARN, d.kmsKeyARN(mockBucket2Region, aws.String(mockKMSKeyARN)))
+	assert.Equal(t, "arn:aws:kms:"+mockBucket2Region+":"+"123456789"+":alias/my-key", d.kmsKeyARN(mockBucket2Region, aws.String("alias/my-key")))
+
+	// Aliases are resolved to the key they refer to
+	d.kmsAPI = mockKMSAPI{}
+	assert.Equal(t, mockKMSKeyARN, d.kmsKeyARN(mockBucket2Region, aws.String("alias/mock-key")))
+	assert.Equal(t, mockKMSKeyARN, d.kmsKeyARN(mockBucket2Region, aws.String("arn:aws:kms:"+mockBucket2Region+":123456789:alias/mock-key")))
+
+	// If the alias cannot be resolved, the alias ARN is returned
+	d.kmsAPI = mockKMSAPIWithErrors{}
+	assert.Equal(t, "arn:aws:kms:"+mockBucket2Region+":"+"123456789"+":alias/my-key", d.kmsKeyARN(mockBucket2Region, aws.String("alias/my-key")))
+}
@@ -42,10 +42,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/machinelearning/armmachinelearning"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managementgroups/armmanagementgroups"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
@@ -69,6 +74,9 @@ const (
 	AES256 = "AES256"
 
 	RetentionPeriod90Days = 90 * time.Hour * 24
+
+	// AllSubscriptions can be used with [WithSubscriptions] to discover all subscriptions the credential has access to.
+	AllSubscriptions = "all"
 )
 
 var (
@@ -117,6 +125,23 @@ func WithResourceGroup(rg string) DiscoveryOption {
 	}
 }
 
+// WithSubscriptions is a [DiscoveryOption] that configures the IDs of the subscriptions to discover. If it contains
+// [AllSubscriptions], all subscriptions the credential has access to are discovered. By default, only the first
+// subscription is discovered.
+func WithSubscriptions(ids []string) DiscoveryOption {
+	return func(d *azureDiscovery) {
+		d.subIDs = append(d.subIDs, ids...)
+	}
+}
+
+// WithManagementGroup is a [DiscoveryOption] that discovers all subscriptions below the given management group,
+// including those of nested management groups.
+func WithManagementGroup(groupID string) DiscoveryOption {
+	return func(d *azureDiscovery) {
+		d.managementGroup = &groupID
+	}
+}
+
 func init() {
 	log = logrus.WithField("component", "azure-discovery")
 }
@@ -126,6 +151,13 @@ type azureDiscovery struct {
 
 	sub  *armsubscription.Subscription
 	cred azcore.TokenCredential
+	// subs contains all subscriptions that are discovered. Each of them is discovered by a copy of this discoverer
+	// with sub set accordingly.
+	subs []*armsubscription.Subscription
+	// subIDs optionally contains the IDs of the subscriptions to discover, see [WithSubscriptions].
+	subIDs []string
+	// managementGroup optionally contains the ID of a management group, whose subscriptions are discovered.
+	managementGroup *string
 	// rg optionally contains the name of a resource group. If this is not nil, all discovery calls will be scoped to the particular resource group.
 	rg                  *string
 	clientOptions       arm.ClientOptions
@@ -134,6 +166,10 @@ type azureDiscovery struct {
 	ctID                string
 	backupMap           map[string]*backup
 	defenderProperties  map[string]*defenderProperties
+	// tenant collects the role assignments of the subscription, if it is discovered by a copy of the discoverer (see
+	// [azureDiscovery.forSubscription]). The identities and the role assignments inherited from management groups are
+	// shared by all subscriptions and are therefore discovered only once (see [azureDiscovery.discoverTenant]).
+	tenant *tenantResources
 }
 
 type defenderProperties struct {
@@ -167,6 +203,9 @@ type clients struct {
 	loadBalancerClient          *armnetwork.LoadBalancersClient
 	applicationGatewayClient    *armnetwork.ApplicationGatewaysClient
 	networkSecurityGroupsClient *armnetwork.SecurityGroupsClient
+	virtualNetworksClient       *armnetwork.VirtualNetworksClient
+	firewallsClient             *armnetwork.AzureFirewallsClient
+	ddosProtectionPlansClient   *armnetwork.DdosProtectionPlansClient
 
 	// AppService
 	webAppsClient *armappservice.WebAppsClient
@@ -176,6 +215,10 @@ type clients struct {
 	blockStorageClient    *armcompute.DisksClient
 	diskEncSetClient      *armcompute.DiskEncryptionSetsClient
 
+	// Container
+	managedClustersClient *armcontainerservice.ManagedClustersClient
+	registriesClient      *armcontainerregistry.RegistriesClient
+
 	// Security
 	defenderClient *armsecurity.PricingsClient
 
@@ -190,6 +233,19 @@ type clients struct {
 
 	// Resource groups
 	rgClient *armresources.ResourceGroupsClient
+
+	// Management groups
+	managementGroupsClient *armmanagementgroups.Client
+
+	// Key Vault
+	keyVaultsClient *armkeyvault.VaultsClient
+	keysClient      *armkeyvault.KeysClient
+	secretsClient   *armkeyvault.SecretsClient
+
+	// Identity
+	roleAssignmentsClient *armauthorization.RoleAssignmentsClient
+	roleDefinitionsClient *armauthorization.RoleDefinitionsClient
+	graphClient           *graphClient
 }
 
 func NewAzureDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
@@ -209,14 +265,28 @@ func NewAzureDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
 
 // List discovers the following Azure resources types:
 // - ResourceGroup resource
+// - Identity resource
 // - Storage resource
 // - Compute resource
+// - Container resource
 // - Network resource
+// - Key Vault resource
+//
+// If multiple subscriptions are configured, they are discovered concurrently and each resource is labelled with the ID
+// of its subscription.
 func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	if err = d.authorize(); err != nil {
 		return nil, fmt.Errorf("%s: %w", ErrCouldNotAuthenticate, err)
 	}
 
+	return d.discoverSubscriptions()
+}
+
+// discoverSubscription discovers the resources of the subscription of the discoverer. Errors of the identity, key vault,
+// container, virtual network, network security group and firewall discovery are logged and the remaining resource
+// types are discovered nevertheless.
+func (d *azureDiscovery) discoverSubscription() (list []ontology.IsResource, err error) {
+
 	// Discover resource group resources
 	log.Info("Discover Azure resource group resources...")
 	rg, err := d.discoverResourceGroups()
@@ -225,6 +295,14 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, rg...)
 
+	// Discover identity resources
+	log.Info("Discover Azure identity resources...")
+	identities, err := d.discoverRoleAssignments()
+	if err != nil {
+		log.Errorf("could not discover role assignments: %v", err)
+	}
+	list = append(list, identities...)
+
 	// Discover storage resources
 	log.Info("Discover Azure storage resources...")
 
@@ -255,6 +333,14 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, cosmosDB...)
 
+	// Discover key vaults
+	log.Info("Discover Azure key vault resources...")
+	keyVaults, err := d.discoverKeyVaults()
+	if err != nil {
+		log.Errorf("could not discover key vaults: %v", err)
+	}
+	list = append(list, keyVaults...)
+
 	// Discover compute resources
 	log.Info("Discover Azure compute resources...")
 
@@ -291,9 +377,40 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 
 	list = append(list, resources...)
 
+	// Discover container resources
+	log.Info("Discover Azure container resources...")
+
+	// Discover AKS clusters
+	clusters, err := d.discoverManagedClusters()
+	if err != nil {
+		log.Errorf("could not discover managed clusters: %v", err)
+	}
+	list = append(list, clusters...)
+
+	// Discover container registries
+	registries, err := d.discoverContainerRegistries()
+	if err != nil {
+		log.Errorf("could not discover container registries: %v", err)
+	}
+	list = append(list, registries...)
+
 	// Discover network resources
 	log.Info("Discover Azure network resources...")
 
+	// Discover virtual networks and their subnets
+	virtualNetworks, err := d.discoverVirtualNetworks()
+	if err != nil {
+		log.Errorf("could not discover virtual networks: %v", err)
+	}
+	list = append(list, virtualNetworks...)
+
+	// Discover network security groups
+	nsgs, err := d.discoverNetworkSecurityGroups()
+	if err != nil {
+		log.Errorf("could not discover network security groups: %v", err)
+	}
+	list = append(list, nsgs...)
+
 	// Discover network interfaces
 	networkInterfaces, err := d.discoverNetworkInterfaces()
 	if err != nil {
@@ -315,6 +432,13 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, ag...)
 
+	// Discover Azure Firewalls
+	firewalls, err := d.discoverFirewalls()
+	if err != nil {
+		log.Errorf("could not discover firewalls: %v", err)
+	}
+	list = append(list, firewalls...)
+
 	// Discover machine learning workspaces
 	mlWorkspaces, err := d.discoverMLWorkspaces()
 	if err != nil {
@@ -322,6 +446,10 @@ func (d *azureDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, mlWorkspaces...)
 
+	// Discover the diagnostic settings of all resources, whose logging has not been discovered yet
+	log.Info("Discover Azure diagnostic settings...")
+	d.addDiagnosticSettings(list)
+
 	return list, nil
 }
 
@@ -358,16 +486,26 @@ func (d *azureDiscovery) authorize() (err error) {
 		subList = append(subList, pageResponse.ListResult.Value...)
 	}
 
+	// Select the subscriptions to discover
+	d.subs, err = d.selectSubscriptions(subList)
+	if err != nil {
+		return err
+	}
+
 	// check if list of subscriptions is empty
-	if len(subList) == 0 {
+	if len(d.subs) == 0 {
 		err = errors.New("list of subscriptions is empty")
 		return
 	}
 
-	// get first subscription
-	d.sub = subList[0]
+	// The first subscription is used for all clients, that are not created for a specific subscription
+	d.sub = d.subs[0]
 
-	log.Infof("Azure %s discoverer uses %s as subscription", d.discovererComponent, *d.sub.SubscriptionID)
+	if len(d.subs) == 1 {
+		log.Infof("Azure %s discoverer uses %s as subscription", d.discovererComponent, *d.sub.SubscriptionID)
+	} else {
+		log.Infof("Azure %s discoverer uses %d subscriptions", d.discovererComponent, len(d.subs))
+	}
 
 	d.isAuthorized = true
 
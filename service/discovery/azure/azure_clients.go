@@ -33,10 +33,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/cosmos/armcosmos"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/dataprotection/armdataprotection"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/machinelearning/armmachinelearning"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managementgroups/armmanagementgroups"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
@@ -153,6 +158,13 @@ func (d *azureDiscovery) initMongoDResourcesBClient() (err error) {
 	return
 }
 
+// initDDoSProtectionPlansClient creates the client if not already exists
+func (d *azureDiscovery) initDDoSProtectionPlansClient() (err error) {
+	d.clients.ddosProtectionPlansClient, err = initClientWithSubID(d.clients.ddosProtectionPlansClient, d, armnetwork.NewDdosProtectionPlansClient)
+
+	return
+}
+
 // initDatabasesClient creates the client if not already exists
 func (d *azureDiscovery) initDatabasesClient() (err error) {
 	d.clients.databasesClient, err = initClientWithSubID(d.clients.databasesClient, d, armsql.NewDatabasesClient)
@@ -181,6 +193,34 @@ func (d *azureDiscovery) initFileStorageClient() (err error) {
 	return
 }
 
+// initFirewallsClient creates the client if not already exists
+func (d *azureDiscovery) initFirewallsClient() (err error) {
+	d.clients.firewallsClient, err = initClientWithSubID(d.clients.firewallsClient, d, armnetwork.NewAzureFirewallsClient)
+
+	return
+}
+
+// initGraphClient creates the client if not already exists
+func (d *azureDiscovery) initGraphClient() (err error) {
+	d.clients.graphClient, err = initClientWithoutSubID(d.clients.graphClient, d, newGraphClient)
+
+	return
+}
+
+// initKeysClient creates the client if not already exists
+func (d *azureDiscovery) initKeysClient() (err error) {
+	d.clients.keysClient, err = initClientWithSubID(d.clients.keysClient, d, armkeyvault.NewKeysClient)
+
+	return
+}
+
+// initKeyVaultsClient creates the client if not already exists
+func (d *azureDiscovery) initKeyVaultsClient() (err error) {
+	d.clients.keyVaultsClient, err = initClientWithSubID(d.clients.keyVaultsClient, d, armkeyvault.NewVaultsClient)
+
+	return
+}
+
 // initLoadBalancersClient creates the client if not already exists
 func (d *azureDiscovery) initLoadBalancersClient() (err error) {
 	d.clients.loadBalancerClient, err = initClientWithSubID(d.clients.loadBalancerClient, d, armnetwork.NewLoadBalancersClient)
@@ -188,6 +228,20 @@ func (d *azureDiscovery) initLoadBalancersClient() (err error) {
 	return
 }
 
+// initManagedClustersClient creates the client if not already exists
+func (d *azureDiscovery) initManagedClustersClient() (err error) {
+	d.clients.managedClustersClient, err = initClientWithSubID(d.clients.managedClustersClient, d, armcontainerservice.NewManagedClustersClient)
+
+	return
+}
+
+// initManagementGroupsClient creates the client if not already exists
+func (d *azureDiscovery) initManagementGroupsClient() (err error) {
+	d.clients.managementGroupsClient, err = initClientWithoutSubID(d.clients.managementGroupsClient, d, armmanagementgroups.NewClient)
+
+	return
+}
+
 // initNetworkInterfacesClient creates the client if not already exists
 func (d *azureDiscovery) initNetworkInterfacesClient() (err error) {
 	d.clients.networkInterfacesClient, err = initClientWithSubID(d.clients.networkInterfacesClient, d, armnetwork.NewInterfacesClient)
@@ -202,6 +256,13 @@ func (d *azureDiscovery) initNetworkSecurityGroupClient() (err error) {
 	return
 }
 
+// initRegistriesClient creates the client if not already exists
+func (d *azureDiscovery) initRegistriesClient() (err error) {
+	d.clients.registriesClient, err = initClientWithSubID(d.clients.registriesClient, d, armcontainerregistry.NewRegistriesClient)
+
+	return
+}
+
 // azureDiscovery creates the client if not already exists
 func (d *azureDiscovery) initResourceGroupsClient() (err error) {
 	d.clients.rgClient, err = initClientWithSubID(d.clients.rgClient, d, armresources.NewResourceGroupsClient)
@@ -209,6 +270,27 @@ func (d *azureDiscovery) initResourceGroupsClient() (err error) {
 	return
 }
 
+// initRoleAssignmentsClient creates the client if not already exists
+func (d *azureDiscovery) initRoleAssignmentsClient() (err error) {
+	d.clients.roleAssignmentsClient, err = initClientWithSubID(d.clients.roleAssignmentsClient, d, armauthorization.NewRoleAssignmentsClient)
+
+	return
+}
+
+// initRoleDefinitionsClient creates the client if not already exists
+func (d *azureDiscovery) initRoleDefinitionsClient() (err error) {
+	d.clients.roleDefinitionsClient, err = initClientWithoutSubID(d.clients.roleDefinitionsClient, d, armauthorization.NewRoleDefinitionsClient)
+
+	return
+}
+
+// initSecretsClient creates the client if not already exists
+func (d *azureDiscovery) initSecretsClient() (err error) {
+	d.clients.secretsClient, err = initClientWithSubID(d.clients.secretsClient, d, armkeyvault.NewSecretsClient)
+
+	return
+}
+
 // initSQLServersClient creates the client if not already exists
 func (d *azureDiscovery) initSQLServersClient() (err error) {
 	d.clients.sqlServersClient, err = initClientWithSubID(d.clients.sqlServersClient, d, armsql.NewServersClient)
@@ -230,6 +312,13 @@ func (d *azureDiscovery) initVirtualMachinesClient() (err error) {
 	return
 }
 
+// initVirtualNetworksClient creates the client if not already exists
+func (d *azureDiscovery) initVirtualNetworksClient() (err error) {
+	d.clients.virtualNetworksClient, err = initClientWithSubID(d.clients.virtualNetworksClient, d, armnetwork.NewVirtualNetworksClient)
+
+	return
+}
+
 // initWebAppsClient creates the client if not already exists
 func (d *azureDiscovery) initWebAppsClient() (err error) {
 	d.clients.webAppsClient, err = initClientWithSubID(d.clients.webAppsClient, d, armappservice.NewWebAppsClient)
@@ -39,6 +39,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -277,18 +279,20 @@ func publicNetworkAccessStatus(status *string) bool {
 }
 
 // discoverDiagnosticSettings discovers the diagnostic setting for the given resource URI and returns the information of the needed information of the log properties as ontology.ActivityLogging object and the Azure response.
+// The logging service IDs contain the Log Analytics workspaces, storage accounts and Event Hubs the logs are sent to.
 func (d *azureDiscovery) discoverDiagnosticSettings(resourceURI string) (*ontology.ActivityLogging, string, error) {
 	var (
-		al           *ontology.ActivityLogging
-		workspaceIDs []string
-		raw          string
+		al         *ontology.ActivityLogging
+		serviceIDs []string
+		retention  *durationpb.Duration
+		raw        string
 	)
 
 	if err := d.initDiagnosticsSettingsClient(); err != nil {
 		return nil, "", err
 	}
 
-	// List all diagnostic settings for the storage account
+	// List all diagnostic settings for the resource
 	listPager := d.clients.diagnosticSettingsClient.NewListPager(resourceURI, &armmonitor.DiagnosticSettingsClientListOptions{})
 	for listPager.More() {
 		pageResponse, err := listPager.NextPage(context.TODO())
@@ -298,25 +302,220 @@ func (d *azureDiscovery) discoverDiagnosticSettings(resourceURI string) (*ontolo
 		}
 
 		for _, value := range pageResponse.Value {
-			// Check if data is sent to a log analytics workspace
-			if value.Properties.WorkspaceID == nil {
-				log.Debugf("diagnostic setting '%s' does not send data to a Log Analytics Workspace", util.Deref(value.Name))
+			ids := diagnosticSettingDestinations(value.Properties)
+			if len(ids) == 0 {
+				log.Debugf("diagnostic setting '%s' does not send data to a Log Analytics Workspace, storage account or Event Hub", util.Deref(value.Name))
 				continue
 			}
 
-			// Add Log Analytics WorkspaceIDs to slice
-			workspaceIDs = append(workspaceIDs, util.Deref(value.Properties.WorkspaceID))
+			// Add the destinations to slice
+			serviceIDs = append(serviceIDs, ids...)
+
+			// The retention policy only applies to logs that are archived in a storage account
+			if value.Properties.StorageAccountID != nil {
+				retention = minRetention(retention, diagnosticSettingRetention(value.Properties))
+			}
 		}
 
 		raw = discovery.Raw(pageResponse)
 	}
 
-	if len(workspaceIDs) > 0 {
+	if len(serviceIDs) > 0 {
 		al = &ontology.ActivityLogging{
 			Enabled:           true,
-			LoggingServiceIds: workspaceIDs, // TODO(all): Each diagnostic setting has also a retention period, maybe we should add that information as well
+			LoggingServiceIds: serviceIDs,
+			RetentionPeriod:   retention,
 		}
 	}
 
 	return al, raw, nil
 }
+
+// diagnosticSettingDestinations returns the IDs of the Log Analytics workspace, storage account and Event Hub the
+// diagnostic setting sends its data to
+func diagnosticSettingDestinations(props *armmonitor.DiagnosticSettings) (ids []string) {
+	if props == nil {
+		return nil
+	}
+
+	if props.WorkspaceID != nil {
+		ids = append(ids, util.Deref(props.WorkspaceID))
+	}
+
+	if props.StorageAccountID != nil {
+		ids = append(ids, util.Deref(props.StorageAccountID))
+	}
+
+	// The authorization rule belongs to the Event Hub namespace, the Event Hub itself is optional
+	if props.EventHubAuthorizationRuleID != nil {
+		id := util.Deref(props.EventHubAuthorizationRuleID)
+		if i := strings.Index(strings.ToLower(id), "/authorizationrules/"); i != -1 {
+			id = id[:i]
+		}
+
+		if props.EventHubName != nil {
+			id += "/eventhubs/" + util.Deref(props.EventHubName)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// diagnosticSettingRetention returns the shortest retention period of the enabled log categories of the diagnostic
+// setting. A retention of 0 days means that the logs are kept forever, in this case nil is returned.
+func diagnosticSettingRetention(props *armmonitor.DiagnosticSettings) (retention *durationpb.Duration) {
+	for _, l := range props.Logs {
+		if l == nil || !util.Deref(l.Enabled) || l.RetentionPolicy == nil || !util.Deref(l.RetentionPolicy.Enabled) {
+			continue
+		}
+
+		days := util.Deref(l.RetentionPolicy.Days)
+		if days <= 0 {
+			continue
+		}
+
+		retention = minRetention(retention, durationpb.New(time.Duration(days)*time.Hour*24))
+	}
+
+	return retention
+}
+
+// minRetention returns the shorter of both retention periods, nil is treated as not set
+func minRetention(a, b *durationpb.Duration) *durationpb.Duration {
+	if a == nil {
+		return b
+	}
+
+	if b == nil || a.AsDuration() < b.AsDuration() {
+		return a
+	}
+
+	return b
+}
+
+// MaxParallelDiagnosticSettings is the maximum number of resources whose diagnostic settings are retrieved in parallel
+const MaxParallelDiagnosticSettings = 8
+
+// addDiagnosticSettings checks the diagnostic settings of every discovered Azure resource and sets its logging
+// properties accordingly, unless they have already been set by the resource specific discoverer. Depending on the
+// properties of the ontology type, the result is stored as [ontology.ResourceLogging], as [ontology.ActivityLogging]
+// or appended to the loggings. Resource types that do not support diagnostic settings are skipped. Since the
+// diagnostic settings can only be retrieved per resource, up to [MaxParallelDiagnosticSettings] requests are sent
+// concurrently.
+func (d *azureDiscovery) addDiagnosticSettings(list []ontology.IsResource) {
+	var (
+		g       errgroup.Group
+		targets []ontology.IsResource
+	)
+
+	for _, r := range list {
+		if isTopLevelResourceID(r.GetId()) && needsDiagnosticSettings(r) {
+			targets = append(targets, r)
+		}
+	}
+
+	if len(targets) == 0 {
+		return
+	}
+
+	// The client is initialized once, so that the concurrent requests do not race on its creation
+	if err := d.initDiagnosticsSettingsClient(); err != nil {
+		log.Warnf("Could not discover diagnostic settings: %v", err)
+		return
+	}
+
+	results := make([]*ontology.ActivityLogging, len(targets))
+	errs := make([]error, len(targets))
+
+	g.SetLimit(MaxParallelDiagnosticSettings)
+
+	for i, r := range targets {
+		g.Go(func() error {
+			results[i], _, errs[i] = d.discoverDiagnosticSettings(r.GetId())
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	for i, r := range targets {
+		if errs[i] != nil {
+			log.Warnf("Could not discover diagnostic settings of resource '%s': %v", r.GetId(), errs[i])
+			continue
+		}
+
+		al := results[i]
+		if al == nil {
+			// Logging is explicitly disabled
+			al = &ontology.ActivityLogging{}
+		}
+
+		setResourceLogging(r, al)
+	}
+}
+
+// needsDiagnosticSettings returns true, if the resource has a logging property that can be derived from diagnostic
+// settings and its logging has not already been discovered
+func needsDiagnosticSettings(r ontology.IsResource) bool {
+	m := r.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	switch {
+	case fields.ByName("resource_logging") != nil:
+		return !m.Has(fields.ByName("resource_logging"))
+	case fields.ByName("activity_logging") != nil:
+		return !m.Has(fields.ByName("activity_logging"))
+	case fields.ByName("loggings") != nil:
+		return !slices.ContainsFunc(getLoggings(r), func(l *ontology.Logging) bool {
+			return l.GetResourceLogging() != nil
+		})
+	default:
+		return false
+	}
+}
+
+// setResourceLogging stores the logging derived from the diagnostic settings in the matching property of the resource
+func setResourceLogging(r ontology.IsResource, al *ontology.ActivityLogging) {
+	m := r.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	switch {
+	case fields.ByName("resource_logging") != nil:
+		m.Set(fields.ByName("resource_logging"), protoreflect.ValueOfMessage(toResourceLogging(al).ProtoReflect()))
+	case fields.ByName("activity_logging") != nil:
+		m.Set(fields.ByName("activity_logging"), protoreflect.ValueOfMessage(al.ProtoReflect()))
+	case fields.ByName("loggings") != nil:
+		l := &ontology.Logging{
+			Type: &ontology.Logging_ResourceLogging{ResourceLogging: toResourceLogging(al)},
+		}
+		m.Mutable(fields.ByName("loggings")).List().Append(protoreflect.ValueOfMessage(l.ProtoReflect()))
+	}
+}
+
+// isTopLevelResourceID returns true, if the ID belongs to an Azure resource that is no sub-resource of another
+// resource, e.g., a virtual network, but not one of its subnets. Only these resources support diagnostic settings.
+func isTopLevelResourceID(id string) bool {
+	parts := strings.Split(strings.ToLower(id), "/")
+
+	return len(parts) == 9 && parts[1] == "subscriptions" && parts[3] == "resourcegroups" && parts[5] == "providers"
+}
+
+// getLoggings returns the loggings of the resource, if it has any
+func getLoggings(r ontology.IsResource) []*ontology.Logging {
+	if l, ok := r.(interface{ GetLoggings() []*ontology.Logging }); ok {
+		return l.GetLoggings()
+	}
+
+	return nil
+}
+
+// toResourceLogging converts the logging information of a diagnostic setting into a [ontology.ResourceLogging]
+func toResourceLogging(al *ontology.ActivityLogging) *ontology.ResourceLogging {
+	return &ontology.ResourceLogging{
+		Enabled:           al.GetEnabled(),
+		LoggingServiceIds: al.GetLoggingServiceIds(),
+		RetentionPeriod:   al.GetRetentionPeriod(),
+	}
+}
@@ -27,11 +27,14 @@ package azure
 
 import (
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/util"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appservice/armappservice/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func Test_tlsCipherSuites(t *testing.T) {
@@ -223,3 +226,84 @@ func Test_azureStorageDiscovery_discoverDiagnosticSettings(t *testing.T) {
 		})
 	}
 }
+
+func Test_azureDiscovery_addDiagnosticSettings(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	vaultID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.keyvault/vaults/keyvault1"
+	clusterID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.containerservice/managedclusters/aks1"
+	existing := &ontology.ResourceLogging{Enabled: true}
+
+	list := []ontology.IsResource{
+		&ontology.KeyVault{Id: vaultID},
+		&ontology.Key{Id: vaultID + "/keys/key1"},
+		&ontology.ContainerOrchestration{Id: clusterID, ResourceLogging: existing},
+	}
+
+	d.addDiagnosticSettings(list)
+
+	kv := assert.Is[*ontology.KeyVault](t, list[0])
+	assert.Equal(t, 1, len(kv.Loggings))
+	assert.Equal(t, &ontology.ResourceLogging{
+		Enabled: true,
+		LoggingServiceIds: []string{
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Storage/storageAccounts/logs",
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.EventHub/namespaces/ns1/eventhubs/logs",
+		},
+		RetentionPeriod: durationpb.New(90 * 24 * time.Hour),
+	}, kv.Loggings[0].GetResourceLogging())
+
+	// Keys are no top-level resources and do not support diagnostic settings
+	key := assert.Is[*ontology.Key](t, list[1])
+	assert.Empty(t, key.Loggings)
+
+	// Already discovered logging is not overwritten
+	aks := assert.Is[*ontology.ContainerOrchestration](t, list[2])
+	assert.Same(t, existing, aks.ResourceLogging)
+}
+
+func Test_diagnosticSettingRetention(t *testing.T) {
+	tests := []struct {
+		name  string
+		props *armmonitor.DiagnosticSettings
+		want  *durationpb.Duration
+	}{
+		{
+			name:  "no logs",
+			props: &armmonitor.DiagnosticSettings{},
+			want:  nil,
+		},
+		{
+			name: "retention disabled",
+			props: &armmonitor.DiagnosticSettings{
+				Logs: []*armmonitor.LogSettings{
+					{Enabled: util.Ref(true), RetentionPolicy: &armmonitor.RetentionPolicy{Enabled: util.Ref(false), Days: util.Ref(int32(30))}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "shortest retention of enabled categories",
+			props: &armmonitor.DiagnosticSettings{
+				Logs: []*armmonitor.LogSettings{
+					{Enabled: util.Ref(true), RetentionPolicy: &armmonitor.RetentionPolicy{Enabled: util.Ref(true), Days: util.Ref(int32(30))}},
+					{Enabled: util.Ref(false), RetentionPolicy: &armmonitor.RetentionPolicy{Enabled: util.Ref(true), Days: util.Ref(int32(7))}},
+					{Enabled: util.Ref(true), RetentionPolicy: &armmonitor.RetentionPolicy{Enabled: util.Ref(true), Days: util.Ref(int32(0))}},
+				},
+			},
+			want: durationpb.New(30 * 24 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diagnosticSettingRetention(tt.props))
+		})
+	}
+}
+
+func Test_isTopLevelResourceID(t *testing.T) {
+	assert.True(t, isTopLevelResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1"))
+	assert.False(t, isTopLevelResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1"))
+	assert.False(t, isTopLevelResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1"))
+	assert.False(t, isTopLevelResourceID("/providers/microsoft.graph/users/user1"))
+}
@@ -1167,6 +1167,435 @@ func (mockSender) Do(req *http.Request) (res *http.Response, err error) {
 				},
 			},
 		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.KeyVault/vaults" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1",
+					"name":     "keyvault1",
+					"location": "eastus",
+					"tags": map[string]interface{}{
+						"testKey1": "testTag1",
+					},
+					"systemData": map[string]interface{}{
+						"createdAt": "2017-05-24T13:28:53.004540398Z",
+					},
+					"properties": map[string]interface{}{
+						"tenantId":              "00000000-0000-0000-0000-000000000000",
+						"sku":                   map[string]interface{}{"family": "A", "name": "standard"},
+						"vaultUri":              "https://keyvault1.vault.azure.net/",
+						"enableSoftDelete":      true,
+						"enablePurgeProtection": true,
+						"networkAcls": map[string]interface{}{
+							"defaultAction": "Deny",
+							"bypass":        "AzureServices",
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/keys" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/keys/customer-key",
+					"name": "customer-key",
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/keys/customer-key" {
+		return createResponse(req, map[string]interface{}{
+			"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/keys/customer-key",
+			"name": "customer-key",
+			"properties": map[string]interface{}{
+				"attributes": map[string]interface{}{
+					"enabled": true,
+					"created": 1704067200, // 2024-01-01
+					"exp":     1735689600, // 2025-01-01
+				},
+				"kty":     "RSA",
+				"keySize": 2048,
+				"keyUri":  "https://keyvault1.vault.azure.net/keys/customer-key",
+				"rotationPolicy": map[string]interface{}{
+					"lifetimeActions": &[]map[string]interface{}{
+						{
+							"action":  map[string]interface{}{"type": "rotate"},
+							"trigger": map[string]interface{}{"timeAfterCreate": "P90D"},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/secrets" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/secrets/secret1",
+					"name": "secret1",
+					"properties": map[string]interface{}{
+						"attributes": map[string]interface{}{
+							"enabled": true,
+							"created": 1704067200, // 2024-01-01
+							"exp":     1735689600, // 2025-01-01
+						},
+						"secretUri": "https://keyvault1.vault.azure.net/secrets/secret1",
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Host == "keyvault1.vault.azure.net" && req.URL.Path == "/certificates" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id": "https://keyvault1.vault.azure.net/certificates/cert1",
+					"attributes": map[string]interface{}{
+						"enabled": true,
+						"created": 1704067200, // 2024-01-01
+						"nbf":     1704067200, // 2024-01-01
+						"exp":     1735689600, // 2025-01-01
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/8e3af657-a8ff-443c-a75c-2fe8c4bcb635",
+					"name": "8e3af657-a8ff-443c-a75c-2fe8c4bcb635",
+					"properties": map[string]interface{}{
+						"roleName": "Owner",
+						"type":     "BuiltInRole",
+						"permissions": []map[string]interface{}{
+							{
+								"actions": []string{"*"},
+							},
+						},
+					},
+				},
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+					"name": "acdd72a7-3385-48ef-bd42-f606fba81ae7",
+					"properties": map[string]interface{}{
+						"roleName": "Reader",
+						"type":     "BuiltInRole",
+						"permissions": []map[string]interface{}{
+							{
+								"actions": []string{"*/read"},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/ra1",
+					"name": "ra1",
+					"properties": map[string]interface{}{
+						"roleDefinitionId": "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/8e3af657-a8ff-443c-a75c-2fe8c4bcb635",
+						"principalId":      "user1",
+						"principalType":    "User",
+						"scope":            "/subscriptions/00000000-0000-0000-0000-000000000000",
+						"createdOn":        "2017-05-07T02:09:07.7598585Z",
+					},
+				},
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/ra2",
+					"name": "ra2",
+					"properties": map[string]interface{}{
+						"roleDefinitionId": "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+						"principalId":      "sp1",
+						"principalType":    "ServicePrincipal",
+						"scope":            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1",
+						"createdOn":        "2017-05-07T02:09:07.7598585Z",
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Host == "graph.microsoft.com" && req.URL.Path == "/v1.0/directoryObjects/getByIds" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"@odata.type":       "#microsoft.graph.user",
+					"id":                "user1",
+					"displayName":       "Guest User",
+					"userPrincipalName": "guest_example.com#EXT#@tenant.onmicrosoft.com",
+					"userType":          "Guest",
+					"accountEnabled":    true,
+					"createdDateTime":   "2017-05-07T02:09:07Z",
+				},
+				{
+					"@odata.type":          "#microsoft.graph.servicePrincipal",
+					"id":                   "sp1",
+					"displayName":          "app1",
+					"appId":                "app1",
+					"servicePrincipalType": "Application",
+					"accountEnabled":       true,
+				},
+			},
+		}, 200)
+	} else if req.URL.Host == "graph.microsoft.com" && req.URL.Path == "/v1.0/reports/authenticationMethods/userRegistrationDetails" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":                "user1",
+					"isMfaRegistered":   true,
+					"isAdmin":           false,
+					"methodsRegistered": []string{"microsoftAuthenticatorPush"},
+				},
+				{
+					"id":              "user2",
+					"isMfaRegistered": false,
+				},
+			},
+		}, 200)
+	} else if req.URL.Host == "graph.microsoft.com" && req.URL.Path == "/v1.0/applications(appId='app1')" {
+		return createResponse(req, map[string]interface{}{
+			"id":          "application1",
+			"appId":       "app1",
+			"displayName": "app1",
+			"passwordCredentials": []map[string]interface{}{
+				{
+					"keyId":         "secret1",
+					"displayName":   "client-secret",
+					"startDateTime": "2024-01-01T00:00:00Z",
+					"endDateTime":   "2025-01-01T00:00:00Z",
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ContainerService/managedClusters" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.ContainerService/managedClusters/aks1",
+					"name":     "aks1",
+					"location": "eastus",
+					"tags": map[string]interface{}{
+						"env": "prod",
+					},
+					"systemData": map[string]interface{}{
+						"createdAt": "2017-05-24T13:28:53.4540398Z",
+					},
+					"properties": map[string]interface{}{
+						"kubernetesVersion":        "1.30",
+						"currentKubernetesVersion": "1.30.4",
+						"enableRBAC":               true,
+						"aadProfile": map[string]interface{}{
+							"managed":         true,
+							"enableAzureRBAC": true,
+						},
+						"apiServerAccessProfile": map[string]interface{}{
+							"enablePrivateCluster": true,
+						},
+						"networkProfile": map[string]interface{}{
+							"networkPlugin": "azure",
+							"networkPolicy": "calico",
+						},
+						"privateFQDN": "aks1-abcdef.privatelink.eastus.azmk8s.io",
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.ContainerRegistry/registries" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.ContainerRegistry/registries/acr1",
+					"name":     "acr1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"adminUserEnabled":    true,
+						"publicNetworkAccess": "Enabled",
+						"zoneRedundancy":      "Enabled",
+						"creationDate":        "2017-05-24T13:28:53.4540398Z",
+						"loginServer":         "acr1.azurecr.io",
+						"policies": map[string]interface{}{
+							"trustPolicy": map[string]interface{}{
+								"type":   "Notary",
+								"status": "enabled",
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/providers/Microsoft.Management/managementGroups/mg1/descendants" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/providers/Microsoft.Management/managementGroups/mg2",
+					"name": "mg2",
+					"type": "Microsoft.Management/managementGroups",
+				},
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000",
+					"name": "00000000-0000-0000-0000-000000000000",
+					"type": "Microsoft.Management/managementGroups/subscriptions",
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/ddosProtectionPlans" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/ddosProtectionPlans/ddos1",
+					"name":     "ddos1",
+					"location": "eastus",
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/virtualNetworks" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1",
+					"name":     "vnet1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"addressSpace": map[string]interface{}{
+							"addressPrefixes": []string{"10.0.0.0/16"},
+						},
+						"enableDdosProtection": true,
+						"ddosProtectionPlan": map[string]interface{}{
+							"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/ddosProtectionPlans/ddos1",
+						},
+						"subnets": []map[string]interface{}{
+							{
+								"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1",
+								"name": "subnet1",
+								"properties": map[string]interface{}{
+									"addressPrefix": "10.0.0.0/24",
+									"networkSecurityGroup": map[string]interface{}{
+										"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+									},
+								},
+							},
+							{
+								"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet2",
+								"name": "subnet2",
+								"properties": map[string]interface{}{
+									"addressPrefix": "10.0.1.0/24",
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/networkSecurityGroups" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+					"name":     "nsg1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"securityRules": []map[string]interface{}{
+							{
+								"name": "AllowHTTPS",
+								"properties": map[string]interface{}{
+									"access":                   "Allow",
+									"direction":                "Inbound",
+									"priority":                 200,
+									"protocol":                 "Tcp",
+									"sourceAddressPrefix":      "Internet",
+									"destinationAddressPrefix": "*",
+									"destinationPortRange":     "443",
+								},
+							},
+							{
+								"name": "DenySSH",
+								"properties": map[string]interface{}{
+									"access":                   "Deny",
+									"direction":                "Inbound",
+									"priority":                 100,
+									"protocol":                 "Tcp",
+									"sourceAddressPrefix":      "*",
+									"destinationAddressPrefix": "*",
+									"destinationPortRange":     "22",
+								},
+							},
+						},
+						"defaultSecurityRules": []map[string]interface{}{
+							{
+								"name": "DenyAllInBound",
+								"properties": map[string]interface{}{
+									"access":                   "Deny",
+									"direction":                "Inbound",
+									"priority":                 65500,
+									"protocol":                 "*",
+									"sourceAddressPrefix":      "*",
+									"destinationAddressPrefix": "*",
+									"destinationPortRange":     "*",
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/azureFirewalls" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/azureFirewalls/fw1",
+					"name":     "fw1",
+					"location": "eastus",
+					"properties": map[string]interface{}{
+						"threatIntelMode": "Deny",
+						"ipConfigurations": []map[string]interface{}{
+							{
+								"name": "ipconfig1",
+								"properties": map[string]interface{}{
+									"privateIPAddress": "10.0.2.4",
+									"publicIPAddress": map[string]interface{}{
+										"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/publicIPAddresses/fw1-pip",
+									},
+									"subnet": map[string]interface{}{
+										"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/AzureFirewallSubnet",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, 200)
+	} else if req.URL.Path == "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.keyvault/vaults/keyvault1/providers/Microsoft.Insights/diagnosticSettings" {
+		return createResponse(req, map[string]interface{}{
+			"value": &[]map[string]interface{}{
+				{
+					"id":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/providers/microsoft.insights/diagnosticSettings/audit",
+					"name": "audit",
+					"properties": map[string]interface{}{
+						"logs": &[]map[string]interface{}{
+							{
+								"categoryGroup": "audit",
+								"enabled":       true,
+								"retentionPolicy": map[string]interface{}{
+									"enabled": true,
+									"days":    365,
+								},
+							},
+							{
+								"categoryGroup": "allLogs",
+								"enabled":       true,
+								"retentionPolicy": map[string]interface{}{
+									"enabled": true,
+									"days":    90,
+								},
+							},
+						},
+						"storageAccountId":            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Storage/storageAccounts/logs",
+						"eventHubAuthorizationRuleId": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.EventHub/namespaces/ns1/authorizationrules/RootManageSharedAccessKey",
+						"eventHubName":                "logs",
+					},
+				},
+			},
+		}, 200)
 	} else {
 		res, err = createResponse(req, map[string]interface{}{}, 404)
 		log.Errorf("Not handling mock for %s yet", req.URL.Path)
@@ -111,7 +111,8 @@ func (d *azureDiscovery) keyURL(diskEncryptionSetID string) (string, *armcompute
 		return "", nil, fmt.Errorf("could not get keyURL")
 	}
 
-	return util.Deref(keyURL), &kv.DiskEncryptionSet, nil
+	// The key URL matches the LabelKeyURI label of the discovered key
+	return keyURI(util.Deref(keyURL)), &kv.DiskEncryptionSet, nil
 }
 
 // diskEncryptionSetName return the disk encryption set ID's name
@@ -886,7 +886,7 @@ func Test_azureComputeDiscovery_discoverBlockStorage(t *testing.T) {
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 								Algorithm: "",
 								Enabled:   true,
-								KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+								KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key",
 							},
 						},
 					},
@@ -1046,7 +1046,7 @@ func Test_azureComputeDiscovery_handleBlockStorage(t *testing.T) {
 						CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 							Algorithm: "",
 							Enabled:   true,
-							KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+							KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key",
 						},
 					},
 				},
@@ -1149,7 +1149,7 @@ func Test_azureComputeDiscovery_blockStorageAtRestEncryption(t *testing.T) {
 					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 						Algorithm: "",
 						Enabled:   true,
-						KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+						KeyUrl:    "https://keyvault1.vault.azure.net/keys/customer-key",
 					},
 				},
 			},
@@ -1226,7 +1226,7 @@ func Test_azureComputeDiscovery_keyURL(t *testing.T) {
 			fields: fields{
 				azureDiscovery: NewMockAzureDiscovery(newMockSender()),
 			},
-			want:    "https://keyvault1.vault.azure.net/keys/customer-key/6273gdb374jz789hjm17819283748382",
+			want:    "https://keyvault1.vault.azure.net/keys/customer-key",
 			wantErr: assert.NoError,
 		},
 	}
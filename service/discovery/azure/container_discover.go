@@ -0,0 +1,103 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
+)
+
+// discoverManagedClusters discovers Azure Kubernetes Service (AKS) clusters
+func (d *azureDiscovery) discoverManagedClusters() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize managed clusters client
+	if err := d.initManagedClustersClient(); err != nil {
+		return nil, err
+	}
+
+	// List all managed clusters
+	err := listPager(d,
+		d.clients.managedClustersClient.NewListPager,
+		d.clients.managedClustersClient.NewListByResourceGroupPager,
+		func(res armcontainerservice.ManagedClustersClientListResponse) []*armcontainerservice.ManagedCluster {
+			return res.Value
+		},
+		func(res armcontainerservice.ManagedClustersClientListByResourceGroupResponse) []*armcontainerservice.ManagedCluster {
+			return res.Value
+		},
+		func(cluster *armcontainerservice.ManagedCluster) error {
+			r := d.handleManagedCluster(cluster)
+
+			log.Infof("Adding managed cluster '%s'", r.GetName())
+
+			list = append(list, r)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverContainerRegistries discovers Azure Container Registries (ACR)
+func (d *azureDiscovery) discoverContainerRegistries() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize registries client
+	if err := d.initRegistriesClient(); err != nil {
+		return nil, err
+	}
+
+	// List all container registries
+	err := listPager(d,
+		d.clients.registriesClient.NewListPager,
+		d.clients.registriesClient.NewListByResourceGroupPager,
+		func(res armcontainerregistry.RegistriesClientListResponse) []*armcontainerregistry.Registry {
+			return res.Value
+		},
+		func(res armcontainerregistry.RegistriesClientListByResourceGroupResponse) []*armcontainerregistry.Registry {
+			return res.Value
+		},
+		func(registry *armcontainerregistry.Registry) error {
+			r := d.handleContainerRegistry(registry)
+
+			log.Infof("Adding container registry '%s'", r.GetName())
+
+			list = append(list, r)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
+)
+
+// handleManagedCluster returns a [ontology.ContainerOrchestration] for the given AKS cluster. The ontology has no
+// dedicated properties for the RBAC and Entra ID integration, the network policy or the Kubernetes version; they are
+// added as labels.
+func (d *azureDiscovery) handleManagedCluster(cluster *armcontainerservice.ManagedCluster) *ontology.ContainerOrchestration {
+	var (
+		props   = util.Deref(cluster.Properties)
+		created *time.Time
+	)
+
+	if cluster.SystemData != nil {
+		created = cluster.SystemData.CreatedAt
+	}
+
+	return &ontology.ContainerOrchestration{
+		Id:                         resourceID(cluster.ID),
+		Name:                       util.Deref(cluster.Name),
+		CreationTime:               creationTime(created),
+		GeoLocation:                location(cluster.Location),
+		Labels:                     managedClusterLabels(labels(cluster.Tags), util.Deref(cluster.Name), &props),
+		ParentId:                   resourceGroupID(cluster.ID),
+		Raw:                        discovery.Raw(cluster),
+		InternetAccessibleEndpoint: managedClusterInternetAccessible(&props),
+		ManagementUrl:              managedClusterURL(&props),
+	}
+}
+
+// handleContainerRegistry returns a [ontology.ContainerRegistry] for the given registry. The admin user and content
+// trust settings are added as labels.
+func (d *azureDiscovery) handleContainerRegistry(registry *armcontainerregistry.Registry) *ontology.ContainerRegistry {
+	var (
+		props   = util.Deref(registry.Properties)
+		created = props.CreationDate
+	)
+
+	if created == nil && registry.SystemData != nil {
+		created = registry.SystemData.CreatedAt
+	}
+
+	return &ontology.ContainerRegistry{
+		Id:                         resourceID(registry.ID),
+		Name:                       util.Deref(registry.Name),
+		CreationTime:               creationTime(created),
+		GeoLocation:                location(registry.Location),
+		Labels:                     containerRegistryLabels(labels(registry.Tags), &props),
+		ParentId:                   resourceGroupID(registry.ID),
+		Raw:                        discovery.Raw(registry),
+		InternetAccessibleEndpoint: containerRegistryInternetAccessible(&props),
+		Redundancies:               containerRegistryRedundancies(&props),
+	}
+}
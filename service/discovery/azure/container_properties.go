@@ -0,0 +1,165 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"strconv"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
+)
+
+const (
+	// LabelKubernetesCluster is the label that contains the name of an AKS cluster. It matches the label the
+	// Kubernetes discoverer adds to the resources of a cluster, since the kubeconfig context created by
+	// 'az aks get-credentials' is named after the cluster.
+	LabelKubernetesCluster = "k8s:cluster"
+
+	// LabelKubernetesVersion is the label that contains the Kubernetes version of an AKS cluster.
+	LabelKubernetesVersion = "azure:kubernetes-version"
+
+	// LabelKubernetesRBACEnabled is the label that specifies whether Kubernetes RBAC is enabled for an AKS cluster.
+	LabelKubernetesRBACEnabled = "azure:kubernetes-rbac-enabled"
+
+	// LabelEntraIDEnabled is the label that specifies whether an AKS cluster authenticates users with Entra ID.
+	LabelEntraIDEnabled = "azure:entra-id-enabled"
+
+	// LabelAzureRBACEnabled is the label that specifies whether an AKS cluster authorizes Entra ID users with Azure
+	// RBAC instead of Kubernetes RBAC.
+	LabelAzureRBACEnabled = "azure:azure-rbac-enabled"
+
+	// LabelPrivateCluster is the label that specifies whether the API server of an AKS cluster is only reachable
+	// from its virtual network.
+	LabelPrivateCluster = "azure:private-cluster"
+
+	// LabelNetworkPolicy is the label that contains the network policy engine of an AKS cluster, e.g., "calico" or
+	// "none".
+	LabelNetworkPolicy = "azure:network-policy"
+
+	// LabelAdminUserEnabled is the label that specifies whether the admin user of a container registry is enabled.
+	LabelAdminUserEnabled = "azure:admin-user-enabled"
+
+	// LabelContentTrustEnabled is the label that specifies whether a container registry enforces content trust.
+	LabelContentTrustEnabled = "azure:content-trust-enabled"
+)
+
+// managedClusterLabels adds the security relevant settings of an AKS cluster to the labels, i.e., the Kubernetes
+// version, the RBAC and Entra ID integration, whether it is a private cluster and the network policy. The name of the
+// cluster is added as [LabelKubernetesCluster], so that it can be linked to the resources discovered in it.
+func managedClusterLabels(l map[string]string, name string, props *armcontainerservice.ManagedClusterProperties) map[string]string {
+	var policy = armcontainerservice.NetworkPolicyNone
+
+	l[LabelKubernetesCluster] = name
+
+	version := util.Deref(props.CurrentKubernetesVersion)
+	if version == "" {
+		version = util.Deref(props.KubernetesVersion)
+	}
+	if version != "" {
+		l[LabelKubernetesVersion] = version
+	}
+
+	l[LabelKubernetesRBACEnabled] = strconv.FormatBool(util.Deref(props.EnableRBAC))
+	l[LabelEntraIDEnabled] = strconv.FormatBool(props.AADProfile != nil)
+	l[LabelAzureRBACEnabled] = strconv.FormatBool(props.AADProfile != nil && util.Deref(props.AADProfile.EnableAzureRBAC))
+	l[LabelPrivateCluster] = strconv.FormatBool(props.APIServerAccessProfile != nil &&
+		util.Deref(props.APIServerAccessProfile.EnablePrivateCluster))
+
+	if props.NetworkProfile != nil && props.NetworkProfile.NetworkPolicy != nil {
+		policy = *props.NetworkProfile.NetworkPolicy
+	}
+	l[LabelNetworkPolicy] = string(policy)
+
+	return l
+}
+
+// managedClusterInternetAccessible returns true, if the API server of the AKS cluster can be reached from the
+// internet. This is the case unless it is a private cluster without a public FQDN or public network access is
+// disabled.
+func managedClusterInternetAccessible(props *armcontainerservice.ManagedClusterProperties) bool {
+	if util.Deref(props.PublicNetworkAccess) == armcontainerservice.PublicNetworkAccessDisabled {
+		return false
+	}
+
+	if p := props.APIServerAccessProfile; p != nil && util.Deref(p.EnablePrivateCluster) {
+		return util.Deref(p.EnablePrivateClusterPublicFQDN)
+	}
+
+	return true
+}
+
+// managedClusterURL returns the URL of the API server of the AKS cluster
+func managedClusterURL(props *armcontainerservice.ManagedClusterProperties) string {
+	fqdn := util.Deref(props.Fqdn)
+	if fqdn == "" {
+		fqdn = util.Deref(props.PrivateFQDN)
+	}
+
+	if fqdn == "" {
+		return ""
+	}
+
+	return "https://" + fqdn
+}
+
+// containerRegistryLabels adds the security relevant settings of a container registry to the labels, i.e., whether
+// the admin user and content trust are enabled
+func containerRegistryLabels(l map[string]string, props *armcontainerregistry.RegistryProperties) map[string]string {
+	l[LabelAdminUserEnabled] = strconv.FormatBool(util.Deref(props.AdminUserEnabled))
+	l[LabelContentTrustEnabled] = strconv.FormatBool(props.Policies != nil && props.Policies.TrustPolicy != nil &&
+		util.Deref(props.Policies.TrustPolicy.Status) == armcontainerregistry.PolicyStatusEnabled)
+
+	return l
+}
+
+// containerRegistryInternetAccessible returns true, if public network access of the registry is enabled and not
+// restricted by network rules
+func containerRegistryInternetAccessible(props *armcontainerregistry.RegistryProperties) bool {
+	// Public network access is enabled by default
+	if props.PublicNetworkAccess != nil && *props.PublicNetworkAccess != armcontainerregistry.PublicNetworkAccessEnabled {
+		return false
+	}
+
+	if props.NetworkRuleSet != nil && util.Deref(props.NetworkRuleSet.DefaultAction) == armcontainerregistry.DefaultActionDeny {
+		return false
+	}
+
+	return true
+}
+
+// containerRegistryRedundancies returns the redundancy of the registry
+func containerRegistryRedundancies(props *armcontainerregistry.RegistryProperties) []*ontology.Redundancy {
+	if util.Deref(props.ZoneRedundancy) == armcontainerregistry.ZoneRedundancyEnabled {
+		return []*ontology.Redundancy{
+			{Type: &ontology.Redundancy_ZoneRedundancy{}},
+		}
+	}
+
+	return nil
+}
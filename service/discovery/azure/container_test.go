@@ -0,0 +1,179 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerregistry/armcontainerregistry"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v7"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_azureDiscovery_discoverManagedClusters(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverManagedClusters()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	aks := assert.Is[*ontology.ContainerOrchestration](t, got[0])
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.containerservice/managedclusters/aks1", aks.Id)
+	assert.Equal(t, "aks1", aks.Name)
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1", util.Deref(aks.ParentId))
+	assert.Equal(t, "https://aks1-abcdef.privatelink.eastus.azmk8s.io", aks.ManagementUrl)
+	assert.Equal(t, map[string]string{
+		"env":                      "prod",
+		LabelKubernetesCluster:     "aks1",
+		LabelKubernetesVersion:     "1.30.4",
+		LabelKubernetesRBACEnabled: "true",
+		LabelEntraIDEnabled:        "true",
+		LabelAzureRBACEnabled:      "true",
+		LabelPrivateCluster:        "true",
+		LabelNetworkPolicy:         "calico",
+	}, aks.Labels)
+	assert.Equal(t, "eastus", aks.GeoLocation.Region)
+	assert.Equal(t, timestamppb.New(time.Date(2017, 5, 24, 13, 28, 53, 454039800, time.UTC)), aks.CreationTime)
+	assert.False(t, aks.InternetAccessibleEndpoint)
+}
+
+func Test_azureDiscovery_discoverContainerRegistries(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverContainerRegistries()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	acr := assert.Is[*ontology.ContainerRegistry](t, got[0])
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.containerregistry/registries/acr1", acr.Id)
+	assert.Equal(t, "acr1", acr.Name)
+	assert.Equal(t, "true", acr.Labels[LabelAdminUserEnabled])
+	assert.Equal(t, "true", acr.Labels[LabelContentTrustEnabled])
+	assert.True(t, acr.InternetAccessibleEndpoint)
+	assert.Equal(t, 1, len(acr.Redundancies))
+	assert.Contains(t, acr.Raw, `"adminUserEnabled":true`)
+}
+
+func Test_managedClusterInternetAccessible(t *testing.T) {
+	tests := []struct {
+		name  string
+		props *armcontainerservice.ManagedClusterProperties
+		want  bool
+	}{
+		{
+			name:  "public cluster",
+			props: &armcontainerservice.ManagedClusterProperties{},
+			want:  true,
+		},
+		{
+			name: "private cluster",
+			props: &armcontainerservice.ManagedClusterProperties{
+				APIServerAccessProfile: &armcontainerservice.ManagedClusterAPIServerAccessProfile{
+					EnablePrivateCluster: util.Ref(true),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "private cluster with public FQDN",
+			props: &armcontainerservice.ManagedClusterProperties{
+				APIServerAccessProfile: &armcontainerservice.ManagedClusterAPIServerAccessProfile{
+					EnablePrivateCluster:           util.Ref(true),
+					EnablePrivateClusterPublicFQDN: util.Ref(true),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "public network access disabled",
+			props: &armcontainerservice.ManagedClusterProperties{
+				PublicNetworkAccess: util.Ref(armcontainerservice.PublicNetworkAccessDisabled),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, managedClusterInternetAccessible(tt.props))
+		})
+	}
+}
+
+func Test_containerRegistryInternetAccessible(t *testing.T) {
+	tests := []struct {
+		name  string
+		props *armcontainerregistry.RegistryProperties
+		want  bool
+	}{
+		{
+			name:  "default",
+			props: &armcontainerregistry.RegistryProperties{},
+			want:  true,
+		},
+		{
+			name: "public network access disabled",
+			props: &armcontainerregistry.RegistryProperties{
+				PublicNetworkAccess: util.Ref(armcontainerregistry.PublicNetworkAccessDisabled),
+			},
+			want: false,
+		},
+		{
+			name: "network rules deny by default",
+			props: &armcontainerregistry.RegistryProperties{
+				NetworkRuleSet: &armcontainerregistry.NetworkRuleSet{
+					DefaultAction: util.Ref(armcontainerregistry.DefaultActionDeny),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, containerRegistryInternetAccessible(tt.props))
+		})
+	}
+}
+
+func Test_managedClusterLabels(t *testing.T) {
+	got := managedClusterLabels(map[string]string{}, "aks2", &armcontainerservice.ManagedClusterProperties{
+		KubernetesVersion: util.Ref("1.29.0"),
+	})
+
+	assert.Equal(t, map[string]string{
+		LabelKubernetesCluster:     "aks2",
+		LabelKubernetesVersion:     "1.29.0",
+		LabelKubernetesRBACEnabled: "false",
+		LabelEntraIDEnabled:        "false",
+		LabelAzureRBACEnabled:      "false",
+		LabelPrivateCluster:        "false",
+		LabelNetworkPolicy:         "none",
+	}, got)
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	// GraphService is the name of the Microsoft Graph API in a [cloud.Configuration]. The Azure SDK does not define
+	// it, but custom cloud configurations can set its endpoint this way.
+	GraphService cloud.ServiceName = "microsoftGraph"
+
+	// GraphVersion is the version of the Microsoft Graph API that is used
+	GraphVersion = "v1.0"
+
+	// GraphMaxIDsPerRequest is the maximum number of IDs that can be resolved with a single getByIds request
+	GraphMaxIDsPerRequest = 1000
+)
+
+// graphEndpoints contains the endpoints of the Microsoft Graph API of the national clouds, indexed by their Entra ID
+// authority host
+var graphEndpoints = map[string]string{
+	cloud.AzurePublic.ActiveDirectoryAuthorityHost:     "https://graph.microsoft.com",
+	cloud.AzureGovernment.ActiveDirectoryAuthorityHost: "https://graph.microsoft.us",
+	cloud.AzureChina.ActiveDirectoryAuthorityHost:      "https://microsoftgraph.chinacloudapi.cn",
+}
+
+// graphClient is a minimal client for the parts of the Microsoft Graph API that we need for the discovery of Entra ID
+// objects. It uses the same credential and transport as the Azure Resource Manager clients.
+type graphClient struct {
+	endpoint string
+	pipeline runtime.Pipeline
+}
+
+// graphDirectoryObject contains the properties of users, groups and service principals that we are interested in
+type graphDirectoryObject struct {
+	ODataType              string                    `json:"@odata.type"`
+	ID                     string                    `json:"id"`
+	DisplayName            string                    `json:"displayName"`
+	UserPrincipalName      string                    `json:"userPrincipalName,omitempty"`
+	UserType               string                    `json:"userType,omitempty"`
+	AccountEnabled         *bool                     `json:"accountEnabled,omitempty"`
+	CreatedDateTime        *time.Time                `json:"createdDateTime,omitempty"`
+	AppID                  string                    `json:"appId,omitempty"`
+	AppOwnerOrganizationID string                    `json:"appOwnerOrganizationId,omitempty"`
+	ServicePrincipalType   string                    `json:"servicePrincipalType,omitempty"`
+	PasswordCredentials    []graphPasswordCredential `json:"passwordCredentials,omitempty"`
+}
+
+// graphPasswordCredential is a client secret of an application or service principal. We deliberately do not include
+// the hint, since it contains the first characters of the secret.
+type graphPasswordCredential struct {
+	KeyID         string     `json:"keyId"`
+	DisplayName   string     `json:"displayName,omitempty"`
+	StartDateTime *time.Time `json:"startDateTime,omitempty"`
+	EndDateTime   *time.Time `json:"endDateTime,omitempty"`
+}
+
+// graphUserRegistrationDetails contains the authentication methods a user has registered
+type graphUserRegistrationDetails struct {
+	ID                string   `json:"id"`
+	IsMfaRegistered   bool     `json:"isMfaRegistered"`
+	IsAdmin           bool     `json:"isAdmin"`
+	MethodsRegistered []string `json:"methodsRegistered,omitempty"`
+}
+
+// newGraphClient creates a new [graphClient]. It has the signature of a [ClientCreateFuncWithoutSubID], so that it
+// can be initialized like the Azure SDK clients. The endpoint is taken from the cloud configuration of the options.
+func newGraphClient(credential azcore.TokenCredential, options *arm.ClientOptions) (*graphClient, error) {
+	var opts policy.ClientOptions
+
+	if options != nil {
+		opts = options.ClientOptions
+	}
+
+	endpoint := graphEndpoint(opts.Cloud)
+
+	return &graphClient{
+		endpoint: endpoint + "/" + GraphVersion,
+		pipeline: runtime.NewPipeline("clouditor-graph", GraphVersion, runtime.PipelineOptions{
+			PerRetry: []policy.Policy{runtime.NewBearerTokenPolicy(credential, []string{endpoint + "/.default"}, nil)},
+		}, &opts),
+	}, nil
+}
+
+// graphEndpoint returns the endpoint of the Microsoft Graph API of the cloud. It is either configured explicitly as
+// [GraphService] or derived from the Entra ID authority host of the cloud. If neither is known, the endpoint of the
+// Azure public cloud is used.
+func graphEndpoint(c cloud.Configuration) string {
+	if svc, ok := c.Services[GraphService]; ok && svc.Endpoint != "" {
+		return strings.TrimSuffix(svc.Endpoint, "/")
+	}
+
+	if endpoint, ok := graphEndpoints[c.ActiveDirectoryAuthorityHost]; ok {
+		return endpoint
+	}
+
+	return graphEndpoints[cloud.AzurePublic.ActiveDirectoryAuthorityHost]
+}
+
+// getByIDs resolves the given object IDs to users, groups and service principals
+func (c *graphClient) getByIDs(ctx context.Context, ids []string) (objects []*graphDirectoryObject, err error) {
+	for len(ids) > 0 {
+		var (
+			batch = ids[:min(len(ids), GraphMaxIDsPerRequest)]
+			res   struct {
+				Value []*graphDirectoryObject `json:"value"`
+			}
+		)
+
+		err = c.do(ctx, http.MethodPost, "/directoryObjects/getByIds", map[string]any{
+			"ids":   batch,
+			"types": []string{"user", "group", "servicePrincipal"},
+		}, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, res.Value...)
+		ids = ids[len(batch):]
+	}
+
+	return
+}
+
+// applicationByAppID returns the application registration with the given app ID
+func (c *graphClient) applicationByAppID(ctx context.Context, appID string) (app *graphDirectoryObject, err error) {
+	app = new(graphDirectoryObject)
+
+	err = c.do(ctx, http.MethodGet, "/applications(appId='"+appID+"')?$select=id,appId,displayName,passwordCredentials", nil, app)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// userRegistrationDetails returns the authentication methods registered by all users of the tenant, indexed by the
+// user ID. The report is retrieved page by page, instead of requesting the details of each user individually.
+func (c *graphClient) userRegistrationDetails(ctx context.Context) (details map[string]*graphUserRegistrationDetails, err error) {
+	details = make(map[string]*graphUserRegistrationDetails)
+
+	path := "/reports/authenticationMethods/userRegistrationDetails?$select=id,isMfaRegistered,isAdmin,methodsRegistered"
+	for path != "" {
+		var res struct {
+			Value    []*graphUserRegistrationDetails `json:"value"`
+			NextLink string                          `json:"@odata.nextLink"`
+		}
+
+		err = c.do(ctx, http.MethodGet, path, nil, &res)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range res.Value {
+			details[d.ID] = d
+		}
+
+		path = res.NextLink
+	}
+
+	return
+}
+
+// do sends a request to the Microsoft Graph API and unmarshals the JSON response into v. The path is either relative to
+// the endpoint or an absolute URL, such as the next link of a paged response.
+func (c *graphClient) do(ctx context.Context, method string, path string, body any, v any) error {
+	if !strings.HasPrefix(path, "https://") {
+		path = c.endpoint + path
+	}
+
+	req, err := runtime.NewRequest(ctx, method, path)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		if err = runtime.MarshalAsJSON(req, body); err != nil {
+			return err
+		}
+	}
+
+	res, err := c.pipeline.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if !runtime.HasStatusCode(res, http.StatusOK) {
+		return runtime.NewResponseError(res)
+	}
+
+	return runtime.UnmarshalAsJSON(res, v)
+}
+
+// isGuest returns true, if the directory object is a guest user
+func (o *graphDirectoryObject) isGuest() bool {
+	return strings.EqualFold(o.UserType, "Guest") || strings.Contains(o.UserPrincipalName, "#EXT#")
+}
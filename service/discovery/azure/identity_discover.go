@@ -0,0 +1,202 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+)
+
+// discoverRoleAssignments discovers the role assignments of the subscription (or resource group) and the Entra ID
+// identities they are assigned to
+func (d *azureDiscovery) discoverRoleAssignments() ([]ontology.IsResource, error) {
+	var (
+		list        []ontology.IsResource
+		assignments []*armauthorization.RoleAssignment
+	)
+
+	// initialize role assignments client
+	if err := d.initRoleAssignmentsClient(); err != nil {
+		return nil, err
+	}
+
+	definitions, err := d.discoverRoleDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	// List all role assignments
+	err = listPager(d,
+		d.clients.roleAssignmentsClient.NewListForSubscriptionPager,
+		d.clients.roleAssignmentsClient.NewListForResourceGroupPager,
+		func(res armauthorization.RoleAssignmentsClientListForSubscriptionResponse) []*armauthorization.RoleAssignment {
+			return res.Value
+		},
+		func(res armauthorization.RoleAssignmentsClientListForResourceGroupResponse) []*armauthorization.RoleAssignment {
+			return res.Value
+		},
+		func(ra *armauthorization.RoleAssignment) error {
+			assignments = append(assignments, ra)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if d.tenant != nil {
+		d.tenant.assignments = assignments
+		d.tenant.definitions = definitions
+
+		// Role assignments inherited from management groups are added by the discoverer of the tenant
+		assignments = slices.DeleteFunc(slices.Clone(assignments), isInheritedRoleAssignment)
+	} else {
+		// Add the identities first, so that they exist before the role assignments that refer to them
+		list = append(list, d.discoverIdentities(assignments, definitions)...)
+	}
+
+	for _, ra := range assignments {
+		r := d.handleRoleAssignment(ra, definitions[roleDefinitionName(ra)])
+
+		log.Infof("Adding role assignment '%s'", r.GetName())
+
+		list = append(list, r)
+	}
+
+	return list, nil
+}
+
+// discoverRoleDefinitions returns all role definitions that can be assigned in the subscription, indexed by their name
+// (which is a GUID)
+func (d *azureDiscovery) discoverRoleDefinitions() (map[string]*armauthorization.RoleDefinition, error) {
+	var definitions = make(map[string]*armauthorization.RoleDefinition)
+
+	// initialize role definitions client
+	if err := d.initRoleDefinitionsClient(); err != nil {
+		return nil, err
+	}
+
+	var scope string
+	if d.sub != nil {
+		scope = util.Deref(d.sub.ID)
+	}
+
+	pager := d.clients.roleDefinitionsClient.NewListPager(scope, &armauthorization.RoleDefinitionsClientListOptions{})
+	err := allPages(pager, func(page armauthorization.RoleDefinitionsClientListResponse) error {
+		for _, def := range page.Value {
+			definitions[strings.ToLower(util.Deref(def.Name))] = def
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+// discoverIdentities retrieves the users, groups and service principals that the role assignments refer to from Entra
+// ID. Access to Entra ID requires additional Microsoft Graph permissions (Directory.Read.All and, for the MFA
+// registration, AuditLog.Read.All). If these are missing, no identities are returned instead of aborting the discovery.
+func (d *azureDiscovery) discoverIdentities(assignments []*armauthorization.RoleAssignment, definitions map[string]*armauthorization.RoleDefinition) []ontology.IsResource {
+	var (
+		list       []ontology.IsResource
+		ids        []string
+		privileged = make(map[string]bool)
+	)
+
+	for _, ra := range assignments {
+		if ra.Properties == nil || ra.Properties.PrincipalID == nil {
+			continue
+		}
+
+		id := util.Deref(ra.Properties.PrincipalID)
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+
+		if isPrivilegedRole(definitions[roleDefinitionName(ra)]) {
+			privileged[id] = true
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// initialize graph client
+	if err := d.initGraphClient(); err != nil {
+		return nil
+	}
+
+	objects, err := d.clients.graphClient.getByIDs(context.TODO(), ids)
+	if err != nil {
+		log.Warnf("Could not retrieve identities from Entra ID, only role assignments are discovered: %v", err)
+		return nil
+	}
+
+	// The MFA registration details are only available with an Entra ID P1 or P2 license, so they are optional
+	var details map[string]*graphUserRegistrationDetails
+	if slices.ContainsFunc(objects, func(o *graphDirectoryObject) bool { return o.ODataType == "#microsoft.graph.user" }) {
+		details, err = d.clients.graphClient.userRegistrationDetails(context.TODO())
+		if err != nil {
+			log.Warnf("Could not retrieve MFA registration of users: %v", err)
+		}
+	}
+
+	for _, o := range objects {
+		switch o.ODataType {
+		case "#microsoft.graph.user":
+			list = append(list, d.handleUser(o, details[o.ID], privileged[o.ID]))
+		case "#microsoft.graph.servicePrincipal":
+			var app *graphDirectoryObject
+
+			// Client secrets are usually added to the application registration rather than the service
+			// principal. Only applications registered in our own tenant can be retrieved.
+			if o.AppID != "" && strings.EqualFold(o.ServicePrincipalType, "Application") {
+				app, err = d.clients.graphClient.applicationByAppID(context.TODO(), o.AppID)
+				if err != nil {
+					log.Debugf("Could not retrieve application of service principal '%s': %v", o.DisplayName, err)
+				}
+			}
+
+			list = append(list, d.handleServicePrincipal(o, app, privileged[o.ID])...)
+		case "#microsoft.graph.group":
+			list = append(list, d.handleGroup(o, privileged[o.ID]))
+		default:
+			log.Debugf("Ignoring directory object '%s' of type %s", o.ID, o.ODataType)
+		}
+	}
+
+	return list
+}
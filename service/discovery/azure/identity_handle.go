@@ -0,0 +1,144 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+)
+
+// handleRoleAssignment returns a [ontology.RoleAssignment] for the given role assignment. Its parent is the identity
+// the role is assigned to.
+func (d *azureDiscovery) handleRoleAssignment(ra *armauthorization.RoleAssignment, def *armauthorization.RoleDefinition) *ontology.RoleAssignment {
+	var (
+		props = util.Deref(ra.Properties)
+		name  = util.Deref(ra.Name)
+	)
+
+	if def != nil && def.Properties != nil && def.Properties.RoleName != nil {
+		name = util.Deref(def.Properties.RoleName)
+	}
+
+	return &ontology.RoleAssignment{
+		Id:           resourceID(ra.ID),
+		Name:         name,
+		Description:  fmt.Sprintf("Role %s assigned to %s %s at scope %s", name, util.Deref(props.PrincipalType), util.Deref(props.PrincipalID), util.Deref(props.Scope)),
+		CreationTime: creationTime(props.CreatedOn),
+		Activated:    true,
+		ParentId:     util.Ref(identityID(props.PrincipalType, util.Deref(props.PrincipalID))),
+		Raw:          discovery.Raw(ra, def),
+	}
+}
+
+// handleUser returns a [ontology.Identity] for the given Entra ID user. The user enforces MFA, if an MFA method is
+// registered.
+func (d *azureDiscovery) handleUser(user *graphDirectoryObject, details *graphUserRegistrationDetails, privileged bool) *ontology.Identity {
+	var (
+		name        = user.UserPrincipalName
+		description = "User"
+	)
+
+	if name == "" {
+		name = user.DisplayName
+	}
+
+	if user.isGuest() {
+		description = "Guest user"
+	}
+
+	return &ontology.Identity{
+		Id:           identityID(util.Ref(armauthorization.PrincipalTypeUser), user.ID),
+		Name:         name,
+		Description:  description,
+		CreationTime: creationTime(user.CreatedDateTime),
+		Activated:    util.Deref(user.AccountEnabled),
+		EnforceMfa:   details != nil && details.IsMfaRegistered,
+		Privileged:   privileged,
+		Raw:          discovery.Raw(user, details),
+	}
+}
+
+// handleServicePrincipal returns a [ontology.Identity] for the given service principal and a [ontology.Secret] for
+// each of the client secrets of the service principal and its application.
+func (d *azureDiscovery) handleServicePrincipal(sp *graphDirectoryObject, app *graphDirectoryObject, privileged bool) (list []ontology.IsResource) {
+	var (
+		id          = identityID(util.Ref(armauthorization.PrincipalTypeServicePrincipal), sp.ID)
+		credentials = sp.PasswordCredentials
+	)
+
+	if app != nil {
+		credentials = append(credentials, app.PasswordCredentials...)
+	}
+
+	list = append(list, &ontology.Identity{
+		Id:           id,
+		Name:         sp.DisplayName,
+		Description:  "Service principal",
+		CreationTime: creationTime(sp.CreatedDateTime),
+		Activated:    sp.AccountEnabled == nil || *sp.AccountEnabled,
+		Privileged:   privileged,
+		Raw:          discovery.Raw(sp, app),
+	})
+
+	for _, c := range credentials {
+		name := c.DisplayName
+		if name == "" {
+			name = c.KeyID
+		}
+
+		list = append(list, &ontology.Secret{
+			Id:             id + "/password-credentials/" + c.KeyID,
+			Name:           name,
+			CreationTime:   creationTime(c.StartDateTime),
+			Enabled:        c.EndDateTime == nil || c.EndDateTime.After(time.Now()),
+			ExpirationDate: creationTime(c.EndDateTime),
+			NotBeforeDate:  creationTime(c.StartDateTime),
+			ParentId:       util.Ref(id),
+			Raw:            discovery.Raw(&c),
+		})
+	}
+
+	return
+}
+
+// handleGroup returns a [ontology.Identity] for the given Entra ID group
+func (d *azureDiscovery) handleGroup(group *graphDirectoryObject, privileged bool) *ontology.Identity {
+	return &ontology.Identity{
+		Id:           identityID(util.Ref(armauthorization.PrincipalTypeGroup), group.ID),
+		Name:         group.DisplayName,
+		Description:  "Group",
+		CreationTime: creationTime(group.CreatedDateTime),
+		Activated:    true,
+		Privileged:   privileged,
+		Raw:          discovery.Raw(group),
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+)
+
+// privilegedActions are actions that allow to modify all resources or to grant access to others. Roles containing one
+// of these actions, such as Owner, Contributor or User Access Administrator, are considered privileged.
+var privilegedActions = []string{
+	"*",
+	"Microsoft.Authorization/*",
+	"Microsoft.Authorization/*/write",
+	"Microsoft.Authorization/roleAssignments/write",
+}
+
+// isPrivilegedRole returns true, if the role definition grants one of the [privilegedActions]
+func isPrivilegedRole(def *armauthorization.RoleDefinition) bool {
+	if def == nil || def.Properties == nil {
+		return false
+	}
+
+	for _, p := range def.Properties.Permissions {
+		if p == nil {
+			continue
+		}
+
+		for _, action := range p.Actions {
+			if slices.ContainsFunc(privilegedActions, func(a string) bool {
+				return strings.EqualFold(a, util.Deref(action))
+			}) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// roleDefinitionName returns the name (GUID) of the role definition of the role assignment
+func roleDefinitionName(ra *armauthorization.RoleAssignment) string {
+	if ra.Properties == nil || ra.Properties.RoleDefinitionID == nil {
+		return ""
+	}
+
+	id := util.Deref(ra.Properties.RoleDefinitionID)
+
+	return strings.ToLower(id[strings.LastIndex(id, "/")+1:])
+}
+
+// isInheritedRoleAssignment returns true, if the role assignment is not assigned at the scope of a subscription (or a
+// resource within it), but inherited from a management group or the root scope of the tenant
+func isInheritedRoleAssignment(ra *armauthorization.RoleAssignment) bool {
+	if ra.Properties == nil || ra.Properties.Scope == nil {
+		return false
+	}
+
+	return !strings.HasPrefix(strings.ToLower(util.Deref(ra.Properties.Scope)), "/subscriptions/")
+}
+
+// identityID returns the ID of an Entra ID object. Since these objects are no Azure resources, we build an ID
+// similar to a resource ID from the type of the principal and its object ID.
+func identityID(typ *armauthorization.PrincipalType, objectID string) string {
+	var collection string
+
+	switch util.Deref(typ) {
+	case armauthorization.PrincipalTypeUser:
+		collection = "users"
+	case armauthorization.PrincipalTypeGroup, armauthorization.PrincipalTypeForeignGroup:
+		collection = "groups"
+	case armauthorization.PrincipalTypeServicePrincipal:
+		collection = "serviceprincipals"
+	default:
+		collection = "directoryobjects"
+	}
+
+	return strings.ToLower("/providers/microsoft.graph/" + collection + "/" + objectID)
+}
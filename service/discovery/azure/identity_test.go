@@ -0,0 +1,156 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+)
+
+func Test_azureDiscovery_discoverRoleAssignments(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverRoleAssignments()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(got))
+
+	user := assert.Is[*ontology.Identity](t, got[0])
+	assert.Equal(t, "/providers/microsoft.graph/users/user1", user.Id)
+	assert.Equal(t, "Guest user", user.Description)
+	assert.True(t, user.Activated)
+	assert.True(t, user.EnforceMfa)
+	assert.True(t, user.Privileged)
+
+	sp := assert.Is[*ontology.Identity](t, got[1])
+	assert.Equal(t, "/providers/microsoft.graph/serviceprincipals/sp1", sp.Id)
+	assert.Equal(t, "Service principal", sp.Description)
+	assert.False(t, sp.Privileged)
+
+	secret := assert.Is[*ontology.Secret](t, got[2])
+	assert.Equal(t, sp.Id+"/password-credentials/secret1", secret.Id)
+	assert.Equal(t, "client-secret", secret.Name)
+	assert.Equal(t, sp.Id, util.Deref(secret.ParentId))
+	assert.False(t, secret.Enabled)
+
+	owner := assert.Is[*ontology.RoleAssignment](t, got[3])
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/providers/microsoft.authorization/roleassignments/ra1", owner.Id)
+	assert.Equal(t, "Owner", owner.Name)
+	assert.Equal(t, user.Id, util.Deref(owner.ParentId))
+
+	reader := assert.Is[*ontology.RoleAssignment](t, got[4])
+	assert.Equal(t, "Reader", reader.Name)
+	assert.Equal(t, sp.Id, util.Deref(reader.ParentId))
+}
+
+func Test_isPrivilegedRole(t *testing.T) {
+	tests := []struct {
+		name string
+		def  *armauthorization.RoleDefinition
+		want bool
+	}{
+		{
+			name: "nil",
+			want: false,
+		},
+		{
+			name: "Owner",
+			def: &armauthorization.RoleDefinition{
+				Properties: &armauthorization.RoleDefinitionProperties{
+					Permissions: []*armauthorization.Permission{{Actions: []*string{util.Ref("*")}}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "User Access Administrator",
+			def: &armauthorization.RoleDefinition{
+				Properties: &armauthorization.RoleDefinitionProperties{
+					Permissions: []*armauthorization.Permission{{Actions: []*string{util.Ref("*/read"), util.Ref("Microsoft.Authorization/*")}}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Reader",
+			def: &armauthorization.RoleDefinition{
+				Properties: &armauthorization.RoleDefinitionProperties{
+					Permissions: []*armauthorization.Permission{{Actions: []*string{util.Ref("*/read")}}},
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isPrivilegedRole(tt.def))
+		})
+	}
+}
+
+func Test_identityID(t *testing.T) {
+	assert.Equal(t, "/providers/microsoft.graph/users/abc", identityID(util.Ref(armauthorization.PrincipalTypeUser), "ABC"))
+	assert.Equal(t, "/providers/microsoft.graph/groups/abc", identityID(util.Ref(armauthorization.PrincipalTypeGroup), "abc"))
+	assert.Equal(t, "/providers/microsoft.graph/directoryobjects/abc", identityID(nil, "abc"))
+}
+
+func Test_graphEndpoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		cloud cloud.Configuration
+		want  string
+	}{
+		{
+			name: "default",
+			want: "https://graph.microsoft.com",
+		},
+		{
+			name:  "Azure Government",
+			cloud: cloud.AzureGovernment,
+			want:  "https://graph.microsoft.us",
+		},
+		{
+			name: "custom",
+			cloud: cloud.Configuration{
+				ActiveDirectoryAuthorityHost: "https://login.example.com/",
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					GraphService: {Endpoint: "https://graph.example.com/"},
+				},
+			},
+			want: "https://graph.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, graphEndpoint(tt.cloud))
+		})
+	}
+}
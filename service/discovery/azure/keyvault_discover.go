@@ -0,0 +1,197 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azcertificates"
+)
+
+// discoverKeyVaults discovers key vaults together with their keys, secrets and certificates
+func (d *azureDiscovery) discoverKeyVaults() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize key vaults client
+	if err := d.initKeyVaultsClient(); err != nil {
+		return nil, err
+	}
+
+	// List all key vaults
+	err := listPager(d,
+		d.clients.keyVaultsClient.NewListBySubscriptionPager,
+		d.clients.keyVaultsClient.NewListByResourceGroupPager,
+		func(res armkeyvault.VaultsClientListBySubscriptionResponse) []*armkeyvault.Vault {
+			return res.Value
+		},
+		func(res armkeyvault.VaultsClientListByResourceGroupResponse) []*armkeyvault.Vault {
+			return res.Value
+		},
+		func(vault *armkeyvault.Vault) error {
+			var credentials []ontology.IsResource
+
+			keys, err := d.discoverKeys(vault)
+			if err != nil {
+				return fmt.Errorf("could not discover keys of key vault '%s': %w", util.Deref(vault.Name), err)
+			}
+			credentials = append(credentials, keys...)
+
+			secrets, err := d.discoverSecrets(vault)
+			if err != nil {
+				return fmt.Errorf("could not discover secrets of key vault '%s': %w", util.Deref(vault.Name), err)
+			}
+			credentials = append(credentials, secrets...)
+
+			// Certificates are only available in the data plane, which might be restricted by the network ACLs or
+			// access policies of the vault. Therefore, we do not abort the discovery if they cannot be retrieved.
+			certificates, err := d.discoverCertificates(vault)
+			if err != nil {
+				log.Warnf("Could not discover certificates of key vault '%s': %v", util.Deref(vault.Name), err)
+			}
+			credentials = append(credentials, certificates...)
+
+			kv := d.handleKeyVault(vault, credentials)
+
+			log.Infof("Adding key vault '%s'", kv.GetName())
+
+			list = append(list, kv)
+			list = append(list, credentials...)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverKeys discovers the keys of the given key vault
+func (d *azureDiscovery) discoverKeys(vault *armkeyvault.Vault) ([]ontology.IsResource, error) {
+	var (
+		list []ontology.IsResource
+		rg   = resourceGroupName(util.Deref(vault.ID))
+	)
+
+	// initialize keys client
+	if err := d.initKeysClient(); err != nil {
+		return nil, err
+	}
+
+	// List all keys of the vault
+	pager := d.clients.keysClient.NewListPager(rg, util.Deref(vault.Name), &armkeyvault.KeysClientListOptions{})
+	err := allPages(pager, func(page armkeyvault.KeysClientListResponse) error {
+		for _, value := range page.Value {
+			// The rotation policy is only part of the response if we retrieve the key directly
+			res, err := d.clients.keysClient.Get(context.TODO(), rg, util.Deref(vault.Name), util.Deref(value.Name), &armkeyvault.KeysClientGetOptions{})
+			if err != nil {
+				return fmt.Errorf("could not get key '%s': %w", util.Deref(value.Name), err)
+			}
+
+			key := d.handleKey(&res.Key, vault)
+
+			log.Infof("Adding key '%s'", key.GetName())
+
+			list = append(list, key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverSecrets discovers the secrets of the given key vault. The values of the secrets are never retrieved.
+func (d *azureDiscovery) discoverSecrets(vault *armkeyvault.Vault) ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize secrets client
+	if err := d.initSecretsClient(); err != nil {
+		return nil, err
+	}
+
+	// List all secrets of the vault
+	pager := d.clients.secretsClient.NewListPager(resourceGroupName(util.Deref(vault.ID)), util.Deref(vault.Name), &armkeyvault.SecretsClientListOptions{})
+	err := allPages(pager, func(page armkeyvault.SecretsClientListResponse) error {
+		for _, value := range page.Value {
+			secret := d.handleSecret(value, vault)
+
+			log.Infof("Adding secret '%s'", secret.GetName())
+
+			list = append(list, secret)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverCertificates discovers the certificates of the given key vault. In contrast to keys and secrets,
+// certificates are not available in the Azure Resource Manager API, so we need to query the vault itself.
+func (d *azureDiscovery) discoverCertificates(vault *armkeyvault.Vault) ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	if vault.Properties == nil || vault.Properties.VaultURI == nil {
+		return nil, nil
+	}
+
+	client, err := azcertificates.NewClient(util.Deref(vault.Properties.VaultURI), d.cred, &azcertificates.ClientOptions{
+		ClientOptions: d.clientOptions.ClientOptions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get certificates client: %w", err)
+	}
+
+	// List all certificates of the vault
+	err = allPages(client.NewListCertificatePropertiesPager(nil), func(page azcertificates.ListCertificatePropertiesResponse) error {
+		for _, value := range page.Value {
+			certificate := d.handleCertificate(value, vault)
+
+			log.Infof("Adding certificate '%s'", certificate.GetName())
+
+			list = append(list, certificate)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
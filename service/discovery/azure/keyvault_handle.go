@@ -0,0 +1,134 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azcertificates"
+)
+
+// handleKeyVault returns a [ontology.KeyVault] for the given vault. The soft-delete and purge protection settings are
+// exposed as labels.
+func (d *azureDiscovery) handleKeyVault(vault *armkeyvault.Vault, credentials []ontology.IsResource) *ontology.KeyVault {
+	var created *time.Time
+
+	if vault.SystemData != nil {
+		created = vault.SystemData.CreatedAt
+	}
+
+	return &ontology.KeyVault{
+		Id:                         resourceID(vault.ID),
+		Name:                       util.Deref(vault.Name),
+		CreationTime:               creationTime(created),
+		GeoLocation:                location(vault.Location),
+		Labels:                     keyVaultLabels(labels(vault.Tags), vault.Properties),
+		ParentId:                   resourceGroupID(vault.ID),
+		Raw:                        discovery.Raw(vault),
+		InternetAccessibleEndpoint: keyVaultInternetAccessible(vault.Properties),
+		CredentialIds:              ontology.ResourceIDs(credentials),
+	}
+}
+
+// handleKey returns a [ontology.Key] for the given key of a vault
+func (d *azureDiscovery) handleKey(key *armkeyvault.Key, vault *armkeyvault.Vault) *ontology.Key {
+	var (
+		props = util.Deref(key.Properties)
+		attrs = util.Deref(props.Attributes)
+	)
+
+	return &ontology.Key{
+		Id:             resourceID(key.ID),
+		Name:           util.Deref(key.Name),
+		CreationTime:   unixTime(attrs.Created),
+		GeoLocation:    location(vault.Location),
+		Labels:         keyLabels(labels(key.Tags), &props),
+		ParentId:       resourceIDPointer(vault.ID),
+		Raw:            discovery.Raw(key),
+		Enabled:        util.Deref(attrs.Enabled),
+		Algorithm:      string(util.Deref(props.Kty)),
+		KeySize:        keySize(&props),
+		ExpirationDate: keyExpirationDate(&props),
+		NotBeforeDate:  unixTime(attrs.NotBefore),
+	}
+}
+
+// handleSecret returns a [ontology.Secret] for the given secret of a vault. The value of the secret is removed before
+// it is added to the raw evidence.
+func (d *azureDiscovery) handleSecret(secret *armkeyvault.Secret, vault *armkeyvault.Vault) *ontology.Secret {
+	var (
+		props = util.Deref(secret.Properties)
+		attrs = util.Deref(props.Attributes)
+	)
+
+	if secret.Properties != nil {
+		secret.Properties.Value = nil
+	}
+
+	return &ontology.Secret{
+		Id:             resourceID(secret.ID),
+		Name:           util.Deref(secret.Name),
+		CreationTime:   creationTime(attrs.Created),
+		GeoLocation:    location(vault.Location),
+		Labels:         labels(secret.Tags),
+		ParentId:       resourceIDPointer(vault.ID),
+		Raw:            discovery.Raw(secret),
+		Enabled:        util.Deref(attrs.Enabled),
+		ExpirationDate: creationTime(attrs.Expires),
+		NotBeforeDate:  creationTime(attrs.NotBefore),
+	}
+}
+
+// handleCertificate returns a [ontology.Certificate] for the given certificate of a vault. Since certificates are no
+// Azure resources, their ID is derived from the ID of the vault.
+func (d *azureDiscovery) handleCertificate(cert *azcertificates.CertificateProperties, vault *armkeyvault.Vault) *ontology.Certificate {
+	var (
+		attrs = util.Deref(cert.Attributes)
+		name  string
+	)
+
+	if cert.ID != nil {
+		name = cert.ID.Name()
+	}
+
+	return &ontology.Certificate{
+		Id:             certificateID(vault.ID, name),
+		Name:           name,
+		CreationTime:   creationTime(attrs.Created),
+		GeoLocation:    location(vault.Location),
+		Labels:         labels(cert.Tags),
+		ParentId:       resourceIDPointer(vault.ID),
+		Raw:            discovery.Raw(cert),
+		Enabled:        util.Deref(attrs.Enabled),
+		ExpirationDate: creationTime(attrs.Expires),
+		NotBeforeDate:  creationTime(attrs.NotBefore),
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// LabelKeyURI is the label that contains the URI of a key without its version, e.g.,
+	// "https://vault.vault.azure.net/keys/key". Storage services that are encrypted with the key contain the same URI as
+	// key URL of their customer key encryption.
+	LabelKeyURI = "azure:key-uri"
+
+	// LabelKeyRotationEnabled is the label that specifies whether the rotation policy of a key rotates it automatically.
+	LabelKeyRotationEnabled = "azure:key-rotation-enabled"
+
+	// LabelSoftDeleteEnabled is the label that specifies whether soft-delete is enabled for a key vault.
+	LabelSoftDeleteEnabled = "azure:soft-delete-enabled"
+
+	// LabelSoftDeleteRetentionDays is the label that contains the number of days deleted items are retained in a key
+	// vault.
+	LabelSoftDeleteRetentionDays = "azure:soft-delete-retention-days"
+
+	// LabelPurgeProtectionEnabled is the label that specifies whether purge protection is enabled for a key vault.
+	LabelPurgeProtectionEnabled = "azure:purge-protection-enabled"
+)
+
+// keyVaultDurationRegexp matches the ISO 8601 durations used by key rotation policies, e.g., "P90D" or "P1Y6M"
+var keyVaultDurationRegexp = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?$`)
+
+// keyVaultInternetAccessible returns true, if the data plane of the vault can be reached from the internet, i.e., if
+// public network access is not disabled and the network ACLs do not deny access by default.
+func keyVaultInternetAccessible(props *armkeyvault.VaultProperties) bool {
+	if props == nil {
+		return false
+	}
+
+	if strings.EqualFold(util.Deref(props.PublicNetworkAccess), "Disabled") {
+		return false
+	}
+
+	// Without network ACLs, the vault allows access from all networks
+	if props.NetworkACLs == nil || util.Deref(props.NetworkACLs.DefaultAction) != armkeyvault.NetworkRuleActionDeny {
+		return true
+	}
+
+	// Access is denied by default, but an IP rule might still open it to everyone
+	for _, rule := range props.NetworkACLs.IPRules {
+		if rule != nil && util.Deref(rule.Value) == "0.0.0.0/0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keySize returns the size of the key in bits. For elliptic curve keys, the size is derived from the curve.
+func keySize(props *armkeyvault.KeyProperties) int32 {
+	if props.KeySize != nil {
+		return *props.KeySize
+	}
+
+	switch util.Deref(props.CurveName) {
+	case armkeyvault.JSONWebKeyCurveNameP256, armkeyvault.JSONWebKeyCurveNameP256K:
+		return 256
+	case armkeyvault.JSONWebKeyCurveNameP384:
+		return 384
+	case armkeyvault.JSONWebKeyCurveNameP521:
+		return 521
+	default:
+		return 0
+	}
+}
+
+// keyExpirationDate returns the date at which the current version of the key expires. If the rotation policy of the
+// key automatically rotates it before that, the date of the next rotation is returned instead.
+func keyExpirationDate(props *armkeyvault.KeyProperties) *timestamppb.Timestamp {
+	var (
+		attrs   = util.Deref(props.Attributes)
+		expires *time.Time
+		created time.Time
+	)
+
+	if attrs.Expires != nil {
+		expires = util.Ref(time.Unix(*attrs.Expires, 0).UTC())
+	}
+
+	if attrs.Created == nil || props.RotationPolicy == nil {
+		return creationTime(expires)
+	}
+	created = time.Unix(*attrs.Created, 0).UTC()
+
+	// If the key has no explicit expiry, the rotation policy defines it relative to the creation of the key version
+	if expires == nil && props.RotationPolicy.Attributes != nil && props.RotationPolicy.Attributes.ExpiryTime != nil {
+		t, err := addKeyVaultDuration(created, util.Deref(props.RotationPolicy.Attributes.ExpiryTime))
+		if err != nil {
+			log.Warnf("Could not parse expiry time of rotation policy: %v", err)
+		} else {
+			expires = &t
+		}
+	}
+
+	for _, action := range props.RotationPolicy.LifetimeActions {
+		if action == nil || action.Action == nil || action.Trigger == nil ||
+			!strings.EqualFold(string(util.Deref(action.Action.Type)), string(armkeyvault.KeyRotationPolicyActionTypeRotate)) {
+			continue
+		}
+
+		var (
+			rotation time.Time
+			err      error
+		)
+
+		switch {
+		case action.Trigger.TimeAfterCreate != nil:
+			rotation, err = addKeyVaultDuration(created, util.Deref(action.Trigger.TimeAfterCreate))
+		case action.Trigger.TimeBeforeExpiry != nil && expires != nil:
+			rotation, err = addKeyVaultDuration(*expires, "-"+util.Deref(action.Trigger.TimeBeforeExpiry))
+		default:
+			continue
+		}
+		if err != nil {
+			log.Warnf("Could not parse trigger of rotation policy: %v", err)
+			continue
+		}
+
+		if expires == nil || rotation.Before(*expires) {
+			expires = &rotation
+		}
+	}
+
+	return creationTime(expires)
+}
+
+// addKeyVaultDuration adds the ISO 8601 duration d, which is optionally prefixed with "-", to t
+func addKeyVaultDuration(t time.Time, d string) (time.Time, error) {
+	var (
+		sign  = 1
+		parts [3]int
+	)
+
+	if after, ok := strings.CutPrefix(d, "-"); ok {
+		sign = -1
+		d = after
+	}
+
+	match := keyVaultDurationRegexp.FindStringSubmatch(d)
+	if match == nil || d == "P" {
+		return t, fmt.Errorf("invalid duration %q", d)
+	}
+
+	for i, m := range match[1:] {
+		if m != "" {
+			parts[i], _ = strconv.Atoi(m)
+		}
+	}
+
+	return t.AddDate(sign*parts[0], sign*parts[1], sign*parts[2]), nil
+}
+
+// unixTime returns the Unix timestamp t in seconds as [timestamppb.Timestamp]
+func unixTime(t *int64) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(time.Unix(*t, 0))
+}
+
+// certificateID returns the ID of a certificate, which is built from the ID of the vault and the certificate name,
+// similar to the IDs of keys and secrets
+func certificateID(vaultID *string, name string) string {
+	return resourceID(vaultID) + "/certificates/" + strings.ToLower(name)
+}
+
+// keyVaultLabels adds the soft-delete and purge protection settings of the vault to the labels. Soft-delete is enabled
+// by default, if it is not explicitly disabled.
+func keyVaultLabels(l map[string]string, props *armkeyvault.VaultProperties) map[string]string {
+	if props == nil {
+		return l
+	}
+
+	l[LabelSoftDeleteEnabled] = strconv.FormatBool(props.EnableSoftDelete == nil || *props.EnableSoftDelete)
+	l[LabelPurgeProtectionEnabled] = strconv.FormatBool(util.Deref(props.EnablePurgeProtection))
+
+	if props.SoftDeleteRetentionInDays != nil {
+		l[LabelSoftDeleteRetentionDays] = strconv.Itoa(int(*props.SoftDeleteRetentionInDays))
+	}
+
+	return l
+}
+
+// keyLabels adds the URI of the key and whether it is rotated automatically to the labels
+func keyLabels(l map[string]string, props *armkeyvault.KeyProperties) map[string]string {
+	if props.KeyURI != nil {
+		l[LabelKeyURI] = keyURI(*props.KeyURI)
+	}
+
+	l[LabelKeyRotationEnabled] = strconv.FormatBool(keyRotationEnabled(props))
+
+	return l
+}
+
+// keyRotationEnabled returns true, if the rotation policy of the key contains an action that rotates the key
+func keyRotationEnabled(props *armkeyvault.KeyProperties) bool {
+	if props.RotationPolicy == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(props.RotationPolicy.LifetimeActions, func(action *armkeyvault.LifetimeAction) bool {
+		return action != nil && action.Action != nil && action.Trigger != nil &&
+			strings.EqualFold(string(util.Deref(action.Action.Type)), string(armkeyvault.KeyRotationPolicyActionTypeRotate))
+	})
+}
+
+// keyURI returns the URI of a key without its version in lower case, e.g., "https://vault.vault.azure.net/keys/key"
+// for "https://vault.vault.azure.net/keys/key/0123456789abcdef". Names of keys are case-insensitive, therefore the URI
+// can be compared to the [LabelKeyURI] label of a key.
+func keyURI(uri string) string {
+	vault, rest, ok := strings.Cut(strings.ToLower(uri), "/keys/")
+	if !ok {
+		return strings.ToLower(uri)
+	}
+
+	name, _, _ := strings.Cut(rest, "/")
+
+	return vaultKeyURI(vault, name)
+}
+
+// vaultKeyURI returns the URI of the key with the given name in the vault, e.g.,
+// "https://vault.vault.azure.net/keys/key", in the format of [keyURI]
+func vaultKeyURI(vaultURI string, name string) string {
+	return strings.ToLower(strings.TrimSuffix(vaultURI, "/") + "/keys/" + name)
+}
@@ -0,0 +1,276 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/keyvault/armkeyvault"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_azureDiscovery_discoverKeyVaults(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverKeyVaults()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(got))
+
+	vaultID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.keyvault/vaults/keyvault1"
+
+	kv := assert.Is[*ontology.KeyVault](t, got[0])
+	assert.Equal(t, vaultID, kv.Id)
+	assert.Equal(t, "keyvault1", kv.Name)
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1", util.Deref(kv.ParentId))
+	assert.False(t, kv.InternetAccessibleEndpoint)
+	assert.Equal(t, []string{
+		vaultID + "/keys/customer-key",
+		vaultID + "/secrets/secret1",
+		vaultID + "/certificates/cert1",
+	}, kv.CredentialIds)
+	assert.Equal(t, map[string]string{
+		"testKey1":                  "testTag1",
+		LabelSoftDeleteEnabled:      "true",
+		LabelPurgeProtectionEnabled: "true",
+	}, kv.Labels)
+
+	key := assert.Is[*ontology.Key](t, got[1])
+	assert.Equal(t, vaultID, util.Deref(key.ParentId))
+	assert.True(t, key.Enabled)
+	assert.Equal(t, "RSA", key.Algorithm)
+	assert.Equal(t, int32(2048), key.KeySize)
+	assert.Equal(t, map[string]string{
+		LabelKeyURI:             "https://keyvault1.vault.azure.net/keys/customer-key",
+		LabelKeyRotationEnabled: "true",
+	}, key.Labels)
+	// The key is rotated 90 days after its creation, which is before it expires
+	assert.Equal(t, timestamppb.New(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)), key.ExpirationDate)
+
+	secret := assert.Is[*ontology.Secret](t, got[2])
+	assert.Equal(t, "secret1", secret.Name)
+	assert.True(t, secret.Enabled)
+	assert.Equal(t, timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), secret.ExpirationDate)
+
+	cert := assert.Is[*ontology.Certificate](t, got[3])
+	assert.Equal(t, vaultID+"/certificates/cert1", cert.Id)
+	assert.Equal(t, "cert1", cert.Name)
+	assert.Equal(t, timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), cert.NotBeforeDate)
+	assert.Equal(t, timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), cert.ExpirationDate)
+}
+
+func Test_azureDiscovery_handleSecret(t *testing.T) {
+	d := NewMockAzureDiscovery(nil)
+
+	got := d.handleSecret(&armkeyvault.Secret{
+		ID:   util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.KeyVault/vaults/keyvault1/secrets/secret1"),
+		Name: util.Ref("secret1"),
+		Properties: &armkeyvault.SecretProperties{
+			Value: util.Ref("top-secret"),
+		},
+	}, &armkeyvault.Vault{})
+
+	assert.Equal(t, "secret1", got.Name)
+	assert.False(t, strings.Contains(got.Raw, "top-secret"))
+}
+
+func Test_keyVaultInternetAccessible(t *testing.T) {
+	tests := []struct {
+		name  string
+		props *armkeyvault.VaultProperties
+		want  bool
+	}{
+		{
+			name: "no properties",
+			want: false,
+		},
+		{
+			name:  "no network ACLs",
+			props: &armkeyvault.VaultProperties{},
+			want:  true,
+		},
+		{
+			name: "public network access disabled",
+			props: &armkeyvault.VaultProperties{
+				PublicNetworkAccess: util.Ref("Disabled"),
+			},
+			want: false,
+		},
+		{
+			name: "deny by default",
+			props: &armkeyvault.VaultProperties{
+				NetworkACLs: &armkeyvault.NetworkRuleSet{
+					DefaultAction: util.Ref(armkeyvault.NetworkRuleActionDeny),
+					IPRules:       []*armkeyvault.IPRule{{Value: util.Ref("203.0.113.0/24")}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "deny by default, but allow everyone",
+			props: &armkeyvault.VaultProperties{
+				NetworkACLs: &armkeyvault.NetworkRuleSet{
+					DefaultAction: util.Ref(armkeyvault.NetworkRuleActionDeny),
+					IPRules:       []*armkeyvault.IPRule{{Value: util.Ref("0.0.0.0/0")}},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keyVaultInternetAccessible(tt.props))
+		})
+	}
+}
+
+func Test_keySize(t *testing.T) {
+	assert.Equal(t, int32(4096), keySize(&armkeyvault.KeyProperties{KeySize: util.Ref(int32(4096))}))
+	assert.Equal(t, int32(384), keySize(&armkeyvault.KeyProperties{CurveName: util.Ref(armkeyvault.JSONWebKeyCurveNameP384)}))
+	assert.Equal(t, int32(0), keySize(&armkeyvault.KeyProperties{}))
+}
+
+func Test_keyExpirationDate(t *testing.T) {
+	var (
+		created = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		expires = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name  string
+		props *armkeyvault.KeyProperties
+		want  *timestamppb.Timestamp
+	}{
+		{
+			name:  "no expiry",
+			props: &armkeyvault.KeyProperties{},
+			want:  nil,
+		},
+		{
+			name: "expiry without rotation policy",
+			props: &armkeyvault.KeyProperties{
+				Attributes: &armkeyvault.KeyAttributes{
+					Created: util.Ref(created.Unix()),
+					Expires: util.Ref(expires.Unix()),
+				},
+			},
+			want: timestamppb.New(expires),
+		},
+		{
+			name: "rotation before expiry",
+			props: &armkeyvault.KeyProperties{
+				Attributes: &armkeyvault.KeyAttributes{
+					Created: util.Ref(created.Unix()),
+					Expires: util.Ref(expires.Unix()),
+				},
+				RotationPolicy: &armkeyvault.RotationPolicy{
+					LifetimeActions: []*armkeyvault.LifetimeAction{
+						{
+							Action:  &armkeyvault.Action{Type: util.Ref(armkeyvault.KeyRotationPolicyActionTypeRotate)},
+							Trigger: &armkeyvault.Trigger{TimeBeforeExpiry: util.Ref("P1M")},
+						},
+					},
+				},
+			},
+			want: timestamppb.New(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "expiry from rotation policy, notify only",
+			props: &armkeyvault.KeyProperties{
+				Attributes: &armkeyvault.KeyAttributes{
+					Created: util.Ref(created.Unix()),
+				},
+				RotationPolicy: &armkeyvault.RotationPolicy{
+					Attributes: &armkeyvault.KeyRotationPolicyAttributes{ExpiryTime: util.Ref("P2Y")},
+					LifetimeActions: []*armkeyvault.LifetimeAction{
+						{
+							Action:  &armkeyvault.Action{Type: util.Ref(armkeyvault.KeyRotationPolicyActionTypeNotify)},
+							Trigger: &armkeyvault.Trigger{TimeBeforeExpiry: util.Ref("P30D")},
+						},
+					},
+				},
+			},
+			want: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keyExpirationDate(tt.props))
+		})
+	}
+}
+
+func Test_addKeyVaultDuration(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := addKeyVaultDuration(start, "P1Y2M3D")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = addKeyVaultDuration(start, "-P1D")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), got)
+
+	_, err = addKeyVaultDuration(start, "PT1H")
+	assert.Error(t, err)
+
+	_, err = addKeyVaultDuration(start, "P")
+	assert.Error(t, err)
+}
+
+func Test_keyURI(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+		want string
+	}{
+		{
+			name: "versioned",
+			uri:  "https://keyvault1.vault.azure.net/keys/Customer-Key/6273gdb374jz789hjm17819283748382",
+			want: "https://keyvault1.vault.azure.net/keys/customer-key",
+		},
+		{
+			name: "versionless",
+			uri:  "https://keyvault1.vault.azure.net/keys/customer-key",
+			want: "https://keyvault1.vault.azure.net/keys/customer-key",
+		},
+		{
+			name: "no key",
+			uri:  "https://keyvault1.vault.azure.net/",
+			want: "https://keyvault1.vault.azure.net/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, keyURI(tt.uri))
+		})
+	}
+}
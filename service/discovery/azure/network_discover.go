@@ -135,3 +135,164 @@ func (d *azureDiscovery) discoverLoadBalancer() ([]ontology.IsResource, error) {
 
 	return list, nil
 }
+
+// discoverVirtualNetworks discovers virtual networks together with their subnets. The DDoS protection plan of a
+// virtual network is added to its raw evidence, if it can be retrieved.
+func (d *azureDiscovery) discoverVirtualNetworks() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize virtual networks client
+	if err := d.initVirtualNetworksClient(); err != nil {
+		return nil, err
+	}
+
+	plans, err := d.discoverDDoSProtectionPlans()
+	if err != nil {
+		log.Warnf("Could not discover DDoS protection plans, the virtual networks are discovered without them: %v", err)
+	}
+
+	// List all virtual networks
+	err = listPager(d,
+		d.clients.virtualNetworksClient.NewListAllPager,
+		d.clients.virtualNetworksClient.NewListPager,
+		func(res armnetwork.VirtualNetworksClientListAllResponse) []*armnetwork.VirtualNetwork {
+			return res.Value
+		},
+		func(res armnetwork.VirtualNetworksClientListResponse) []*armnetwork.VirtualNetwork {
+			return res.Value
+		},
+		func(vnet *armnetwork.VirtualNetwork) error {
+			var plan *armnetwork.DdosProtectionPlan
+
+			if vnet.Properties != nil && vnet.Properties.DdosProtectionPlan != nil {
+				plan = plans[resourceID(vnet.Properties.DdosProtectionPlan.ID)]
+			}
+
+			r := d.handleVirtualNetwork(vnet, plan)
+
+			log.Infof("Adding virtual network '%s'", r.GetName())
+
+			list = append(list, r)
+
+			if vnet.Properties == nil {
+				return nil
+			}
+
+			for _, subnet := range vnet.Properties.Subnets {
+				s := d.handleSubnet(subnet, vnet)
+
+				log.Infof("Adding subnet '%s'", s.GetName())
+
+				list = append(list, s)
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverDDoSProtectionPlans discovers DDoS protection plans. Since there is no matching resource in the ontology,
+// they are returned indexed by their ID, so that they can be added to the virtual networks they protect.
+func (d *azureDiscovery) discoverDDoSProtectionPlans() (map[string]*armnetwork.DdosProtectionPlan, error) {
+	var plans = make(map[string]*armnetwork.DdosProtectionPlan)
+
+	// initialize DDoS protection plans client
+	if err := d.initDDoSProtectionPlansClient(); err != nil {
+		return nil, err
+	}
+
+	// List all DDoS protection plans
+	err := listPager(d,
+		d.clients.ddosProtectionPlansClient.NewListPager,
+		d.clients.ddosProtectionPlansClient.NewListByResourceGroupPager,
+		func(res armnetwork.DdosProtectionPlansClientListResponse) []*armnetwork.DdosProtectionPlan {
+			return res.Value
+		},
+		func(res armnetwork.DdosProtectionPlansClientListByResourceGroupResponse) []*armnetwork.DdosProtectionPlan {
+			return res.Value
+		},
+		func(plan *armnetwork.DdosProtectionPlan) error {
+			plans[resourceID(plan.ID)] = plan
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// discoverNetworkSecurityGroups discovers network security groups
+func (d *azureDiscovery) discoverNetworkSecurityGroups() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize network security groups client
+	if err := d.initNetworkSecurityGroupClient(); err != nil {
+		return nil, err
+	}
+
+	// List all network security groups
+	err := listPager(d,
+		d.clients.networkSecurityGroupsClient.NewListAllPager,
+		d.clients.networkSecurityGroupsClient.NewListPager,
+		func(res armnetwork.SecurityGroupsClientListAllResponse) []*armnetwork.SecurityGroup {
+			return res.Value
+		},
+		func(res armnetwork.SecurityGroupsClientListResponse) []*armnetwork.SecurityGroup {
+			return res.Value
+		},
+		func(nsg *armnetwork.SecurityGroup) error {
+			r := d.handleNetworkSecurityGroup(nsg)
+
+			log.Infof("Adding network security group '%s'", r.GetName())
+
+			list = append(list, r)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// discoverFirewalls discovers Azure Firewalls
+func (d *azureDiscovery) discoverFirewalls() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	// initialize firewalls client
+	if err := d.initFirewallsClient(); err != nil {
+		return nil, err
+	}
+
+	// List all firewalls
+	err := listPager(d,
+		d.clients.firewallsClient.NewListAllPager,
+		d.clients.firewallsClient.NewListPager,
+		func(res armnetwork.AzureFirewallsClientListAllResponse) []*armnetwork.AzureFirewall {
+			return res.Value
+		},
+		func(res armnetwork.AzureFirewallsClientListResponse) []*armnetwork.AzureFirewall {
+			return res.Value
+		},
+		func(fw *armnetwork.AzureFirewall) error {
+			r := d.handleFirewall(fw)
+
+			log.Infof("Adding firewall '%s'", r.GetName())
+
+			list = append(list, r)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -86,8 +86,8 @@ func (d *azureDiscovery) handleNetworkInterfaces(ni *armnetwork.Interface) ontol
 		GeoLocation: &ontology.GeoLocation{
 			Region: util.Deref(ni.Location),
 		},
-		Labels:   labels(ni.Tags),
-		ParentId: resourceGroupID(ni.ID),
+		Labels:   networkSecurityGroupLabel(labels(ni.Tags), util.Deref(ni.Properties).NetworkSecurityGroup),
+		ParentId: networkInterfaceParentID(ni),
 		Raw:      discovery.Raw(ni),
 		AccessRestriction: &ontology.AccessRestriction{
 			Type: &ontology.AccessRestriction_L3Firewall{
@@ -98,3 +98,63 @@ func (d *azureDiscovery) handleNetworkInterfaces(ni *armnetwork.Interface) ontol
 		},
 	}
 }
+
+// handleVirtualNetwork returns a [ontology.VirtualNetwork] for the given virtual network. The DDoS protection plan is
+// part of the raw evidence, since there is no matching resource in the ontology.
+func (d *azureDiscovery) handleVirtualNetwork(vnet *armnetwork.VirtualNetwork, plan *armnetwork.DdosProtectionPlan) ontology.IsResource {
+	return &ontology.VirtualNetwork{
+		Id:           resourceID(vnet.ID),
+		Name:         util.Deref(vnet.Name),
+		CreationTime: nil, // No creation time available
+		GeoLocation:  location(vnet.Location),
+		Labels:       labels(vnet.Tags),
+		ParentId:     resourceGroupID(vnet.ID),
+		Raw:          discovery.Raw(vnet, plan),
+	}
+}
+
+// handleSubnet returns a [ontology.VirtualSubNetwork] for the given subnet of a virtual network
+func (d *azureDiscovery) handleSubnet(subnet *armnetwork.Subnet, vnet *armnetwork.VirtualNetwork) ontology.IsResource {
+	return &ontology.VirtualSubNetwork{
+		Id:           resourceID(subnet.ID),
+		Name:         util.Deref(subnet.Name),
+		CreationTime: nil, // No creation time available
+		GeoLocation:  location(vnet.Location),
+		Labels:       networkSecurityGroupLabel(labels(vnet.Tags), util.Deref(subnet.Properties).NetworkSecurityGroup),
+		ParentId:     resourceIDPointer(vnet.ID),
+		Raw:          discovery.Raw(subnet),
+	}
+}
+
+// handleNetworkSecurityGroup returns a [ontology.NetworkSecurityGroup] for the given network security group. It is
+// internet accessible, if its inbound rules allow traffic from the internet to at least one port.
+func (d *azureDiscovery) handleNetworkSecurityGroup(nsg *armnetwork.SecurityGroup) ontology.IsResource {
+	return &ontology.NetworkSecurityGroup{
+		Id:                         resourceID(nsg.ID),
+		Name:                       util.Deref(nsg.Name),
+		CreationTime:               nil, // No creation time available
+		GeoLocation:                location(nsg.Location),
+		Labels:                     labels(nsg.Tags),
+		ParentId:                   resourceGroupID(nsg.ID),
+		Raw:                        discovery.Raw(nsg),
+		InternetAccessibleEndpoint: nsgAllowsInboundInternet(nsg),
+	}
+}
+
+// handleFirewall returns a [ontology.NetworkSecurityGroup] for the given Azure Firewall, since the ontology has no
+// dedicated firewall resource. Like a network security group, it filters the traffic of the subnets that route through
+// it. It is internet accessible, if a public IP address is assigned to it. The private IP addresses and the threat
+// intelligence mode are part of the raw evidence.
+func (d *azureDiscovery) handleFirewall(fw *armnetwork.AzureFirewall) ontology.IsResource {
+	return &ontology.NetworkSecurityGroup{
+		Id:                         resourceID(fw.ID),
+		Name:                       util.Deref(fw.Name),
+		Description:                firewallDescription(fw),
+		CreationTime:               nil, // No creation time available
+		GeoLocation:                location(fw.Location),
+		Labels:                     labels(fw.Tags),
+		ParentId:                   firewallParentID(fw),
+		Raw:                        discovery.Raw(fw),
+		InternetAccessibleEndpoint: firewallHasPublicIPAddress(fw),
+	}
+}
@@ -26,7 +26,12 @@
 package azure
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"clouditor.io/clouditor/v2/internal/util"
 
@@ -142,3 +147,201 @@ func publicIPAddressFromLoadBalancer(lb *armnetwork.LoadBalancer) []string {
 
 	return publicIPAddresses
 }
+
+// LabelNetworkSecurityGroupID is the label that contains the ID of the network security group associated with a
+// network interface or subnet, since the ontology has no property for it
+const LabelNetworkSecurityGroupID = "azure:network-security-group-id"
+
+// networkSecurityGroupLabel adds the ID of the network security group to the labels, if one is associated
+func networkSecurityGroupLabel(l map[string]string, nsg *armnetwork.SecurityGroup) map[string]string {
+	if nsg != nil && nsg.ID != nil {
+		l[LabelNetworkSecurityGroupID] = resourceID(nsg.ID)
+	}
+
+	return l
+}
+
+// internetAddressPrefixes are the source address prefixes of a security rule, that match traffic from the internet
+var internetAddressPrefixes = []string{"*", "Internet", "0.0.0.0/0", "::/0"}
+
+// networkInterfaceParentID returns the ID of the subnet of the primary IP configuration of the network interface. If
+// the network interface is not attached to a subnet, the resource group is returned instead.
+func networkInterfaceParentID(ni *armnetwork.Interface) *string {
+	if ni.Properties != nil {
+		for _, ipc := range ni.Properties.IPConfigurations {
+			if ipc == nil || ipc.Properties == nil || ipc.Properties.Subnet == nil || ipc.Properties.Subnet.ID == nil {
+				continue
+			}
+
+			if util.Deref(ipc.Properties.Primary) || len(ni.Properties.IPConfigurations) == 1 {
+				return resourceIDPointer(ipc.Properties.Subnet.ID)
+			}
+		}
+	}
+
+	return resourceGroupID(ni.ID)
+}
+
+// nsgRules returns the custom and default security rules of the network security group in the order in which Azure
+// evaluates them, i.e., ordered by priority
+func nsgRules(nsg *armnetwork.SecurityGroup) (rules []*armnetwork.SecurityRule) {
+	if nsg.Properties == nil {
+		return nil
+	}
+
+	for _, r := range slices.Concat(nsg.Properties.SecurityRules, nsg.Properties.DefaultSecurityRules) {
+		if r != nil && r.Properties != nil {
+			rules = append(rules, r)
+		}
+	}
+
+	slices.SortStableFunc(rules, func(a, b *armnetwork.SecurityRule) int {
+		return cmp.Compare(util.Deref(a.Properties.Priority), util.Deref(b.Properties.Priority))
+	})
+
+	return rules
+}
+
+// nsgAllowsInboundInternet returns true, if the inbound rules of the network security group allow traffic from the
+// internet to at least one port. The rules are evaluated in order of their priority, so a rule allowing traffic only
+// takes effect for the ports that are not denied by a rule with a higher priority.
+//
+// Note, that this evaluates the rules of the network security group itself. The effective rules of a network interface
+// (see the GetEffectiveSecurityRules API) additionally combine the network security groups of the interface and its
+// subnet. That API is a long-running operation per network interface and only available for running VMs, therefore it
+// is not used. Instead, the network security group of a network interface or subnet is referenced by the
+// [LabelNetworkSecurityGroupID] label.
+func nsgAllowsInboundInternet(nsg *armnetwork.SecurityGroup) bool {
+	var denied []portRange
+
+	for _, r := range nsgRules(nsg) {
+		props := r.Properties
+
+		if util.Deref(props.Direction) != armnetwork.SecurityRuleDirectionInbound {
+			continue
+		}
+
+		sources := append([]*string{props.SourceAddressPrefix}, props.SourceAddressPrefixes...)
+		if !slices.ContainsFunc(sources, func(prefix *string) bool {
+			return slices.Contains(internetAddressPrefixes, util.Deref(prefix))
+		}) {
+			continue
+		}
+
+		ports := destinationPorts(props)
+
+		switch util.Deref(props.Access) {
+		case armnetwork.SecurityRuleAccessAllow:
+			if slices.ContainsFunc(ports, func(p portRange) bool { return !p.coveredBy(denied) }) {
+				return true
+			}
+		case armnetwork.SecurityRuleAccessDeny:
+			denied = append(denied, ports...)
+		}
+	}
+
+	return false
+}
+
+// portRange is an inclusive range of ports of a security rule
+type portRange struct {
+	from, to int
+}
+
+// destinationPorts returns the destination port ranges of the security rule, which are either specified as single
+// range or as list of ranges
+func destinationPorts(props *armnetwork.SecurityRulePropertiesFormat) (ranges []portRange) {
+	for _, s := range append([]*string{props.DestinationPortRange}, props.DestinationPortRanges...) {
+		if s == nil {
+			continue
+		}
+
+		r, err := parsePortRange(*s)
+		if err != nil {
+			log.Debugf("Ignoring destination port range of security rule: %v", err)
+			continue
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return
+}
+
+// parsePortRange parses a port range of a security rule, e.g., "*", "443" or "8000-8080"
+func parsePortRange(s string) (r portRange, err error) {
+	if s == "*" {
+		return portRange{0, 65535}, nil
+	}
+
+	from, to, found := strings.Cut(s, "-")
+	if !found {
+		to = from
+	}
+
+	if r.from, err = strconv.Atoi(strings.TrimSpace(from)); err != nil {
+		return r, fmt.Errorf("invalid port range %q: %w", s, err)
+	}
+
+	if r.to, err = strconv.Atoi(strings.TrimSpace(to)); err != nil {
+		return r, fmt.Errorf("invalid port range %q: %w", s, err)
+	}
+
+	return r, nil
+}
+
+// coveredBy returns true, if every port of the range is contained in one of the given ranges
+func (r portRange) coveredBy(ranges []portRange) bool {
+	next := r.from
+
+	// Sort a copy, so that the ranges can be walked from the lowest port upwards
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b portRange) int { return cmp.Compare(a.from, b.from) })
+
+	for _, o := range sorted {
+		if o.from > next {
+			break
+		}
+
+		next = max(next, o.to+1)
+		if next > r.to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// firewallDescription returns a description of the Azure Firewall containing its SKU tier
+func firewallDescription(fw *armnetwork.AzureFirewall) string {
+	if fw.Properties == nil || fw.Properties.SKU == nil || fw.Properties.SKU.Tier == nil {
+		return "Azure Firewall"
+	}
+
+	return fmt.Sprintf("Azure Firewall (%s)", *fw.Properties.SKU.Tier)
+}
+
+// firewallParentID returns the ID of the subnet the Azure Firewall is deployed to. If it is not known, the resource
+// group is returned instead.
+func firewallParentID(fw *armnetwork.AzureFirewall) *string {
+	if fw.Properties != nil {
+		for _, ipc := range fw.Properties.IPConfigurations {
+			if ipc != nil && ipc.Properties != nil && ipc.Properties.Subnet != nil && ipc.Properties.Subnet.ID != nil {
+				return resourceIDPointer(ipc.Properties.Subnet.ID)
+			}
+		}
+	}
+
+	return resourceGroupID(fw.ID)
+}
+
+// firewallHasPublicIPAddress returns true, if a public IP address is assigned to the Azure Firewall
+func firewallHasPublicIPAddress(fw *armnetwork.AzureFirewall) bool {
+	if fw.Properties == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(fw.Properties.IPConfigurations, func(ipc *armnetwork.AzureFirewallIPConfiguration) bool {
+		return ipc != nil && ipc.Properties != nil && ipc.Properties.PublicIPAddress != nil
+	})
+}
@@ -26,6 +26,8 @@
 package azure
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/ontology"
@@ -69,7 +71,7 @@ func Test_azureNetworkDiscovery_discoverNetworkInterfaces(t *testing.T) {
 					GeoLocation: &ontology.GeoLocation{
 						Region: "eastus",
 					},
-					Labels:   map[string]string{},
+					Labels:   map[string]string{LabelNetworkSecurityGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1"},
 					ParentId: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1"),
 					Raw:      "{\"*armnetwork.Interface\":[{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkInterfaces/iface1\",\"location\":\"eastus\",\"name\":\"iface1\",\"properties\":{\"networkSecurityGroup\":{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1\",\"location\":\"eastus\"}}}]}",
 					AccessRestriction: &ontology.AccessRestriction{
@@ -95,7 +97,7 @@ func Test_azureNetworkDiscovery_discoverNetworkInterfaces(t *testing.T) {
 					GeoLocation: &ontology.GeoLocation{
 						Region: "eastus",
 					},
-					Labels:   map[string]string{},
+					Labels:   map[string]string{LabelNetworkSecurityGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1"},
 					ParentId: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1"),
 					Raw:      "{\"*armnetwork.Interface\":[{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkInterfaces/iface1\",\"location\":\"eastus\",\"name\":\"iface1\",\"properties\":{\"networkSecurityGroup\":{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1\",\"location\":\"eastus\"}}}]}",
 					AccessRestriction: &ontology.AccessRestriction{
@@ -703,7 +705,7 @@ func Test_azureDiscovery_handleNetworkInterfaces(t *testing.T) {
 				GeoLocation: &ontology.GeoLocation{
 					Region: "eastus",
 				},
-				Labels:   map[string]string{},
+				Labels:   map[string]string{LabelNetworkSecurityGroupID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1"},
 				Raw:      "{\"*armnetwork.Interface\":[{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkInterfaces/iface1\",\"location\":\"eastus\",\"name\":\"iface1\",\"properties\":{\"networkSecurityGroup\":{\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1\",\"location\":\"eastus\"}}}]}",
 				ParentId: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1"),
 				AccessRestriction: &ontology.AccessRestriction{
@@ -725,3 +727,219 @@ func Test_azureDiscovery_handleNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_azureDiscovery_discoverVirtualNetworks(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverVirtualNetworks()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+
+	vnetID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/virtualnetworks/vnet1"
+
+	vnet := assert.Is[*ontology.VirtualNetwork](t, got[0])
+	assert.Equal(t, vnetID, vnet.Id)
+	assert.Equal(t, "vnet1", vnet.Name)
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1", util.Deref(vnet.ParentId))
+	// The DDoS protection plan is part of the raw evidence
+	assert.Contains(t, vnet.Raw, "ddos1")
+
+	subnet := assert.Is[*ontology.VirtualSubNetwork](t, got[1])
+	assert.Equal(t, vnetID+"/subnets/subnet1", subnet.Id)
+	assert.Equal(t, vnetID, util.Deref(subnet.ParentId))
+	assert.Equal(t, "eastus", subnet.GeoLocation.Region)
+
+	// Without permission to read DDoS protection plans, the virtual networks are still discovered
+	d = NewMockAzureDiscovery(mockSenderWithoutDDoSProtectionPlans{})
+
+	got, err = d.discoverVirtualNetworks()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+	assert.False(t, strings.Contains(assert.Is[*ontology.VirtualNetwork](t, got[0]).Raw, `"name":"ddos1"`))
+}
+
+// mockSenderWithoutDDoSProtectionPlans is a [mockSender] that denies access to the DDoS protection plans
+type mockSenderWithoutDDoSProtectionPlans struct {
+	mockSender
+}
+
+func (m mockSenderWithoutDDoSProtectionPlans) Do(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Path, "/providers/Microsoft.Network/ddosProtectionPlans") {
+		return createResponse(req, map[string]interface{}{
+			"error": map[string]interface{}{
+				"code":    "AuthorizationFailed",
+				"message": "The client does not have authorization to perform action 'Microsoft.Network/ddosProtectionPlans/read'",
+			},
+		}, http.StatusForbidden)
+	}
+
+	return m.mockSender.Do(req)
+}
+
+func Test_azureDiscovery_discoverNetworkSecurityGroups(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverNetworkSecurityGroups()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	nsg := assert.Is[*ontology.NetworkSecurityGroup](t, got[0])
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/networksecuritygroups/nsg1", nsg.Id)
+	assert.Equal(t, "nsg1", nsg.Name)
+	// HTTPS is allowed from the internet, only SSH is denied
+	assert.True(t, nsg.InternetAccessibleEndpoint)
+}
+
+func Test_azureDiscovery_discoverFirewalls(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	got, err := d.discoverFirewalls()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	fw := assert.Is[*ontology.NetworkSecurityGroup](t, got[0])
+	assert.Equal(t, "fw1", fw.Name)
+	assert.Equal(t, "Azure Firewall", fw.Description)
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1/providers/microsoft.network/virtualnetworks/vnet1/subnets/azurefirewallsubnet", fw.GetParentId())
+	assert.True(t, fw.InternetAccessibleEndpoint)
+	assert.Contains(t, fw.Raw, "10.0.2.4")
+}
+
+func Test_nsgAllowsInboundInternet(t *testing.T) {
+	rule := func(priority int32, access armnetwork.SecurityRuleAccess, source string, port string) *armnetwork.SecurityRule {
+		return &armnetwork.SecurityRule{
+			Properties: &armnetwork.SecurityRulePropertiesFormat{
+				Access:               util.Ref(access),
+				Direction:            util.Ref(armnetwork.SecurityRuleDirectionInbound),
+				Priority:             util.Ref(priority),
+				SourceAddressPrefix:  util.Ref(source),
+				DestinationPortRange: util.Ref(port),
+			},
+		}
+	}
+	rangesRule := func(priority int32, access armnetwork.SecurityRuleAccess, ports ...string) *armnetwork.SecurityRule {
+		r := rule(priority, access, "Internet", "")
+		r.Properties.DestinationPortRange = nil
+		for _, p := range ports {
+			r.Properties.DestinationPortRanges = append(r.Properties.DestinationPortRanges, util.Ref(p))
+		}
+
+		return r
+	}
+	defaultRules := []*armnetwork.SecurityRule{
+		rule(65000, armnetwork.SecurityRuleAccessAllow, "VirtualNetwork", "*"),
+		rule(65500, armnetwork.SecurityRuleAccessDeny, "*", "*"),
+	}
+
+	tests := []struct {
+		name  string
+		rules []*armnetwork.SecurityRule
+		want  bool
+	}{
+		{
+			name: "default rules only",
+			want: false,
+		},
+		{
+			name:  "allow from internet",
+			rules: []*armnetwork.SecurityRule{rule(100, armnetwork.SecurityRuleAccessAllow, "Internet", "443")},
+			want:  true,
+		},
+		{
+			name: "deny all before allow",
+			rules: []*armnetwork.SecurityRule{
+				rule(200, armnetwork.SecurityRuleAccessAllow, "0.0.0.0/0", "443"),
+				rule(100, armnetwork.SecurityRuleAccessDeny, "*", "*"),
+			},
+			want: false,
+		},
+		{
+			name:  "allow from private network",
+			rules: []*armnetwork.SecurityRule{rule(100, armnetwork.SecurityRuleAccessAllow, "10.0.0.0/8", "*")},
+			want:  false,
+		},
+		{
+			name: "deny port ranges before allow",
+			rules: []*armnetwork.SecurityRule{
+				rangesRule(100, armnetwork.SecurityRuleAccessDeny, "0-442", "444-65535"),
+				rangesRule(110, armnetwork.SecurityRuleAccessDeny, "443"),
+				rule(200, armnetwork.SecurityRuleAccessAllow, "Internet", "*"),
+			},
+			want: false,
+		},
+		{
+			name: "deny port ranges with gap before allow",
+			rules: []*armnetwork.SecurityRule{
+				rangesRule(100, armnetwork.SecurityRuleAccessDeny, "22", "3389"),
+				rangesRule(200, armnetwork.SecurityRuleAccessAllow, "22", "80-443"),
+			},
+			want: true,
+		},
+		{
+			name: "deny other port before allow",
+			rules: []*armnetwork.SecurityRule{
+				rule(100, armnetwork.SecurityRuleAccessDeny, "*", "22"),
+				rule(200, armnetwork.SecurityRuleAccessAllow, "Internet", "22"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsg := &armnetwork.SecurityGroup{
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules:        tt.rules,
+					DefaultSecurityRules: defaultRules,
+				},
+			}
+
+			assert.Equal(t, tt.want, nsgAllowsInboundInternet(nsg))
+		})
+	}
+}
+
+func Test_parsePortRange(t *testing.T) {
+	r, err := parsePortRange("*")
+	assert.NoError(t, err)
+	assert.Equal(t, portRange{0, 65535}, r, assert.CompareAllUnexported())
+
+	r, err = parsePortRange("8000-8080")
+	assert.NoError(t, err)
+	assert.Equal(t, portRange{8000, 8080}, r, assert.CompareAllUnexported())
+
+	_, err = parsePortRange("http")
+	assert.ErrorContains(t, err, "invalid port range")
+}
+
+func Test_networkSecurityGroupLabel(t *testing.T) {
+	nsgID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+
+	assert.Equal(t, map[string]string{
+		"env":                       "prod",
+		LabelNetworkSecurityGroupID: resourceID(&nsgID),
+	}, networkSecurityGroupLabel(map[string]string{"env": "prod"}, &armnetwork.SecurityGroup{ID: &nsgID}))
+	assert.Equal(t, map[string]string{}, networkSecurityGroupLabel(map[string]string{}, nil))
+}
+
+func Test_networkInterfaceParentID(t *testing.T) {
+	subnetID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1"
+
+	ni := &armnetwork.Interface{
+		ID: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1/providers/Microsoft.Network/networkInterfaces/iface1"),
+		Properties: &armnetwork.InterfacePropertiesFormat{
+			IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+				{
+					Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+						Primary: util.Ref(true),
+						Subnet:  &armnetwork.Subnet{ID: util.Ref(subnetID)},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, strings.ToLower(subnetID), util.Deref(networkInterfaceParentID(ni)))
+
+	// Without IP configurations, the network interface belongs to its resource group
+	ni.Properties = nil
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/res1", util.Deref(networkInterfaceParentID(ni)))
+}
@@ -60,7 +60,8 @@ func (d *azureDiscovery) handleCosmosDB(account *armcosmos.DatabaseAccountGetRes
 					Enabled: true,
 					// Algorithm: algorithm, //TODO(anatheka): How do we get the algorithm? Are we available to do it by
 					// the related resources?
-					KeyUrl: util.Deref(account.Properties.KeyVaultKeyURI),
+					// The key URL matches the LabelKeyURI label of the discovered key
+					KeyUrl: keyURI(util.Deref(account.Properties.KeyVaultKeyURI)),
 				},
 			},
 		}
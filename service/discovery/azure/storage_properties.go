@@ -56,13 +56,15 @@ func storageAtRestEncryption(account *armstorage.Account) (enc *ontology.AtRestE
 			},
 		}
 	} else if util.Deref(account.Properties.Encryption.KeySource) == armstorage.KeySourceMicrosoftKeyvault {
+		var props = util.Deref(account.Properties.Encryption.KeyVaultProperties)
+
 		enc = &ontology.AtRestEncryption{
 			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
 				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 					Algorithm: "", // TODO(all): TBD
 					Enabled:   true,
-					// TODO(oxisto): This should also include the key!
-					KeyUrl: util.Deref(account.Properties.Encryption.KeyVaultProperties.KeyVaultURI),
+					// The key URL matches the LabelKeyURI label of the discovered key
+					KeyUrl: vaultKeyURI(util.Deref(props.KeyVaultURI), util.Deref(props.KeyName)),
 				},
 			},
 		}
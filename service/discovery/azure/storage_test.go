@@ -177,6 +177,31 @@ func Test_storageAtRestEncryption(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Customer key",
+			args: args{
+				account: &armstorage.Account{
+					Properties: &armstorage.AccountProperties{
+						Encryption: &armstorage.Encryption{
+							KeySource: util.Ref(armstorage.KeySourceMicrosoftKeyvault),
+							KeyVaultProperties: &armstorage.KeyVaultProperties{
+								KeyVaultURI: util.Ref("https://keyvault1.vault.azure.net/"),
+								KeyName:     util.Ref("customer-key"),
+							},
+						},
+					},
+				},
+			},
+			want: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+					CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+						Enabled: true,
+						KeyUrl:  "https://keyvault1.vault.azure.net/keys/customer-key",
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1162,7 +1187,7 @@ func Test_azureStorageDiscovery_discoverCosmosDB(t *testing.T) {
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 								Enabled:   true,
 								Algorithm: "",
-								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey/123456",
+								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey",
 							},
 						},
 					},
@@ -1184,7 +1209,7 @@ func Test_azureStorageDiscovery_discoverCosmosDB(t *testing.T) {
 							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
 								Enabled:   true,
 								Algorithm: "",
-								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey/123456",
+								KeyUrl:    "https://testvault.vault.azure.net/keys/testkey",
 							},
 						},
 					},
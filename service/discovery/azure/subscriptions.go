@@ -0,0 +1,238 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/managementgroups/armmanagementgroups"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// LabelSubscriptionID is the label that contains the ID of the subscription a resource was discovered in.
+	LabelSubscriptionID = "azure:subscription-id"
+
+	// MaxParallelSubscriptions is the maximum number of subscriptions that are discovered in parallel.
+	MaxParallelSubscriptions = 8
+)
+
+// selectSubscriptions returns the subscriptions that should be discovered out of all subscriptions the credential
+// has access to. These are either the subscriptions below the configured management group, the configured
+// subscriptions or, by default, the first subscription.
+func (d *azureDiscovery) selectSubscriptions(subs []*armsubscription.Subscription) ([]*armsubscription.Subscription, error) {
+	var ids []string
+
+	switch {
+	case d.managementGroup != nil:
+		var err error
+
+		ids, err = d.discoverManagementGroupSubscriptions(*d.managementGroup)
+		if err != nil {
+			return nil, err
+		}
+	case slices.Contains(d.subIDs, AllSubscriptions):
+		return subs, nil
+	case len(d.subIDs) > 0:
+		ids = d.subIDs
+	default:
+		return subs[:min(len(subs), 1)], nil
+	}
+
+	var selected []*armsubscription.Subscription
+	for _, sub := range subs {
+		if slices.ContainsFunc(ids, func(id string) bool {
+			return strings.EqualFold(id, util.Deref(sub.SubscriptionID))
+		}) {
+			selected = append(selected, sub)
+		}
+	}
+
+	if len(selected) < len(ids) {
+		log.Warnf("Only %d of %d configured subscriptions are accessible", len(selected), len(ids))
+	}
+
+	return selected, nil
+}
+
+// discoverManagementGroupSubscriptions returns the IDs of all subscriptions below the given management group,
+// including those of nested management groups
+func (d *azureDiscovery) discoverManagementGroupSubscriptions(groupID string) (ids []string, err error) {
+	// initialize management groups client
+	if err = d.initManagementGroupsClient(); err != nil {
+		return nil, err
+	}
+
+	pager := d.clients.managementGroupsClient.NewGetDescendantsPager(groupID, &armmanagementgroups.ClientGetDescendantsOptions{})
+	err = allPages(pager, func(page armmanagementgroups.ClientGetDescendantsResponse) error {
+		for _, desc := range page.Value {
+			if strings.HasSuffix(strings.ToLower(util.Deref(desc.Type)), "/subscriptions") {
+				ids = append(ids, util.Deref(desc.Name))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// discoverSubscriptions discovers all selected subscriptions. If there is more than one, each subscription is
+// discovered concurrently by its own copy of the discoverer and its resources are labelled with the subscription ID.
+// The identities and the role assignments inherited from management groups belong to the tenant, so they are discovered
+// once and are not labelled. Subscriptions that cannot be discovered are logged and skipped, an error is only returned
+// if all of them fail.
+func (d *azureDiscovery) discoverSubscriptions() (list []ontology.IsResource, err error) {
+	// In single-subscription mode, the resources are not labelled, since they all belong to the same subscription
+	if len(d.subs) <= 1 {
+		return d.discoverSubscription()
+	}
+
+	var (
+		g       errgroup.Group
+		results = make([][]ontology.IsResource, len(d.subs))
+		errs    = make([]error, len(d.subs))
+		tenants = make([]*tenantResources, len(d.subs))
+		subList []ontology.IsResource
+		ok      []*tenantResources
+	)
+
+	g.SetLimit(MaxParallelSubscriptions)
+
+	for i, sub := range d.subs {
+		tenants[i] = &tenantResources{}
+
+		g.Go(func() error {
+			sd := d.forSubscription(sub)
+			sd.tenant = tenants[i]
+
+			results[i], errs[i] = sd.discoverSubscription()
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	for i, sub := range d.subs {
+		if errs[i] != nil {
+			log.Errorf("Could not discover subscription %s: %v", util.Deref(sub.SubscriptionID), errs[i])
+			continue
+		}
+
+		for _, r := range results[i] {
+			ontology.SetLabel(r, LabelSubscriptionID, util.Deref(sub.SubscriptionID))
+			subList = append(subList, r)
+		}
+
+		ok = append(ok, tenants[i])
+	}
+
+	if len(ok) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// Add the resources of the tenant first, so that the identities exist before the role assignments that refer to
+	// them
+	list = append(d.discoverTenant(ok), subList...)
+
+	return list, nil
+}
+
+// tenantResources contains the role assignments and role definitions of a subscription, which are needed to discover
+// the resources of the tenant
+type tenantResources struct {
+	assignments []*armauthorization.RoleAssignment
+	definitions map[string]*armauthorization.RoleDefinition
+}
+
+// discoverTenant discovers the resources that are shared by all subscriptions of the tenant, i.e., the identities the
+// role assignments of the subscriptions refer to and the role assignments inherited from management groups. An identity
+// is privileged, if it has a privileged role in any of the subscriptions.
+func (d *azureDiscovery) discoverTenant(tenants []*tenantResources) (list []ontology.IsResource) {
+	var (
+		assignments []*armauthorization.RoleAssignment
+		definitions = make(map[string]*armauthorization.RoleDefinition)
+		seen        = make(map[string]bool)
+	)
+
+	for _, t := range tenants {
+		maps.Copy(definitions, t.definitions)
+
+		for _, ra := range t.assignments {
+			id := resourceID(ra.ID)
+			if seen[id] {
+				continue
+			}
+
+			seen[id] = true
+			assignments = append(assignments, ra)
+		}
+	}
+
+	list = append(list, d.discoverIdentities(assignments, definitions)...)
+
+	for _, ra := range assignments {
+		if !isInheritedRoleAssignment(ra) {
+			continue
+		}
+
+		r := d.handleRoleAssignment(ra, definitions[roleDefinitionName(ra)])
+
+		log.Infof("Adding role assignment '%s'", r.GetName())
+
+		list = append(list, r)
+	}
+
+	return list
+}
+
+// forSubscription returns a copy of the discoverer, that discovers the given subscription. Since the Azure SDK
+// clients are bound to a subscription, the copy creates its own clients.
+func (d *azureDiscovery) forSubscription(sub *armsubscription.Subscription) *azureDiscovery {
+	return &azureDiscovery{
+		isAuthorized:        true,
+		sub:                 sub,
+		subs:                []*armsubscription.Subscription{sub},
+		cred:                d.cred,
+		rg:                  d.rg,
+		clientOptions:       d.clientOptions,
+		discovererComponent: d.discovererComponent,
+		ctID:                d.ctID,
+		backupMap:           make(map[string]*backup),
+		defenderProperties:  make(map[string]*defenderProperties),
+	}
+}
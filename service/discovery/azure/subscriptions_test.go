@@ -0,0 +1,218 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package azure
+
+import (
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/subscription/armsubscription"
+)
+
+var (
+	mockSubscription1 = &armsubscription.Subscription{
+		ID:             util.Ref(testdata.MockSubscriptionResourceID),
+		SubscriptionID: util.Ref(testdata.MockSubscriptionID),
+	}
+	mockSubscription2 = &armsubscription.Subscription{
+		ID:             util.Ref("/subscriptions/11111111-1111-1111-1111-111111111111"),
+		SubscriptionID: util.Ref("11111111-1111-1111-1111-111111111111"),
+	}
+)
+
+func Test_azureDiscovery_selectSubscriptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []DiscoveryOption
+		want    []*armsubscription.Subscription
+		wantErr assert.WantErr
+	}{
+		{
+			name:    "default",
+			want:    []*armsubscription.Subscription{mockSubscription1},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "all subscriptions",
+			opts:    []DiscoveryOption{WithSubscriptions([]string{AllSubscriptions})},
+			want:    []*armsubscription.Subscription{mockSubscription1, mockSubscription2},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "configured subscriptions",
+			opts:    []DiscoveryOption{WithSubscriptions([]string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"})},
+			want:    []*armsubscription.Subscription{mockSubscription2},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:    "management group",
+			opts:    []DiscoveryOption{WithManagementGroup("mg1")},
+			want:    []*armsubscription.Subscription{mockSubscription1},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "unknown management group",
+			opts: []DiscoveryOption{WithManagementGroup("unknown")},
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "404")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewMockAzureDiscovery(newMockSender(), tt.opts...)
+
+			got, err := d.selectSubscriptions([]*armsubscription.Subscription{mockSubscription1, mockSubscription2})
+
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_azureDiscovery_discoverSubscriptions(t *testing.T) {
+	d := NewMockAzureDiscovery(newMockSender())
+
+	// In single-subscription mode, the resources are not labelled
+	d.subs = []*armsubscription.Subscription{mockSubscription1}
+
+	got, err := d.discoverSubscriptions()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, got)
+
+	for _, r := range got {
+		if l, ok := r.(interface{ GetLabels() map[string]string }); ok {
+			assert.Empty(t, l.GetLabels()[LabelSubscriptionID])
+		}
+	}
+
+	d.subs = []*armsubscription.Subscription{mockSubscription1, mockSubscription2}
+
+	// The second subscription cannot be discovered, since the mock sender does not know it
+	got, err = d.discoverSubscriptions()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, got)
+
+	for _, r := range got {
+		l, ok := r.(interface{ GetLabels() map[string]string })
+		if !ok {
+			continue
+		}
+
+		// The identities and their credentials belong to the tenant rather than the subscription
+		if strings.HasPrefix(r.GetId(), "/providers/microsoft.graph/") {
+			assert.Empty(t, l.GetLabels()[LabelSubscriptionID])
+		} else {
+			assert.Equal(t, testdata.MockSubscriptionID, l.GetLabels()[LabelSubscriptionID])
+		}
+	}
+
+	// If all subscriptions fail, an error is returned
+	d.subs = []*armsubscription.Subscription{mockSubscription2}
+	d.sub = mockSubscription2
+
+	got, err = d.discoverSubscriptions()
+	assert.Error(t, err)
+	assert.Empty(t, got)
+}
+
+func Test_azureDiscovery_discoverTenant(t *testing.T) {
+	var (
+		d      = NewMockAzureDiscovery(newMockSender())
+		owner  = "8e3af657-a8ff-443c-a75c-2fe8c4bcb635"
+		reader = "acdd72a7-3385-48ef-bd42-f606fba81ae7"
+		defs   = map[string]*armauthorization.RoleDefinition{
+			owner: {Properties: &armauthorization.RoleDefinitionProperties{
+				RoleName:    util.Ref("Owner"),
+				Permissions: []*armauthorization.Permission{{Actions: []*string{util.Ref("*")}}},
+			}},
+			reader: {Properties: &armauthorization.RoleDefinitionProperties{
+				RoleName:    util.Ref("Reader"),
+				Permissions: []*armauthorization.Permission{{Actions: []*string{util.Ref("*/read")}}},
+			}},
+		}
+		assignment = func(id string, def string, principal string, scope string) *armauthorization.RoleAssignment {
+			return &armauthorization.RoleAssignment{
+				ID:   util.Ref(id),
+				Name: util.Ref(id[strings.LastIndex(id, "/")+1:]),
+				Properties: &armauthorization.RoleAssignmentProperties{
+					RoleDefinitionID: util.Ref("/providers/Microsoft.Authorization/roleDefinitions/" + def),
+					PrincipalID:      util.Ref(principal),
+					PrincipalType:    util.Ref(armauthorization.PrincipalTypeServicePrincipal),
+					Scope:            util.Ref(scope),
+				},
+			}
+		}
+		// The role assignment of the management group is inherited by both subscriptions
+		inherited = assignment("/providers/Microsoft.Management/managementGroups/mg1/providers/Microsoft.Authorization/roleAssignments/ra3", reader, "sp1", "/providers/Microsoft.Management/managementGroups/mg1")
+	)
+
+	got := d.discoverTenant([]*tenantResources{
+		{
+			assignments: []*armauthorization.RoleAssignment{
+				assignment(util.Deref(mockSubscription1.ID)+"/providers/Microsoft.Authorization/roleAssignments/ra1", reader, "user1", util.Deref(mockSubscription1.ID)),
+				inherited,
+			},
+			definitions: defs,
+		},
+		{
+			assignments: []*armauthorization.RoleAssignment{
+				assignment(util.Deref(mockSubscription2.ID)+"/providers/Microsoft.Authorization/roleAssignments/ra2", owner, "user1", util.Deref(mockSubscription2.ID)),
+				inherited,
+			},
+			definitions: defs,
+		},
+	})
+
+	// Each identity is only discovered once and is privileged, if it is privileged in any subscription
+	assert.Equal(t, []string{
+		"/providers/microsoft.graph/users/user1",
+		"/providers/microsoft.graph/serviceprincipals/sp1",
+		"/providers/microsoft.graph/serviceprincipals/sp1/password-credentials/secret1",
+		"/providers/microsoft.management/managementgroups/mg1/providers/microsoft.authorization/roleassignments/ra3",
+	}, ontology.ResourceIDs(got))
+	assert.True(t, assert.Is[*ontology.Identity](t, got[0]).Privileged)
+	assert.False(t, assert.Is[*ontology.Identity](t, got[1]).Privileged)
+}
+
+func Test_isInheritedRoleAssignment(t *testing.T) {
+	assert.False(t, isInheritedRoleAssignment(&armauthorization.RoleAssignment{}))
+	assert.False(t, isInheritedRoleAssignment(&armauthorization.RoleAssignment{
+		Properties: &armauthorization.RoleAssignmentProperties{Scope: util.Ref("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/res1")},
+	}))
+	assert.True(t, isInheritedRoleAssignment(&armauthorization.RoleAssignment{
+		Properties: &armauthorization.RoleAssignmentProperties{Scope: util.Ref("/providers/Microsoft.Management/managementGroups/mg1")},
+	}))
+	assert.True(t, isInheritedRoleAssignment(&armauthorization.RoleAssignment{
+		Properties: &armauthorization.RoleAssignmentProperties{Scope: util.Ref("/")},
+	}))
+}
@@ -29,6 +29,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"slices"
 	"time"
 
@@ -44,8 +45,13 @@ import (
 	"clouditor.io/clouditor/v2/service/discovery/aws"
 	"clouditor.io/clouditor/v2/service/discovery/azure"
 	"clouditor.io/clouditor/v2/service/discovery/extra/csaf"
+	"clouditor.io/clouditor/v2/service/discovery/file"
+	"clouditor.io/clouditor/v2/service/discovery/gcp"
+	"clouditor.io/clouditor/v2/service/discovery/git"
 	"clouditor.io/clouditor/v2/service/discovery/k8s"
 	"clouditor.io/clouditor/v2/service/discovery/openstack"
+	"clouditor.io/clouditor/v2/service/discovery/terraform"
+	"clouditor.io/clouditor/v2/service/discovery/vsphere"
 
 	"github.com/go-co-op/gocron"
 	"github.com/google/uuid"
@@ -59,11 +65,17 @@ import (
 )
 
 const (
-	ProviderAWS       = "aws"
-	ProviderK8S       = "k8s"
-	ProviderAzure     = "azure"
-	ProviderOpenstack = "openstack"
-	ProviderCSAF      = "csaf"
+	ProviderAWS          = "aws"
+	ProviderK8S          = "k8s"
+	ProviderAzure        = "azure"
+	ProviderOpenstack    = "openstack"
+	ProviderCSAF         = "csaf"
+	ProviderGCP          = "gcp"
+	ProviderVSphere      = "vsphere"
+	ProviderFile         = "file"
+	ProviderTerraform    = "terraform"
+	ProviderK8SManifests = "k8s-manifests"
+	ProviderGit          = "git"
 
 	// DiscovererStart is emitted at the start of a discovery run.
 	DiscovererStart DiscoveryEventType = iota
@@ -93,9 +105,107 @@ func DefaultServiceSpec() launcher.ServiceSpec {
 		WithTargetOfEvaluationID(viper.GetString(config.TargetOfEvaluationIDFlag)),
 		WithProviders(providers),
 		WithEvidenceStoreAddress(viper.GetString(config.EvidenceStoreURLFlag)),
+		WithAWSClientOptions(awsClientOptions()...),
+		WithAzureDiscoveryOptions(azureDiscoveryOptions()...),
+		WithKubernetesContexts(viper.GetStringSlice(config.DiscoveryK8sContextsFlag)),
+		WithKubernetesDiscoveryOptions(k8sDiscoveryOptions()...),
+		WithKubernetesManifestsPath(viper.GetString(config.DiscoveryK8sManifestsPathFlag)),
+		WithGCPDiscoveryOptions(gcpDiscoveryOptions()...),
+		WithFileDiscoveryOptions(fileDiscoveryOptions()...),
+		WithTerraformDiscoveryOptions(terraformDiscoveryOptions()...),
+		WithGitDiscoveryOptions(gitDiscoveryOptions()...),
 	)
 }
 
+// awsClientOptions returns the [aws.ClientOption]s that configure the AWS accounts and regions to discover, retrieved
+// from the config system.
+func awsClientOptions() (opts []aws.ClientOption) {
+	if accounts := viper.GetStringSlice(config.DiscoveryAWSAccountsFlag); len(accounts) > 0 {
+		opts = append(opts, aws.WithAccounts(accounts))
+	}
+	if viper.GetBool(config.DiscoveryAWSOrganizationFlag) {
+		opts = append(opts, aws.WithOrganization())
+	}
+	if roleName := viper.GetString(config.DiscoveryAWSRoleNameFlag); roleName != "" {
+		opts = append(opts, aws.WithRoleName(roleName))
+	}
+	if regions := viper.GetStringSlice(config.DiscoveryAWSRegionsFlag); len(regions) > 0 {
+		opts = append(opts, aws.WithRegions(regions))
+	}
+
+	return
+}
+
+// azureDiscoveryOptions returns the [azure.DiscoveryOption]s that configure the Azure subscriptions to discover,
+// retrieved from the config system.
+func azureDiscoveryOptions() (opts []azure.DiscoveryOption) {
+	if subs := viper.GetStringSlice(config.DiscoveryAzureSubscriptionsFlag); len(subs) > 0 {
+		opts = append(opts, azure.WithSubscriptions(subs))
+	}
+	if group := viper.GetString(config.DiscoveryAzureManagementGroupFlag); group != "" {
+		opts = append(opts, azure.WithManagementGroup(group))
+	}
+
+	return
+}
+
+// k8sDiscoveryOptions returns the [k8s.DiscoveryOption]s that configure the Kubernetes namespaces to discover,
+// retrieved from the config system.
+func k8sDiscoveryOptions() (opts []k8s.DiscoveryOption) {
+	if namespaces := viper.GetStringSlice(config.DiscoveryK8sNamespacesFlag); len(namespaces) > 0 {
+		opts = append(opts, k8s.WithNamespaces(namespaces))
+	}
+	if namespaces := viper.GetStringSlice(config.DiscoveryK8sExcludedNamespacesFlag); len(namespaces) > 0 {
+		opts = append(opts, k8s.WithExcludedNamespaces(namespaces))
+	}
+
+	return
+}
+
+// gcpDiscoveryOptions returns the [gcp.DiscoveryOption]s that configure the GCP projects to discover, retrieved from
+// the config system.
+func gcpDiscoveryOptions() (opts []gcp.DiscoveryOption) {
+	if projects := viper.GetStringSlice(config.DiscoveryGCPProjectsFlag); len(projects) > 0 {
+		opts = append(opts, gcp.WithProjects(projects))
+	}
+
+	return
+}
+
+// fileDiscoveryOptions returns the [file.DiscoveryOption]s that configure the directory of the resource files, retrieved
+// from the config system.
+func fileDiscoveryOptions() (opts []file.DiscoveryOption) {
+	if path := viper.GetString(config.DiscoveryFilePathFlag); path != "" {
+		opts = append(opts, file.WithPath(path))
+	}
+
+	return
+}
+
+// terraformDiscoveryOptions returns the [terraform.DiscoveryOption]s that configure the Terraform state and plan files,
+// retrieved from the config system.
+func terraformDiscoveryOptions() (opts []terraform.DiscoveryOption) {
+	if paths := viper.GetStringSlice(config.DiscoveryTerraformPathsFlag); len(paths) > 0 {
+		opts = append(opts, terraform.WithPaths(paths))
+	}
+
+	return
+}
+
+// gitDiscoveryOptions returns the [git.DiscoveryOption]s that configure the repositories and the trusted keys,
+// retrieved from the config system.
+func gitDiscoveryOptions() (opts []git.DiscoveryOption) {
+	if paths := viper.GetStringSlice(config.DiscoveryGitPathsFlag); len(paths) > 0 {
+		opts = append(opts, git.WithPaths(paths))
+	}
+
+	if keyRing := viper.GetString(config.DiscoveryGitKeyRingFlag); keyRing != "" {
+		opts = append(opts, git.WithKeyRing(keyRing))
+	}
+
+	return
+}
+
 // DiscoveryEventType defines the event types for [DiscoveryEvent].
 type DiscoveryEventType int
 
@@ -124,6 +234,33 @@ type Service struct {
 	providers   []string
 	discoverers []discovery.Discoverer
 
+	// awsOpts configure the AWS accounts and regions that are discovered.
+	awsOpts []aws.ClientOption
+
+	// azureOpts configure the Azure subscriptions that are discovered.
+	azureOpts []azure.DiscoveryOption
+
+	// k8sContexts are the kubeconfig contexts that are discovered. If empty, the current context is used.
+	k8sContexts []string
+
+	// k8sOpts configure the Kubernetes namespaces that are discovered.
+	k8sOpts []k8s.DiscoveryOption
+
+	// k8sManifestsPath is the file or directory of the Kubernetes manifests that are discovered.
+	k8sManifestsPath string
+
+	// gcpOpts configure the GCP projects that are discovered.
+	gcpOpts []gcp.DiscoveryOption
+
+	// fileOpts configure the directory of the resource files that are discovered.
+	fileOpts []file.DiscoveryOption
+
+	// terraformOpts configure the Terraform state and plan files that are discovered.
+	terraformOpts []terraform.DiscoveryOption
+
+	// gitOpts configure the git repositories that are discovered.
+	gitOpts []git.DiscoveryOption
+
 	discoveryInterval time.Duration
 
 	Events chan *DiscoveryEvent
@@ -187,6 +324,70 @@ func WithProviders(providersList []string) service.Option[*Service] {
 	}
 }
 
+// WithAzureDiscoveryOptions is an option to configure the subscriptions discovered by the Azure discoverer.
+func WithAzureDiscoveryOptions(opts ...azure.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.azureOpts = append(s.azureOpts, opts...)
+	}
+}
+
+// WithAWSClientOptions is an option to configure the accounts and regions discovered by the AWS discoverers.
+func WithAWSClientOptions(opts ...aws.ClientOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.awsOpts = append(s.awsOpts, opts...)
+	}
+}
+
+// WithKubernetesContexts is an option to configure the kubeconfig contexts, i.e., the clusters, that are discovered.
+func WithKubernetesContexts(contexts []string) service.Option[*Service] {
+	return func(s *Service) {
+		s.k8sContexts = contexts
+	}
+}
+
+// WithKubernetesDiscoveryOptions is an option to configure the Kubernetes discoverers.
+func WithKubernetesDiscoveryOptions(opts ...k8s.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.k8sOpts = append(s.k8sOpts, opts...)
+	}
+}
+
+// WithKubernetesManifestsPath is an option to configure the file or directory of the Kubernetes manifests that are
+// discovered.
+func WithKubernetesManifestsPath(path string) service.Option[*Service] {
+	return func(s *Service) {
+		s.k8sManifestsPath = path
+	}
+}
+
+// WithGCPDiscoveryOptions is an option to configure the GCP projects that are discovered
+func WithGCPDiscoveryOptions(opts ...gcp.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.gcpOpts = append(s.gcpOpts, opts...)
+	}
+}
+
+// WithFileDiscoveryOptions is an option to configure the directory of the resource files that are discovered
+func WithFileDiscoveryOptions(opts ...file.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.fileOpts = append(s.fileOpts, opts...)
+	}
+}
+
+// WithTerraformDiscoveryOptions is an option to configure the Terraform state and plan files that are discovered
+func WithTerraformDiscoveryOptions(opts ...terraform.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.terraformOpts = append(s.terraformOpts, opts...)
+	}
+}
+
+// WithGitDiscoveryOptions is an option to configure the git repositories that are discovered
+func WithGitDiscoveryOptions(opts ...git.DiscoveryOption) service.Option[*Service] {
+	return func(s *Service) {
+		s.gitOpts = append(s.gitOpts, opts...)
+	}
+}
+
 // WithAdditionalDiscoverers is an option to add additional discoverers for discovering. Note: These are added in
 // addition to the ones created by [WithProviders].
 func WithAdditionalDiscoverers(discoverers []discovery.Discoverer) service.Option[*Service] {
@@ -250,6 +451,15 @@ func (svc *Service) Init() {
 func (svc *Service) Shutdown() {
 	svc.evidenceStoreStreams.CloseAll()
 	svc.scheduler.Stop()
+
+	// Some discoverers hold a session, which needs to be closed
+	for _, d := range svc.discoverers {
+		if c, ok := d.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				log.Warnf("Could not close discoverer %s: %v", d.Name(), err)
+			}
+		}
+	}
 }
 
 // initEvidenceStoreStream initializes the stream that is used to send evidences to the evidence store service.
@@ -304,7 +514,8 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 				log.Errorf("Could not authenticate to Azure: %v", err)
 				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to Azure: %v", err)
 			}
-			// Add authorizer and TargetOfEvaluationID
+			// Add configured subscriptions, authorizer and TargetOfEvaluationID
+			optsAzure = append(optsAzure, svc.azureOpts...)
 			optsAzure = append(optsAzure, azure.WithAuthorizer(authorizer), azure.WithTargetOfEvaluationID(svc.ctID))
 			// Check if resource group is given and append to discoverer
 			if req.GetResourceGroup() != "" {
@@ -312,24 +523,56 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 			}
 			svc.discoverers = append(svc.discoverers, azure.NewAzureDiscovery(optsAzure...))
 		case provider == ProviderK8S:
-			k8sClient, err := k8s.AuthFromKubeConfig()
-			if err != nil {
-				log.Errorf("Could not authenticate to Kubernetes: %v", err)
-				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to Kubernetes: %v", err)
+			// Use the current context, if no contexts are configured
+			contexts := svc.k8sContexts
+			if len(contexts) == 0 {
+				contexts = []string{""}
 			}
-			svc.discoverers = append(svc.discoverers,
-				k8s.NewKubernetesComputeDiscovery(k8sClient, svc.ctID),
-				k8s.NewKubernetesNetworkDiscovery(k8sClient, svc.ctID),
-				k8s.NewKubernetesStorageDiscovery(k8sClient, svc.ctID))
+
+			// A context that cannot be used is skipped, so that the other clusters are still discovered
+			var errs []error
+			for _, kubeContext := range contexts {
+				k8sClient, err := k8s.AuthFromKubeConfigContext(kubeContext)
+				if err != nil {
+					log.Errorf("Could not authenticate to Kubernetes context '%s', skipping it: %v", kubeContext, err)
+					errs = append(errs, err)
+					continue
+				}
+
+				metadataClient, err := k8s.MetadataFromKubeConfigContext(kubeContext)
+				if err != nil {
+					log.Errorf("Could not authenticate to Kubernetes context '%s', skipping it: %v", kubeContext, err)
+					errs = append(errs, err)
+					continue
+				}
+
+				// Label the resources with the context, if multiple clusters are discovered
+				optsK8s := append(slices.Clone(svc.k8sOpts), k8s.WithMetadataClient(metadataClient))
+				if kubeContext != "" {
+					optsK8s = append(optsK8s, k8s.WithCluster(kubeContext))
+				}
+
+				svc.discoverers = append(svc.discoverers, k8s.NewKubernetesDiscoverers(k8sClient, svc.ctID, optsK8s...)...)
+			}
+
+			if len(errs) == len(contexts) {
+				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to Kubernetes: %v", errors.Join(errs...))
+			}
+		case provider == ProviderK8SManifests:
+			svc.discoverers = append(svc.discoverers, k8s.NewKubernetesManifestDiscovery(svc.k8sManifestsPath, svc.ctID, svc.k8sOpts...))
 		case provider == ProviderAWS:
-			awsClient, err := aws.NewClient()
+			awsClients, err := aws.NewClients(svc.awsOpts...)
 			if err != nil {
 				log.Errorf("Could not authenticate to AWS: %v", err)
 				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to AWS: %v", err)
 			}
 			svc.discoverers = append(svc.discoverers,
-				aws.NewAwsStorageDiscovery(awsClient, svc.ctID),
-				aws.NewAwsComputeDiscovery(awsClient, svc.ctID))
+				aws.NewAwsMultiDiscovery(awsClients, aws.NewAwsStorageDiscovery, svc.ctID),
+				aws.NewAwsMultiDiscovery(awsClients, aws.NewAwsComputeDiscovery, svc.ctID),
+				aws.NewAwsMultiDiscovery(awsClients, aws.NewAwsDatabaseDiscovery, svc.ctID),
+				aws.NewAwsGlobalMultiDiscovery(awsClients, aws.NewAwsIAMDiscovery, svc.ctID),
+				aws.NewAwsMultiDiscovery(awsClients, aws.NewAwsNetworkDiscovery, svc.ctID),
+				aws.NewAwsMultiDiscovery(awsClients, aws.NewAwsKeyDiscovery, svc.ctID))
 		case provider == ProviderOpenstack:
 			authorizer, err := openstack.NewAuthorizer()
 			if err != nil {
@@ -339,6 +582,42 @@ func (svc *Service) Start(ctx context.Context, req *discovery.StartDiscoveryRequ
 			// Add authorizer and TargetOfEvaluationID
 			optsOpenstack = append(optsOpenstack, openstack.WithAuthorizer(authorizer), openstack.WithTargetOfEvaluationID(svc.ctID))
 			svc.discoverers = append(svc.discoverers, openstack.NewOpenstackDiscovery(optsOpenstack...))
+		case provider == ProviderGCP:
+			authorizer, err := gcp.NewAuthorizer()
+			if err != nil {
+				log.Errorf("Could not authenticate to GCP: %v", err)
+				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to GCP: %v", err)
+			}
+			// Add configured projects, authorizer and TargetOfEvaluationID
+			optsGCP := slices.Clone(svc.gcpOpts)
+			optsGCP = append(optsGCP, gcp.WithAuthorizer(authorizer), gcp.WithTargetOfEvaluationID(svc.ctID))
+			svc.discoverers = append(svc.discoverers, gcp.NewGCPDiscovery(optsGCP...))
+		case provider == ProviderVSphere:
+			u, insecure, err := vsphere.NewAuthorizer()
+			if err != nil {
+				log.Errorf("Could not authenticate to vSphere: %v", err)
+				return nil, status.Errorf(codes.FailedPrecondition, "could not authenticate to vSphere: %v", err)
+			}
+			svc.discoverers = append(svc.discoverers, vsphere.NewVSphereDiscovery(
+				vsphere.WithAuthorizer(u),
+				vsphere.WithInsecure(insecure),
+				vsphere.WithTargetOfEvaluationID(svc.ctID),
+			))
+		case provider == ProviderFile:
+			// Add configured path and TargetOfEvaluationID
+			optsFile := slices.Clone(svc.fileOpts)
+			optsFile = append(optsFile, file.WithTargetOfEvaluationID(svc.ctID))
+			svc.discoverers = append(svc.discoverers, file.NewFileDiscovery(optsFile...))
+		case provider == ProviderTerraform:
+			// Add configured paths and TargetOfEvaluationID
+			optsTerraform := slices.Clone(svc.terraformOpts)
+			optsTerraform = append(optsTerraform, terraform.WithTargetOfEvaluationID(svc.ctID))
+			svc.discoverers = append(svc.discoverers, terraform.NewTerraformDiscovery(optsTerraform...))
+		case provider == ProviderGit:
+			// Add configured repositories and TargetOfEvaluationID
+			optsGit := slices.Clone(svc.gitOpts)
+			optsGit = append(optsGit, git.WithTargetOfEvaluationID(svc.ctID))
+			svc.discoverers = append(svc.discoverers, git.NewGitDiscovery(optsGit...))
 		case provider == ProviderCSAF:
 			var (
 				domain string
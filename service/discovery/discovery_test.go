@@ -29,6 +29,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -336,6 +338,7 @@ func TestService_Start(t *testing.T) {
 		scheduler            *gocron.Scheduler
 		authz                service.AuthorizationStrategy
 		providers            []string
+		k8sContexts          []string
 		discoveryInterval    time.Duration
 		Events               chan *DiscoveryEvent
 		ctID                 string
@@ -345,6 +348,27 @@ func TestService_Start(t *testing.T) {
 		ctx context.Context
 		req *discovery.StartDiscoveryRequest
 	}
+	// Create a kubeconfig with a single valid context
+	kubeHome := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(kubeHome, ".kube"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(kubeHome, ".kube", "config"), []byte(`apiVersion: v1
+kind: Config
+clusters:
+  - name: good
+    cluster:
+      server: https://127.0.0.1:1
+contexts:
+  - name: good
+    context:
+      cluster: good
+      user: good
+users:
+  - name: good
+    user:
+      token: test
+current-context: good
+`), 0600))
+
 	tests := []struct {
 		name    string
 		fields  fields
@@ -458,6 +482,81 @@ func TestService_Start(t *testing.T) {
 				return assert.ErrorContains(t, gotErr, "could not authenticate to Kubernetes")
 			},
 		},
+		{
+			name: "Happy path: K8S skips context that cannot be used",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderK8S},
+				k8sContexts:       []string{"good", "missing"},
+				discoveryInterval: time.Duration(5 * time.Minute),
+				envVariables: []envVariable{
+					{
+						hasEnvVariable:   true,
+						envVariableKey:   "HOME",
+						envVariableValue: kubeHome,
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: func(t *testing.T, got *discovery.StartDiscoveryResponse) bool {
+				return assert.Equal(t, &discovery.StartDiscoveryResponse{Successful: true}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "GCP authorizer error",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderGCP},
+				discoveryInterval: time.Duration(5 * time.Minute),
+				envVariables: []envVariable{
+					// We must set the credentials to a non-existing file so that the GCP authorizer fails
+					{
+						hasEnvVariable:   true,
+						envVariableKey:   "GOOGLE_APPLICATION_CREDENTIALS",
+						envVariableValue: "does-not-exist.json",
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: assert.Nil[*discovery.StartDiscoveryResponse],
+			wantErr: func(t *testing.T, gotErr error) bool {
+				return assert.ErrorContains(t, gotErr, "could not authenticate to GCP")
+			},
+		},
+		{
+			name: "vSphere authorizer error",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderVSphere},
+				discoveryInterval: time.Duration(5 * time.Minute),
+				envVariables: []envVariable{
+					// We must unset the URL so that the vSphere authorizer fails
+					{
+						hasEnvVariable:   true,
+						envVariableKey:   "VSPHERE_URL",
+						envVariableValue: "",
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: assert.Nil[*discovery.StartDiscoveryResponse],
+			wantErr: func(t *testing.T, gotErr error) bool {
+				return assert.ErrorContains(t, gotErr, "could not authenticate to vSphere")
+			},
+		},
 		{
 			name: "Happy path: no discovery interval error",
 			fields: fields{
@@ -581,6 +680,74 @@ func TestService_Start(t *testing.T) {
 			},
 			wantErr: assert.Nil[error],
 		},
+		{
+			name: "Happy path: file",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderFile},
+				discoveryInterval: time.Duration(5 * time.Minute),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: func(t *testing.T, got *discovery.StartDiscoveryResponse) bool {
+				return assert.Equal(t, &discovery.StartDiscoveryResponse{Successful: true}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: terraform",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderTerraform},
+				discoveryInterval: time.Duration(5 * time.Minute),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: func(t *testing.T, got *discovery.StartDiscoveryResponse) bool {
+				return assert.Equal(t, &discovery.StartDiscoveryResponse{Successful: true}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: Kubernetes manifests",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderK8SManifests},
+				discoveryInterval: time.Duration(5 * time.Minute),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: func(t *testing.T, got *discovery.StartDiscoveryResponse) bool {
+				return assert.Equal(t, &discovery.StartDiscoveryResponse{Successful: true}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: git",
+			fields: fields{
+				authz:             servicetest.NewAuthorizationStrategy(true),
+				scheduler:         gocron.NewScheduler(time.UTC),
+				providers:         []string{ProviderGit},
+				discoveryInterval: time.Duration(5 * time.Minute),
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &discovery.StartDiscoveryRequest{},
+			},
+			want: func(t *testing.T, got *discovery.StartDiscoveryResponse) bool {
+				return assert.Equal(t, &discovery.StartDiscoveryResponse{Successful: true}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -590,6 +757,7 @@ func TestService_Start(t *testing.T) {
 				scheduler:            tt.fields.scheduler,
 				authz:                tt.fields.authz,
 				providers:            tt.fields.providers,
+				k8sContexts:          tt.fields.k8sContexts,
 				discoveryInterval:    tt.fields.discoveryInterval,
 				Events:               tt.fields.Events,
 				ctID:                 tt.fields.ctID,
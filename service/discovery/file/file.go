@@ -0,0 +1,232 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package file contains a discoverer that reads ontology resources from a directory of JSON or YAML files. This allows
+// to assess resources of systems that cannot be reached by any other discoverer, e.g., air-gapped networks or
+// hand-maintained inventories.
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	log *logrus.Entry
+
+	ErrMissingPath = errors.New("the path of the resource directory is not set")
+)
+
+type fileDiscovery struct {
+	ctID string
+	// path is the directory that contains the resource files
+	path string
+}
+
+type DiscoveryOption func(d *fileDiscovery)
+
+func WithTargetOfEvaluationID(ctID string) DiscoveryOption {
+	return func(d *fileDiscovery) {
+		d.ctID = ctID
+	}
+}
+
+// WithPath is an option to set the directory that contains the resource files
+func WithPath(path string) DiscoveryOption {
+	return func(d *fileDiscovery) {
+		d.path = path
+	}
+}
+
+func init() {
+	log = logrus.WithField("component", "file-discovery")
+}
+
+func NewFileDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
+	d := &fileDiscovery{
+		ctID: config.DefaultTargetOfEvaluationID,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (*fileDiscovery) Name() string {
+	return "File"
+}
+
+func (*fileDiscovery) Description() string {
+	return "Discovery of ontology resources from JSON and YAML files."
+}
+
+func (d *fileDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// List reads all files with the extension .json, .yaml or .yml in the directory and its subdirectories. The files are
+// re-read on every discovery run, so that changes of the files are picked up without a restart. Hidden files and
+// directories are skipped.
+func (d *fileDiscovery) List() (list []ontology.IsResource, err error) {
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if d.path == "" {
+		return nil, ErrMissingPath
+	}
+
+	err = filepath.WalkDir(d.path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip hidden files and directories, but not the directory itself
+		if path != d.path && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() || !isResourceFile(path) {
+			return nil
+		}
+
+		r, err := readResources(path)
+		if err != nil {
+			return fmt.Errorf("could not read resources of file '%s': %w", path, err)
+		}
+
+		list = append(list, r...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read resource directory: %w", err)
+	}
+
+	return
+}
+
+// isResourceFile returns true, if the file has the extension of a JSON or YAML file
+func isResourceFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// readResources reads the resources of a file. A file either contains a single resource or a list of resources. Each
+// resource uses the JSON representation of [ontology.Resource], i.e., an object with the resource type as its only
+// key, e.g.:
+//
+//	{"virtualMachine": {"id": "vm-1", "name": "vm-1"}}
+//
+// YAML files are converted to JSON first.
+func readResources(path string) (list []ontology.IsResource, err error) {
+	var raws []json.RawMessage
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.ToLower(filepath.Ext(path)) != ".json" {
+		b, err = yaml.YAMLToJSON(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert YAML to JSON: %w", err)
+		}
+	}
+
+	b = bytes.TrimSpace(b)
+
+	// An empty file does not contain any resources
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return nil, nil
+	}
+
+	if b[0] == '[' {
+		err = json.Unmarshal(b, &raws)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse list of resources: %w", err)
+		}
+	} else {
+		raws = []json.RawMessage{b}
+	}
+
+	for i, raw := range raws {
+		r, err := parseResource(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource at index %d: %w", i, err)
+		}
+
+		log.Infof("Adding %s '%s'", ontology.ResourceTypes(r)[0], r.GetName())
+
+		list = append(list, r)
+	}
+
+	return
+}
+
+// parseResource parses the JSON representation of a single [ontology.Resource]. The resource must have an ID, since
+// it is used to identify the resource across discovery runs.
+func parseResource(raw json.RawMessage) (r ontology.IsResource, err error) {
+	var resource ontology.Resource
+
+	err = protojson.Unmarshal(raw, &resource)
+	if err != nil {
+		return nil, err
+	}
+
+	r = ontology.UnwrapResource(&resource)
+	if r == nil {
+		return nil, errors.New("resource type is missing")
+	}
+
+	if r.GetId() == "" {
+		return nil, errors.New("resource ID is missing")
+	}
+
+	return
+}
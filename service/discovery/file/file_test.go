@@ -0,0 +1,260 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestNewFileDiscovery(t *testing.T) {
+	type args struct {
+		opts []DiscoveryOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[discovery.Discoverer]
+	}{
+		{
+			name: "Happy path: default values",
+			args: args{},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*fileDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "File", d.Name())
+				assert.Empty(t, d.path)
+				return assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+			},
+		},
+		{
+			name: "Happy path: with options",
+			args: args{
+				opts: []DiscoveryOption{
+					WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1),
+					WithPath("testdata/resources"),
+				},
+			},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*fileDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "testdata/resources", d.path)
+				return assert.Equal(t, testdata.MockTargetOfEvaluationID1, d.TargetOfEvaluationID())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewFileDiscovery(tt.args.opts...)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_fileDiscovery_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "path not set",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingPath)
+			},
+		},
+		{
+			name:     "path does not exist",
+			path:     "testdata/does-not-exist",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.ErrorIs(t, err, os.ErrNotExist)
+				return assert.ErrorContains(t, err, "could not read resource directory")
+			},
+		},
+		{
+			name: "invalid file",
+			path: writeFiles(t, map[string]string{
+				"vm.json":      `{"virtualMachine": {"id": "vm-1"}}`,
+				"invalid.json": `{"virtualMachine": {"id": "vm-2"`,
+			}),
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not read resources of file")
+			},
+		},
+		{
+			name: "Happy path",
+			path: "testdata/resources",
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// The files are read in lexical order, hidden files and files with other extensions are skipped
+				assert.Equal(t, []string{
+					"inventory/network-1",
+					"inventory/disk-1",
+					"inventory/bucket-1",
+					"inventory/vm-1",
+				}, ontology.ResourceIDs(got))
+
+				vm, ok := got[3].(*ontology.VirtualMachine)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"inventory/disk-1"}, vm.GetBlockStorageIds())
+				assert.True(t, vm.GetBootLogging().GetEnabled())
+
+				disk, ok := got[1].(*ontology.BlockStorage)
+				assert.True(t, ok)
+				assert.Equal(t, constants.AES256, disk.GetAtRestEncryption().GetManagedKeyEncryption().GetAlgorithm())
+
+				network, ok := got[0].(*ontology.VirtualNetwork)
+				assert.True(t, ok)
+				return assert.Equal(t, map[string]string{"zone": "dmz"}, network.GetLabels())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewFileDiscovery(WithPath(tt.path))
+
+			gotList, err := d.List()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_readResources(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "empty file",
+			file:     "resources.yaml",
+			content:  "",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr:  assert.Nil[error],
+		},
+		{
+			name:     "invalid YAML",
+			file:     "resources.yaml",
+			content:  "virtualMachine: [",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not convert YAML to JSON")
+			},
+		},
+		{
+			name:     "invalid list",
+			file:     "resources.json",
+			content:  `[{"virtualMachine": {"id": "vm-1"}}`,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not parse list of resources")
+			},
+		},
+		{
+			name:     "unknown resource type",
+			file:     "resources.json",
+			content:  `{"spaceship": {"id": "enterprise"}}`,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid resource at index 0")
+			},
+		},
+		{
+			name:     "missing resource type",
+			file:     "resources.json",
+			content:  `[{"virtualMachine": {"id": "vm-1"}}, {}]`,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid resource at index 1: resource type is missing")
+			},
+		},
+		{
+			name:     "missing resource ID",
+			file:     "resources.yaml",
+			content:  "virtualMachine:\n  name: vm-1\n",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "resource ID is missing")
+			},
+		},
+		{
+			name:    "Happy path: output of ontology.Resource.MarshalJSON",
+			file:    "resources.json",
+			content: marshalResource(t, &ontology.Container{Id: "container-1", Name: "container-1"}),
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				return assert.Equal(t, []ontology.IsResource{
+					&ontology.Container{Id: "container-1", Name: "container-1"},
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(writeFiles(t, map[string]string{tt.file: tt.content}), tt.file)
+
+			gotList, err := readResources(path)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+// writeFiles writes the files to a temporary directory and returns the path of the directory
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	return dir
+}
+
+// marshalResource returns the JSON representation of the resource as produced by [ontology.Resource.MarshalJSON]
+func marshalResource(t *testing.T, r ontology.IsResource) string {
+	b, err := ontology.ProtoResource(r).MarshalJSON()
+	assert.NoError(t, err)
+
+	return string(b)
+}
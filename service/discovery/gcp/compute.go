@@ -0,0 +1,202 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// MetadataSerialPortLogging is the metadata key that enables the logging of the serial port output (including the boot
+// log) to Cloud Logging.
+const MetadataSerialPortLogging = "serial-port-logging-enable"
+
+// discoverVirtualMachines discovers the Compute Engine VMs of all zones of the project
+func (d *gcpDiscovery) discoverVirtualMachines(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.computeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	err = client.Instances.AggregatedList(projectID).Pages(context.Background(), func(page *compute.InstanceAggregatedList) error {
+		// The items are grouped by zone. We sort the zones to get a stable order of the resources.
+		for _, zone := range slices.Sorted(maps.Keys(page.Items)) {
+			for _, instance := range page.Items[zone].Instances {
+				list = append(list, d.handleInstance(projectID, instance))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list instances: %w", err)
+	}
+
+	return
+}
+
+// handleInstance returns a [ontology.VirtualMachine] out of an existing [compute.Instance]
+func (d *gcpDiscovery) handleInstance(projectID string, instance *compute.Instance) ontology.IsResource {
+	var diskIDs []string
+
+	for _, disk := range instance.Disks {
+		diskIDs = append(diskIDs, disk.Source)
+	}
+
+	r := &ontology.VirtualMachine{
+		Id:                         instance.SelfLink,
+		Name:                       instance.Name,
+		Description:                instance.Description,
+		CreationTime:               creationTime(instance.CreationTimestamp),
+		GeoLocation:                location(regionFromZone(instance.Zone)),
+		Labels:                     instance.Labels,
+		InternetAccessibleEndpoint: hasExternalIP(instance),
+		BlockStorageIds:            diskIDs,
+		BootLogging: &ontology.BootLogging{
+			Enabled: metadataValue(instance.Metadata, MetadataSerialPortLogging) == "true",
+		},
+		ParentId: util.Ref(projectResourceID(projectID)),
+		Raw:      discovery.Raw(instance),
+	}
+
+	log.Infof("Adding virtual machine '%s'", r.Name)
+
+	return r
+}
+
+// discoverDisks discovers the Compute Engine disks of all zones and regions of the project
+func (d *gcpDiscovery) discoverDisks(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.computeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	err = client.Disks.AggregatedList(projectID).Pages(context.Background(), func(page *compute.DiskAggregatedList) error {
+		// The items are grouped by zone or region. We sort them to get a stable order of the resources.
+		for _, scope := range slices.Sorted(maps.Keys(page.Items)) {
+			for _, disk := range page.Items[scope].Disks {
+				list = append(list, d.handleDisk(projectID, disk))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list disks: %w", err)
+	}
+
+	return
+}
+
+// handleDisk returns a [ontology.BlockStorage] out of an existing [compute.Disk]
+func (d *gcpDiscovery) handleDisk(projectID string, disk *compute.Disk) ontology.IsResource {
+	var (
+		region     string
+		kmsKeyName string
+	)
+
+	// Zonal disks have a zone, regional disks are replicated to two zones of the region
+	if disk.Zone != "" {
+		region = regionFromZone(disk.Zone)
+	} else {
+		region = disk.Region
+	}
+
+	if disk.DiskEncryptionKey != nil {
+		kmsKeyName = disk.DiskEncryptionKey.KmsKeyName
+	}
+
+	r := &ontology.BlockStorage{
+		Id:               disk.SelfLink,
+		Name:             disk.Name,
+		Description:      disk.Description,
+		CreationTime:     creationTime(disk.CreationTimestamp),
+		GeoLocation:      location(region),
+		Labels:           disk.Labels,
+		AtRestEncryption: atRestEncryption(kmsKeyName),
+		Redundancies:     diskRedundancies(disk),
+		ParentId:         util.Ref(projectResourceID(projectID)),
+		Raw:              discovery.Raw(disk),
+	}
+
+	log.Infof("Adding block storage '%s'", r.Name)
+
+	return r
+}
+
+// hasExternalIP returns true, if one of the network interfaces of the instance has an external IP address
+func hasExternalIP(instance *compute.Instance) bool {
+	for _, nic := range instance.NetworkInterfaces {
+		for _, ac := range nic.AccessConfigs {
+			if ac.NatIP != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// metadataValue returns the value of the metadata item with the given key. An empty string is returned if the item
+// does not exist.
+func metadataValue(metadata *compute.Metadata, key string) string {
+	if metadata == nil {
+		return ""
+	}
+
+	for _, item := range metadata.Items {
+		if item.Key == key {
+			return util.Deref(item.Value)
+		}
+	}
+
+	return ""
+}
+
+// diskRedundancies returns a zone redundancy for regional disks, which are synchronously replicated to two zones
+func diskRedundancies(disk *compute.Disk) []*ontology.Redundancy {
+	if len(disk.ReplicaZones) < 2 {
+		return nil
+	}
+
+	return []*ontology.Redundancy{
+		{
+			Type: &ontology.Redundancy_ZoneRedundancy{
+				ZoneRedundancy: &ontology.ZoneRedundancy{
+					GeoLocations: []*ontology.GeoLocation{location(disk.Region)},
+				},
+			},
+		},
+	}
+}
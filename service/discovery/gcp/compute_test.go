@@ -0,0 +1,218 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/gcptest"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_gcpDiscovery_discoverVirtualMachines(t *testing.T) {
+	tests := []struct {
+		name     string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "compute client not initialized")
+			},
+		},
+		{
+			name: "error list instances",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleError("GET /projects/{project}/aggregated/instances", 403, "Compute Engine API has not been used in project")
+			},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not list instances", "Compute Engine API has not been used in project")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleInstanceAggregatedListSuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2024-02-01T10:00:00.000-08:00")
+				assert.NoError(t, err)
+
+				want := &ontology.VirtualMachine{
+					Id:           "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/instances/web-1",
+					Name:         "web-1",
+					Description:  "Web server",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "europe-west3",
+					},
+					Labels: map[string]string{
+						"env": "prod",
+					},
+					InternetAccessibleEndpoint: true,
+					BlockStorageIds: []string{
+						"https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/web-1",
+						"https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/data-1",
+					},
+					BootLogging: &ontology.BootLogging{
+						Enabled: true,
+					},
+					ParentId: util.Ref("projects/clouditor-test"),
+				}
+
+				got0 := assert.Is[*ontology.VirtualMachine](t, got[0])
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, want, got0)
+
+				got1 := assert.Is[*ontology.VirtualMachine](t, got[1])
+				assert.Equal(t, "worker-1", got1.GetName())
+				assert.False(t, got1.GetInternetAccessibleEndpoint())
+				return assert.False(t, got1.GetBootLogging().GetEnabled())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverVirtualMachines(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_gcpDiscovery_discoverDisks(t *testing.T) {
+	tests := []struct {
+		name     string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "compute client not initialized")
+			},
+		},
+		{
+			name:     "error list disks",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not list disks")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleDiskAggregatedListSuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 3, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2024-02-02T10:00:00.000-08:00")
+				assert.NoError(t, err)
+
+				want := &ontology.BlockStorage{
+					Id:           "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/europe-west3-a/disks/data-1",
+					Name:         "data-1",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "europe-west3",
+					},
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+								Algorithm: constants.AES256,
+								Enabled:   true,
+								KeyUrl:    "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/disk-key",
+							},
+						},
+					},
+					ParentId: util.Ref("projects/clouditor-test"),
+				}
+
+				got1 := assert.Is[*ontology.BlockStorage](t, got[1])
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				assert.Equal(t, want, got1)
+
+				got0 := assert.Is[*ontology.BlockStorage](t, got[0])
+				return assert.NotNil(t, got0.GetAtRestEncryption().GetManagedKeyEncryption())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverDisks(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
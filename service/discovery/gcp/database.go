@@ -0,0 +1,217 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// IPAddressTypePrimary is the type of the public IP address of a SQL instance
+	IPAddressTypePrimary = "PRIMARY"
+
+	// AvailabilityTypeRegional is the availability type of highly available SQL instances, which have a standby
+	// instance in another zone of the region
+	AvailabilityTypeRegional = "REGIONAL"
+
+	// RetentionUnitCount is the retention unit of the automated backups if a number of backups is retained
+	RetentionUnitCount = "COUNT"
+)
+
+// discoverSQLInstances discovers the Cloud SQL instances of the project
+func (d *gcpDiscovery) discoverSQLInstances(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.sqlClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	err = client.Instances.List(projectID).Pages(context.Background(), func(page *sqladmin.InstancesListResponse) error {
+		for _, instance := range page.Items {
+			list = append(list, d.handleSQLInstance(projectID, instance)...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list SQL instances: %w", err)
+	}
+
+	return
+}
+
+// handleSQLInstance returns a [ontology.RelationalDatabaseService] and the [ontology.DatabaseStorage] it uses out of
+// an existing [sqladmin.DatabaseInstance]
+func (d *gcpDiscovery) handleSQLInstance(projectID string, instance *sqladmin.DatabaseInstance) []ontology.IsResource {
+	var (
+		settings     = util.Deref(instance.Settings)
+		ipConfig     = util.Deref(settings.IpConfiguration)
+		kmsKeyName   string
+		redundancies = sqlRedundancies(instance)
+	)
+
+	if instance.DiskEncryptionConfiguration != nil {
+		kmsKeyName = instance.DiskEncryptionConfiguration.KmsKeyName
+	}
+
+	storage := &ontology.DatabaseStorage{
+		Id:               sqlStorageID(instance.SelfLink),
+		Name:             instance.Name,
+		CreationTime:     creationTime(instance.CreateTime),
+		GeoLocation:      location(instance.Region),
+		Labels:           settings.UserLabels,
+		AtRestEncryption: atRestEncryption(kmsKeyName),
+		Backups:          sqlBackups(settings.BackupConfiguration),
+		Redundancies:     redundancies,
+		ParentId:         util.Ref(instance.SelfLink),
+		Raw:              discovery.Raw(instance),
+	}
+
+	service := &ontology.RelationalDatabaseService{
+		Id:                         instance.SelfLink,
+		Name:                       instance.Name,
+		Description:                fmt.Sprintf("Cloud SQL instance (%s)", instance.DatabaseVersion),
+		CreationTime:               creationTime(instance.CreateTime),
+		GeoLocation:                location(instance.Region),
+		Labels:                     settings.UserLabels,
+		InternetAccessibleEndpoint: hasPublicIP(instance),
+		Ips:                        sqlIPs(instance),
+		Ports:                      sqlPorts(instance.DatabaseVersion),
+		StorageIds:                 []string{storage.Id},
+		TransportEncryption: &ontology.TransportEncryption{
+			Enabled:  true,
+			Enforced: sqlEnforcesTLS(&ipConfig),
+			Protocol: constants.TLS,
+		},
+		Redundancies: redundancies,
+		ParentId:     util.Ref(projectResourceID(projectID)),
+		Raw:          discovery.Raw(instance),
+	}
+
+	log.Infof("Adding relational database service '%s'", service.Name)
+
+	return []ontology.IsResource{service, storage}
+}
+
+// sqlStorageID returns the ID of the database storage of a SQL instance, which is derived from the self link of the
+// instance
+func sqlStorageID(selfLink string) string {
+	return selfLink + "/storage"
+}
+
+// hasPublicIP returns true, if the SQL instance has a public IP address
+func hasPublicIP(instance *sqladmin.DatabaseInstance) bool {
+	for _, ip := range instance.IpAddresses {
+		if ip.Type == IPAddressTypePrimary {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sqlIPs returns all IP addresses of the SQL instance
+func sqlIPs(instance *sqladmin.DatabaseInstance) (ips []string) {
+	for _, ip := range instance.IpAddresses {
+		ips = append(ips, ip.IpAddress)
+	}
+
+	return
+}
+
+// sqlPorts returns the default port of the database engine, since Cloud SQL does not allow changing it
+func sqlPorts(databaseVersion string) []uint32 {
+	switch {
+	case strings.HasPrefix(databaseVersion, "POSTGRES"):
+		return []uint32{5432}
+	case strings.HasPrefix(databaseVersion, "MYSQL"):
+		return []uint32{3306}
+	case strings.HasPrefix(databaseVersion, "SQLSERVER"):
+		return []uint32{1433}
+	default:
+		return nil
+	}
+}
+
+// sqlEnforcesTLS returns true, if the SQL instance only accepts encrypted connections. The deprecated flag requireSsl
+// is still set by older instances.
+func sqlEnforcesTLS(ipConfig *sqladmin.IpConfiguration) bool {
+	switch ipConfig.SslMode {
+	case "ENCRYPTED_ONLY", "TRUSTED_CLIENT_CERTIFICATE_REQUIRED":
+		return true
+	default:
+		return ipConfig.RequireSsl
+	}
+}
+
+// sqlBackups returns the automated backups of the SQL instance, which are taken daily. If a number of backups is
+// retained, the retention period is the number of days.
+func sqlBackups(config *sqladmin.BackupConfiguration) []*ontology.Backup {
+	if config == nil {
+		return []*ontology.Backup{{Enabled: false}}
+	}
+
+	backup := &ontology.Backup{
+		Enabled: config.Enabled,
+	}
+
+	if config.Enabled {
+		backup.Interval = durationpb.New(24 * time.Hour)
+
+		if settings := config.BackupRetentionSettings; settings != nil && settings.RetentionUnit == RetentionUnitCount {
+			backup.RetentionPeriod = durationpb.New(time.Duration(settings.RetainedBackups) * 24 * time.Hour)
+		}
+	}
+
+	return []*ontology.Backup{backup}
+}
+
+// sqlRedundancies returns a zone redundancy for highly available SQL instances
+func sqlRedundancies(instance *sqladmin.DatabaseInstance) []*ontology.Redundancy {
+	if instance.Settings == nil || instance.Settings.AvailabilityType != AvailabilityTypeRegional {
+		return nil
+	}
+
+	return []*ontology.Redundancy{
+		{
+			Type: &ontology.Redundancy_ZoneRedundancy{
+				ZoneRedundancy: &ontology.ZoneRedundancy{
+					GeoLocations: []*ontology.GeoLocation{location(instance.Region)},
+				},
+			},
+		},
+	}
+}
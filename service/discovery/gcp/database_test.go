@@ -0,0 +1,217 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/gcptest"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_gcpDiscovery_discoverSQLInstances(t *testing.T) {
+	tests := []struct {
+		name     string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "SQL admin client not initialized")
+			},
+		},
+		{
+			name:     "error list SQL instances",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not list SQL instances")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleSQLInstanceListSuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 4, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2024-02-20T12:00:00.000Z")
+				assert.NoError(t, err)
+
+				redundancies := []*ontology.Redundancy{
+					{
+						Type: &ontology.Redundancy_ZoneRedundancy{
+							ZoneRedundancy: &ontology.ZoneRedundancy{
+								GeoLocations: []*ontology.GeoLocation{{Region: "europe-west3"}},
+							},
+						},
+					},
+				}
+
+				wantService := &ontology.RelationalDatabaseService{
+					Id:           "https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/orders-db",
+					Name:         "orders-db",
+					Description:  "Cloud SQL instance (POSTGRES_15)",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "europe-west3",
+					},
+					Labels: map[string]string{
+						"env": "prod",
+					},
+					InternetAccessibleEndpoint: true,
+					Ips:                        []string{"34.159.1.2", "34.159.1.3"},
+					Ports:                      []uint32{5432},
+					StorageIds:                 []string{"https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/orders-db/storage"},
+					TransportEncryption: &ontology.TransportEncryption{
+						Enabled:  true,
+						Enforced: true,
+						Protocol: constants.TLS,
+					},
+					Redundancies: redundancies,
+					ParentId:     util.Ref("projects/clouditor-test"),
+				}
+
+				wantStorage := &ontology.DatabaseStorage{
+					Id:           "https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/orders-db/storage",
+					Name:         "orders-db",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "europe-west3",
+					},
+					Labels: map[string]string{
+						"env": "prod",
+					},
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+							CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+								Algorithm: constants.AES256,
+								Enabled:   true,
+								KeyUrl:    "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/sql-key",
+							},
+						},
+					},
+					Backups: []*ontology.Backup{
+						{
+							Enabled:         true,
+							Interval:        durationpb.New(24 * time.Hour),
+							RetentionPeriod: durationpb.New(14 * 24 * time.Hour),
+						},
+					},
+					Redundancies: redundancies,
+					ParentId:     util.Ref("https://sqladmin.googleapis.com/v1/projects/clouditor-test/instances/orders-db"),
+				}
+
+				got0 := assert.Is[*ontology.RelationalDatabaseService](t, got[0])
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, wantService, got0)
+
+				got1 := assert.Is[*ontology.DatabaseStorage](t, got[1])
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				assert.Equal(t, wantStorage, got1)
+
+				got2 := assert.Is[*ontology.RelationalDatabaseService](t, got[2])
+				assert.False(t, got2.GetInternetAccessibleEndpoint())
+				assert.False(t, got2.GetTransportEncryption().GetEnforced())
+				assert.Equal(t, []uint32{3306}, got2.GetPorts())
+				assert.Empty(t, got2.GetRedundancies())
+
+				got3 := assert.Is[*ontology.DatabaseStorage](t, got[3])
+				return assert.False(t, got3.GetBackups()[0].GetEnabled())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverSQLInstances(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_sqlEnforcesTLS(t *testing.T) {
+	tests := []struct {
+		name     string
+		ipConfig *sqladmin.IpConfiguration
+		want     bool
+	}{
+		{
+			name:     "encrypted only",
+			ipConfig: &sqladmin.IpConfiguration{SslMode: "ENCRYPTED_ONLY"},
+			want:     true,
+		},
+		{
+			name:     "client certificate required",
+			ipConfig: &sqladmin.IpConfiguration{SslMode: "TRUSTED_CLIENT_CERTIFICATE_REQUIRED"},
+			want:     true,
+		},
+		{
+			name:     "unencrypted allowed",
+			ipConfig: &sqladmin.IpConfiguration{SslMode: "ALLOW_UNENCRYPTED_AND_ENCRYPTED"},
+			want:     false,
+		},
+		{
+			name:     "legacy require SSL",
+			ipConfig: &sqladmin.IpConfiguration{RequireSsl: true},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sqlEnforcesTLS(tt.ipConfig))
+		})
+	}
+}
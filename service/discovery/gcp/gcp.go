@@ -0,0 +1,314 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package gcp contains a discoverer for Google Cloud Platform (GCP) projects.
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxParallelProjects is the maximum number of projects that are discovered in parallel
+const MaxParallelProjects = 8
+
+var (
+	log *logrus.Entry
+
+	ErrCouldNotFindCredentials = errors.New("could not find default credentials")
+)
+
+type gcpDiscovery struct {
+	ctID string
+	// projects contains the IDs of the projects to discover. If empty, all active projects that are accessible with the
+	// credentials are discovered.
+	projects   []string
+	clientOpts []option.ClientOption
+	clients    clients
+	// identities contains the identities of the members of the IAM policies, which are collected across all projects,
+	// since the same member can be part of the IAM policies of several projects.
+	identities map[string]*ontology.Identity
+	// identitiesMu guards the identities, since projects are discovered in parallel
+	identitiesMu sync.Mutex
+}
+
+type clients struct {
+	computeService         *compute.Service
+	storageService         *storage.Service
+	sqlService             *sqladmin.Service
+	resourceManagerService *cloudresourcemanager.Service
+}
+
+type DiscoveryOption func(d *gcpDiscovery)
+
+func WithTargetOfEvaluationID(ctID string) DiscoveryOption {
+	return func(d *gcpDiscovery) {
+		d.ctID = ctID
+	}
+}
+
+// WithProjects is an option to set the IDs of the projects to discover
+func WithProjects(projects []string) DiscoveryOption {
+	return func(d *gcpDiscovery) {
+		d.projects = projects
+	}
+}
+
+// WithAuthorizer is an option to set the client options (e.g., the credentials) of the GCP API clients
+func WithAuthorizer(opts ...option.ClientOption) DiscoveryOption {
+	return func(d *gcpDiscovery) {
+		d.clientOpts = append(d.clientOpts, opts...)
+	}
+}
+
+func init() {
+	log = logrus.WithField("component", "gcp-discovery")
+}
+
+func NewGCPDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
+	d := &gcpDiscovery{
+		ctID: config.DefaultTargetOfEvaluationID,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (*gcpDiscovery) Name() string {
+	return "GCP"
+}
+
+func (*gcpDiscovery) Description() string {
+	return "Discovery of Compute Engine, VPC firewall, Cloud Storage, Cloud SQL and IAM resources in GCP projects."
+}
+
+func (d *gcpDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// NewAuthorizer returns a client option with the application default credentials, e.g., the credentials of a service
+// account given by the environment variable GOOGLE_APPLICATION_CREDENTIALS.
+func NewAuthorizer() (option.ClientOption, error) {
+	creds, err := google.FindDefaultCredentials(context.Background(), cloudresourcemanager.CloudPlatformReadOnlyScope)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCouldNotFindCredentials, err)
+	}
+
+	return option.WithCredentials(creds), nil
+}
+
+// authorize creates the following GCP API clients, if they are not already initialized
+// * compute client
+// * storage client
+// * SQL admin client
+// * resource manager client
+func (d *gcpDiscovery) authorize() (err error) {
+	ctx := context.Background()
+
+	// Compute client
+	if d.clients.computeService == nil {
+		d.clients.computeService, err = compute.NewService(ctx, d.clientOpts...)
+		if err != nil {
+			return fmt.Errorf("could not create compute client: %w", err)
+		}
+	}
+
+	// Storage client
+	if d.clients.storageService == nil {
+		d.clients.storageService, err = storage.NewService(ctx, d.clientOpts...)
+		if err != nil {
+			return fmt.Errorf("could not create storage client: %w", err)
+		}
+	}
+
+	// SQL admin client
+	if d.clients.sqlService == nil {
+		d.clients.sqlService, err = sqladmin.NewService(ctx, d.clientOpts...)
+		if err != nil {
+			return fmt.Errorf("could not create SQL admin client: %w", err)
+		}
+	}
+
+	// Resource manager client
+	if d.clients.resourceManagerService == nil {
+		d.clients.resourceManagerService, err = cloudresourcemanager.NewService(ctx, d.clientOpts...)
+		if err != nil {
+			return fmt.Errorf("could not create resource manager client: %w", err)
+		}
+	}
+
+	return
+}
+
+// List discovers the following GCP resource types of each project and translates them into the Clouditor ontology:
+// * Projects
+// * Compute Engine VMs
+// * Compute Engine disks
+// * VPC firewall rules
+// * Cloud Storage buckets
+// * Cloud SQL instances
+// * IAM bindings of the projects (and their members)
+//
+// Up to [MaxParallelProjects] projects are discovered in parallel. A resource type is skipped for a project, if its API
+// is disabled or the permissions to list it are missing.
+func (d *gcpDiscovery) List() (list []ontology.IsResource, err error) {
+	var (
+		g        errgroup.Group
+		projects []*cloudresourcemanager.Project
+	)
+
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if err = d.authorize(); err != nil {
+		return nil, fmt.Errorf("could not authorize gcp: %w", err)
+	}
+
+	d.identities = make(map[string]*ontology.Identity)
+
+	projects, err = d.discoverProjects()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover projects: %w", err)
+	}
+
+	// Each project writes its resources into its own slot to keep a stable order
+	var (
+		results = make([][]ontology.IsResource, len(projects))
+		errs    = make([]error, len(projects))
+		ok      int
+	)
+
+	g.SetLimit(MaxParallelProjects)
+
+	for i, project := range projects {
+		g.Go(func() error {
+			results[i], errs[i] = d.discoverProject(project)
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	// A project that cannot be discovered is skipped, the discovery only fails if no project could be discovered
+	for i, project := range projects {
+		if errs[i] != nil {
+			log.Errorf("Could not discover project '%s': %v", project.ProjectId, errs[i])
+			continue
+		}
+
+		list = append(list, results[i]...)
+		ok++
+	}
+
+	if ok == 0 && len(projects) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	// The identities are added last, since they are collected across all projects
+	list = append(list, d.discoveredIdentities()...)
+
+	return
+}
+
+// discoverProject discovers the project itself and all supported resource types of the project
+func (d *gcpDiscovery) discoverProject(project *cloudresourcemanager.Project) (list []ontology.IsResource, err error) {
+	var discoverers = []struct {
+		name     string
+		discover func(projectID string) ([]ontology.IsResource, error)
+	}{
+		{"virtual machines", d.discoverVirtualMachines},
+		{"disks", d.discoverDisks},
+		{"firewall rules", d.discoverFirewalls},
+		{"buckets", d.discoverBuckets},
+		{"SQL instances", d.discoverSQLInstances},
+		{"IAM bindings", d.discoverIAMBindings},
+	}
+
+	list = append(list, d.handleProject(project))
+
+	for _, disc := range discoverers {
+		r, err := disc.discover(project.ProjectId)
+		if isAccessError(err) {
+			log.Warnf("Could not discover %s of project '%s', skipping them: %v", disc.name, project.ProjectId, err)
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("could not discover %s of project '%s': %w", disc.name, project.ProjectId, err)
+		}
+
+		list = append(list, r...)
+	}
+
+	return
+}
+
+// isAccessError returns true, if the error indicates that the API of a resource type is disabled for the project
+// (SERVICE_DISABLED) or the permissions to access it are missing (PERMISSION_DENIED). Both are answered with 403
+// Forbidden by the REST APIs.
+func isAccessError(err error) bool {
+	var apiErr *googleapi.Error
+
+	if err == nil {
+		return false
+	}
+
+	if errors.As(err, &apiErr) {
+		if apiErr.Code == http.StatusForbidden {
+			return true
+		}
+
+		for _, item := range apiErr.Errors {
+			if item.Reason == "accessNotConfigured" || item.Reason == "forbidden" {
+				return true
+			}
+		}
+
+		return strings.Contains(apiErr.Message, "SERVICE_DISABLED") || strings.Contains(apiErr.Body, "SERVICE_DISABLED")
+	}
+
+	return status.Code(err) == codes.PermissionDenied
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"fmt"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
+)
+
+// computeClient returns the compute client if initialized
+func (d *gcpDiscovery) computeClient() (client *compute.Service, err error) {
+	if d.clients.computeService == nil {
+		return nil, fmt.Errorf("compute client not initialized")
+	}
+	return d.clients.computeService, nil
+}
+
+// storageClient returns the storage client if initialized
+func (d *gcpDiscovery) storageClient() (client *storage.Service, err error) {
+	if d.clients.storageService == nil {
+		return nil, fmt.Errorf("storage client not initialized")
+	}
+	return d.clients.storageService, nil
+}
+
+// sqlClient returns the SQL admin client if initialized
+func (d *gcpDiscovery) sqlClient() (client *sqladmin.Service, err error) {
+	if d.clients.sqlService == nil {
+		return nil, fmt.Errorf("SQL admin client not initialized")
+	}
+	return d.clients.sqlService, nil
+}
+
+// resourceManagerClient returns the resource manager client if initialized
+func (d *gcpDiscovery) resourceManagerClient() (client *cloudresourcemanager.Service, err error) {
+	if d.clients.resourceManagerService == nil {
+		return nil, fmt.Errorf("resource manager client not initialized")
+	}
+	return d.clients.resourceManagerService, nil
+}
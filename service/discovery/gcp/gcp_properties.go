@@ -0,0 +1,109 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// projectResourceID returns the resource name of the project, which is used as ID of the project resource and as
+// parent ID of the resources within the project.
+func projectResourceID(projectID string) string {
+	return "projects/" + projectID
+}
+
+// creationTime parses the RFC 3339 timestamp returned by the GCP APIs. Nil is returned if the timestamp is not set or
+// invalid.
+func creationTime(timestamp string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// regionFromZone returns the region of a zone, e.g., "europe-west3" for the zone "europe-west3-a". The zone can also be
+// given as URL.
+func regionFromZone(zone string) string {
+	zone = path.Base(zone)
+
+	i := strings.LastIndex(zone, "-")
+	if i == -1 {
+		return zone
+	}
+
+	return zone[:i]
+}
+
+// location returns the geo location of a region. The region can also be given as URL.
+func location(region string) *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: strings.ToLower(path.Base(region)),
+	}
+}
+
+// atRestEncryption returns the at-rest encryption of a resource. GCP always encrypts data at rest, either with a
+// Google-managed key or, if a Cloud KMS key is given, with a customer-managed key.
+func atRestEncryption(kmsKeyName string) *ontology.AtRestEncryption {
+	if kmsKeyName == "" {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Algorithm: constants.AES256,
+					Enabled:   true,
+				},
+			},
+		}
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+				Algorithm: constants.AES256,
+				Enabled:   true,
+				KeyUrl:    cryptoKeyName(kmsKeyName),
+			},
+		},
+	}
+}
+
+// cryptoKeyName returns the resource name of the Cloud KMS key without the key version, since disks refer to a
+// specific version of the key.
+func cryptoKeyName(kmsKeyName string) string {
+	if i := strings.Index(kmsKeyName, "/cryptoKeyVersions/"); i != -1 {
+		return kmsKeyName[:i]
+	}
+
+	return kmsKeyName
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_regionFromZone(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		want string
+	}{
+		{
+			name: "zone name",
+			zone: "europe-west3-a",
+			want: "europe-west3",
+		},
+		{
+			name: "zone URL",
+			zone: "https://www.googleapis.com/compute/v1/projects/clouditor-test/zones/us-central1-f",
+			want: "us-central1",
+		},
+		{
+			name: "no zone",
+			zone: "global",
+			want: "global",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, regionFromZone(tt.zone))
+		})
+	}
+}
+
+func Test_creationTime(t *testing.T) {
+	assert.Nil(t, creationTime(""))
+	assert.Nil(t, creationTime("yesterday"))
+	assert.Equal(t, int64(1706781600), creationTime("2024-02-01T10:00:00.000Z").GetSeconds())
+}
+
+func Test_cryptoKeyName(t *testing.T) {
+	tests := []struct {
+		name       string
+		kmsKeyName string
+		want       string
+	}{
+		{
+			name:       "key version",
+			kmsKeyName: "projects/p/locations/l/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1",
+			want:       "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+		},
+		{
+			name:       "key",
+			kmsKeyName: "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+			want:       "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, cryptoKeyName(tt.kmsKeyName))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/compute/v1"
+)
+
+// FirewallDirectionIngress is the direction of firewall rules that apply to incoming traffic
+const FirewallDirectionIngress = "INGRESS"
+
+// discoverFirewalls discovers the VPC firewall rules of the project
+func (d *gcpDiscovery) discoverFirewalls(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.computeClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	err = client.Firewalls.List(projectID).Pages(context.Background(), func(page *compute.FirewallList) error {
+		for _, firewall := range page.Items {
+			list = append(list, d.handleFirewall(projectID, firewall))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list firewall rules: %w", err)
+	}
+
+	return
+}
+
+// handleFirewall returns a [ontology.NetworkSecurityGroup] out of an existing [compute.Firewall]. Firewall rules apply
+// to a whole VPC network, which is global. Therefore, the rules do not have a geo location.
+func (d *gcpDiscovery) handleFirewall(projectID string, firewall *compute.Firewall) ontology.IsResource {
+	r := &ontology.NetworkSecurityGroup{
+		Id:                         firewall.SelfLink,
+		Name:                       firewall.Name,
+		Description:                firewall.Description,
+		CreationTime:               creationTime(firewall.CreationTimestamp),
+		InternetAccessibleEndpoint: firewallAllowsInboundInternet(firewall),
+		ParentId:                   util.Ref(projectResourceID(projectID)),
+		Raw:                        discovery.Raw(firewall),
+	}
+
+	log.Infof("Adding firewall rule '%s'", r.Name)
+
+	return r
+}
+
+// firewallAllowsInboundInternet returns true, if the firewall rule is enabled and allows incoming traffic from any
+// IPv4 or IPv6 address
+func firewallAllowsInboundInternet(firewall *compute.Firewall) bool {
+	if firewall.Disabled || firewall.Direction != FirewallDirectionIngress || len(firewall.Allowed) == 0 {
+		return false
+	}
+
+	return slices.Contains(firewall.SourceRanges, "0.0.0.0/0") || slices.Contains(firewall.SourceRanges, "::/0")
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/gcptest"
+
+	"google.golang.org/api/compute/v1"
+)
+
+func Test_gcpDiscovery_discoverFirewalls(t *testing.T) {
+	tests := []struct {
+		name     string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "compute client not initialized")
+			},
+		},
+		{
+			name:     "error list firewall rules",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not list firewall rules")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleFirewallListSuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 3, len(got))
+
+				got0 := assert.Is[*ontology.NetworkSecurityGroup](t, got[0])
+				assert.Equal(t, "default-allow-ssh", got0.GetName())
+				assert.Equal(t, "Allow SSH from anywhere", got0.GetDescription())
+				assert.Nil(t, got0.GetGeoLocation())
+				assert.True(t, got0.GetInternetAccessibleEndpoint())
+
+				got1 := assert.Is[*ontology.NetworkSecurityGroup](t, got[1])
+				assert.False(t, got1.GetInternetAccessibleEndpoint())
+
+				got2 := assert.Is[*ontology.NetworkSecurityGroup](t, got[2])
+				return assert.False(t, got2.GetInternetAccessibleEndpoint())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverFirewalls(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_firewallAllowsInboundInternet(t *testing.T) {
+	tests := []struct {
+		name     string
+		firewall *compute.Firewall
+		want     bool
+	}{
+		{
+			name: "ingress from IPv4 internet",
+			firewall: &compute.Firewall{
+				Direction:    FirewallDirectionIngress,
+				SourceRanges: []string{"0.0.0.0/0"},
+				Allowed:      []*compute.FirewallAllowed{{IPProtocol: "tcp"}},
+			},
+			want: true,
+		},
+		{
+			name: "ingress from IPv6 internet",
+			firewall: &compute.Firewall{
+				Direction:    FirewallDirectionIngress,
+				SourceRanges: []string{"::/0"},
+				Allowed:      []*compute.FirewallAllowed{{IPProtocol: "all"}},
+			},
+			want: true,
+		},
+		{
+			name: "deny rule",
+			firewall: &compute.Firewall{
+				Direction:    FirewallDirectionIngress,
+				SourceRanges: []string{"0.0.0.0/0"},
+				Denied:       []*compute.FirewallDenied{{IPProtocol: "all"}},
+			},
+			want: false,
+		},
+		{
+			name: "egress rule",
+			firewall: &compute.Firewall{
+				Direction:         "EGRESS",
+				DestinationRanges: []string{"0.0.0.0/0"},
+				Allowed:           []*compute.FirewallAllowed{{IPProtocol: "all"}},
+			},
+			want: false,
+		},
+		{
+			name: "disabled rule",
+			firewall: &compute.Firewall{
+				Direction:    FirewallDirectionIngress,
+				SourceRanges: []string{"0.0.0.0/0"},
+				Allowed:      []*compute.FirewallAllowed{{IPProtocol: "tcp"}},
+				Disabled:     true,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, firewallAllowsInboundInternet(tt.firewall))
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// privilegedRoles contains the roles that grant full control over a project or its IAM policy
+var privilegedRoles = []string{
+	"roles/owner",
+	"roles/editor",
+	"roles/resourcemanager.projectIamAdmin",
+	"roles/iam.securityAdmin",
+}
+
+// discoverProjects returns the configured projects. If no projects are configured, all active projects that are
+// accessible with the credentials are returned.
+func (d *gcpDiscovery) discoverProjects() (projects []*cloudresourcemanager.Project, err error) {
+	client, err := d.resourceManagerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	if len(d.projects) > 0 {
+		for _, projectID := range d.projects {
+			project, err := client.Projects.Get(projectID).Do()
+			if err != nil {
+				return nil, fmt.Errorf("could not get project '%s': %w", projectID, err)
+			}
+
+			projects = append(projects, project)
+		}
+
+		return
+	}
+
+	err = client.Projects.List().Filter("lifecycleState:ACTIVE").Pages(context.Background(), func(page *cloudresourcemanager.ListProjectsResponse) error {
+		projects = append(projects, page.Projects...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list projects: %w", err)
+	}
+
+	return
+}
+
+// handleProject returns a [ontology.ResourceGroup] out of an existing [cloudresourcemanager.Project]. Projects are
+// global, therefore they do not have a geo location.
+func (d *gcpDiscovery) handleProject(project *cloudresourcemanager.Project) ontology.IsResource {
+	r := &ontology.ResourceGroup{
+		Id:           projectResourceID(project.ProjectId),
+		Name:         project.Name,
+		CreationTime: creationTime(project.CreateTime),
+		Labels:       project.Labels,
+		ParentId:     projectParentID(project.Parent),
+		Raw:          discovery.Raw(project),
+	}
+
+	log.Infof("Adding project '%s'", r.Name)
+
+	return r
+}
+
+// discoverIAMBindings discovers the bindings of the IAM policy of the project. Each member of a binding results in a
+// role assignment. The members themselves are collected as identities, which are added after all projects have been
+// discovered.
+func (d *gcpDiscovery) discoverIAMBindings(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.resourceManagerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	policy, err := client.Projects.GetIamPolicy(projectID, &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{
+			// Version 3 is needed to retrieve conditional role bindings
+			RequestedPolicyVersion: 3,
+		},
+	}).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not get IAM policy: %w", err)
+	}
+
+	// The identities are shared with the other projects, which are discovered in parallel
+	d.identitiesMu.Lock()
+	defer d.identitiesMu.Unlock()
+
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			list = append(list, d.handleRoleBinding(projectID, binding, member))
+		}
+	}
+
+	return
+}
+
+// handleRoleBinding returns a [ontology.RoleAssignment] for a member of an existing [cloudresourcemanager.Binding] and
+// remembers the identity of the member
+func (d *gcpDiscovery) handleRoleBinding(projectID string, binding *cloudresourcemanager.Binding, member string) ontology.IsResource {
+	var description = fmt.Sprintf("Role %s assigned to %s on project %s", binding.Role, member, projectID)
+
+	if binding.Condition != nil {
+		description += fmt.Sprintf(" with condition '%s'", binding.Condition.Title)
+	}
+
+	identity := d.identity(member)
+	if slices.Contains(privilegedRoles, binding.Role) {
+		identity.Privileged = true
+	}
+
+	r := &ontology.RoleAssignment{
+		Id:          roleBindingID(projectID, binding, member),
+		Name:        binding.Role,
+		Description: description,
+		// Bindings of deleted members do not grant any access
+		Activated: identity.Activated,
+		ParentId:  util.Ref(identity.Id),
+		Raw:       discovery.Raw(binding),
+	}
+
+	log.Infof("Adding role assignment '%s'", r.Id)
+
+	return r
+}
+
+// roleBindingID returns the ID of the role assignment of a member. Since IAM policies of version 3 can bind the same
+// role to the same member multiple times with different conditions, the ID of a conditional binding contains a hash of
+// its condition expression.
+func roleBindingID(projectID string, binding *cloudresourcemanager.Binding, member string) string {
+	id := fmt.Sprintf("%s/%s/%s", projectResourceID(projectID), binding.Role, member)
+
+	if binding.Condition != nil {
+		sum := sha256.Sum256([]byte(binding.Condition.Expression))
+		id += "/conditions/" + hex.EncodeToString(sum[:8])
+	}
+
+	return id
+}
+
+// identity returns the identity of an IAM member (e.g., "user:alice@example.com"). The identity is created, if it does
+// not exist yet.
+func (d *gcpDiscovery) identity(member string) *ontology.Identity {
+	if d.identities == nil {
+		d.identities = make(map[string]*ontology.Identity)
+	}
+
+	if identity, ok := d.identities[member]; ok {
+		return identity
+	}
+
+	var (
+		memberType, name, _ = strings.Cut(member, ":")
+		activated           = true
+	)
+
+	// Members that have been deleted have the prefix "deleted:" and a unique ID as suffix
+	// (e.g., "deleted:user:alice@example.com?uid=123456789012345678901")
+	if memberType == "deleted" {
+		activated = false
+		memberType, name, _ = strings.Cut(name, ":")
+		name, _, _ = strings.Cut(name, "?")
+	}
+
+	// Special members like "allUsers" do not have a type
+	if name == "" {
+		name = memberType
+		memberType = "special"
+	}
+
+	identity := &ontology.Identity{
+		Id:          member,
+		Name:        name,
+		Description: fmt.Sprintf("GCP IAM member of type %s", memberType),
+		Activated:   activated,
+		Raw:         discovery.Raw(member),
+	}
+
+	d.identities[member] = identity
+
+	return identity
+}
+
+// discoveredIdentities returns the identities collected while discovering the IAM bindings, sorted by their ID
+func (d *gcpDiscovery) discoveredIdentities() (list []ontology.IsResource) {
+	for _, member := range slices.Sorted(maps.Keys(d.identities)) {
+		identity := d.identities[member]
+
+		log.Infof("Adding identity '%s'", identity.Name)
+
+		list = append(list, identity)
+	}
+
+	return
+}
+
+// projectParentID returns the resource name of the parent of a project, e.g., "organizations/123456789012"
+func projectParentID(parent *cloudresourcemanager.ResourceId) *string {
+	if parent == nil || parent.Id == "" {
+		return nil
+	}
+
+	return util.Ref(fmt.Sprintf("%ss/%s", parent.Type, parent.Id))
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/gcptest"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func Test_gcpDiscovery_discoverProjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		projects []string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		want     func(t *testing.T, got []string) bool
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			want:     assert.Nil[[]string],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "resource manager client not initialized")
+			},
+		},
+		{
+			name:     "error get project",
+			projects: []string{"unknown"},
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleError("GET /v1/projects/{projectId}", 403, "permission denied")
+			},
+			clients: true,
+			want:    assert.Nil[[]string],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not get project 'unknown'", "permission denied")
+			},
+		},
+		{
+			name: "Happy path: list projects",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleProjectListSuccessfully()
+			},
+			clients: true,
+			want: func(t *testing.T, got []string) bool {
+				return assert.Equal(t, []string{gcptest.MockProjectID}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:     "Happy path: configured projects",
+			projects: []string{gcptest.MockProjectID},
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleProjectGetSuccessfully()
+			},
+			clients: true,
+			want: func(t *testing.T, got []string) bool {
+				return assert.Equal(t, []string{gcptest.MockProjectID}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+			d.projects = tt.projects
+
+			got, err := d.discoverProjects()
+			for _, project := range got {
+				ids = append(ids, project.ProjectId)
+			}
+
+			tt.want(t, ids)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_gcpDiscovery_discoverIAMBindings(t *testing.T) {
+	tests := []struct {
+		name           string
+		handlers       func(srv *gcptest.Server)
+		clients        bool
+		wantList       assert.Want[[]ontology.IsResource]
+		wantIdentities assert.Want[[]ontology.IsResource]
+		wantErr        assert.WantErr
+	}{
+		{
+			name:           "error client not initialized",
+			handlers:       func(srv *gcptest.Server) {},
+			clients:        false,
+			wantList:       assert.Nil[[]ontology.IsResource],
+			wantIdentities: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "resource manager client not initialized")
+			},
+		},
+		{
+			name:           "error get IAM policy",
+			handlers:       func(srv *gcptest.Server) {},
+			clients:        true,
+			wantList:       assert.Nil[[]ontology.IsResource],
+			wantIdentities: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not get IAM policy")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleProjectIamPolicySuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 5, len(got))
+
+				want := &ontology.RoleAssignment{
+					Id:          "projects/clouditor-test/roles/owner/user:alice@example.com",
+					Name:        "roles/owner",
+					Description: "Role roles/owner assigned to user:alice@example.com on project clouditor-test",
+					Activated:   true,
+					ParentId:    util.Ref("user:alice@example.com"),
+				}
+
+				got0 := assert.Is[*ontology.RoleAssignment](t, got[0])
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, want, got0)
+
+				got3 := assert.Is[*ontology.RoleAssignment](t, got[3])
+				assert.Equal(t, "projects/clouditor-test/roles/storage.admin/group:admins@example.com/conditions/69296253542fadd9", got3.GetId())
+				assert.Equal(t, "Role roles/storage.admin assigned to group:admins@example.com on project clouditor-test with condition 'expires_2030'", got3.GetDescription())
+
+				got4 := assert.Is[*ontology.RoleAssignment](t, got[4])
+				return assert.False(t, got4.GetActivated())
+			},
+			wantIdentities: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 5, len(got))
+
+				want := &ontology.Identity{
+					Id:          "serviceAccount:web@clouditor-test.iam.gserviceaccount.com",
+					Name:        "web@clouditor-test.iam.gserviceaccount.com",
+					Description: "GCP IAM member of type serviceAccount",
+					Activated:   true,
+					Privileged:  false,
+				}
+
+				got2 := assert.Is[*ontology.Identity](t, got[2])
+				assert.NotEmpty(t, got2.GetRaw())
+				got2.Raw = ""
+				assert.Equal(t, want, got2)
+
+				got0 := assert.Is[*ontology.Identity](t, got[0])
+				assert.Equal(t, "carol@example.com", got0.GetName())
+				assert.Equal(t, "GCP IAM member of type user", got0.GetDescription())
+
+				got3 := assert.Is[*ontology.Identity](t, got[3])
+				return assert.True(t, got3.GetPrivileged())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverIAMBindings(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantIdentities(t, d.discoveredIdentities())
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_gcpDiscovery_identity(t *testing.T) {
+	d := &gcpDiscovery{}
+
+	got := d.identity(MemberAllUsers)
+	assert.Equal(t, "allUsers", got.GetName())
+	assert.Equal(t, "GCP IAM member of type special", got.GetDescription())
+	assert.True(t, got.GetActivated())
+
+	// The same identity is returned for the same member
+	assert.True(t, got == d.identity(MemberAllUsers))
+}
+
+func Test_roleBindingID(t *testing.T) {
+	var (
+		binding = &cloudresourcemanager.Binding{Role: "roles/viewer"}
+		cond1   = &cloudresourcemanager.Binding{Role: "roles/viewer", Condition: &cloudresourcemanager.Expr{Title: "a", Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")"}}
+		cond2   = &cloudresourcemanager.Binding{Role: "roles/viewer", Condition: &cloudresourcemanager.Expr{Title: "a", Expression: "resource.name.startsWith(\"projects/_/buckets/public\")"}}
+	)
+
+	assert.Equal(t, "projects/p1/roles/viewer/user:alice@example.com", roleBindingID("p1", binding, "user:alice@example.com"))
+
+	// The same role can be bound to the same member with different conditions
+	assert.NotEqual(t, roleBindingID("p1", cond1, "user:alice@example.com"), roleBindingID("p1", cond2, "user:alice@example.com"))
+}
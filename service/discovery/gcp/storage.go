@@ -0,0 +1,166 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/api/storage/v1"
+)
+
+const (
+	// PublicAccessPreventionEnforced is the public access prevention setting that prevents public access to a bucket
+	// regardless of its IAM policy
+	PublicAccessPreventionEnforced = "enforced"
+
+	// MemberAllUsers is the IAM member that represents anyone on the internet
+	MemberAllUsers = "allUsers"
+	// MemberAllAuthenticatedUsers is the IAM member that represents anyone who is authenticated with a Google account
+	MemberAllAuthenticatedUsers = "allAuthenticatedUsers"
+)
+
+// discoverBuckets discovers the Cloud Storage buckets of the project
+func (d *gcpDiscovery) discoverBuckets(projectID string) (list []ontology.IsResource, err error) {
+	client, err := d.storageClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	err = client.Buckets.List(projectID).Pages(context.Background(), func(page *storage.Buckets) error {
+		for _, bucket := range page.Items {
+			r, err := d.handleBucket(projectID, bucket)
+			if err != nil {
+				return err
+			}
+
+			list = append(list, r)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list buckets: %w", err)
+	}
+
+	return
+}
+
+// handleBucket returns a [ontology.ObjectStorage] out of an existing [storage.Bucket]. The IAM policy of the bucket is
+// retrieved to check whether the bucket is publicly accessible.
+func (d *gcpDiscovery) handleBucket(projectID string, bucket *storage.Bucket) (ontology.IsResource, error) {
+	var kmsKeyName string
+
+	client, err := d.storageClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	policy, err := client.Buckets.GetIamPolicy(bucket.Name).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not get IAM policy of bucket '%s': %w", bucket.Name, err)
+	}
+
+	if bucket.Encryption != nil {
+		kmsKeyName = bucket.Encryption.DefaultKmsKeyName
+	}
+
+	r := &ontology.ObjectStorage{
+		Id:               bucket.SelfLink,
+		Name:             bucket.Name,
+		CreationTime:     creationTime(bucket.TimeCreated),
+		GeoLocation:      location(bucket.Location),
+		Labels:           bucket.Labels,
+		PublicAccess:     bucketIsPublic(bucket, policy),
+		AtRestEncryption: atRestEncryption(kmsKeyName),
+		Immutability: &ontology.Immutability{
+			Enabled: bucket.RetentionPolicy != nil && bucket.RetentionPolicy.IsLocked,
+		},
+		Backups: []*ontology.Backup{
+			{
+				// Object versioning keeps the previous versions of overwritten or deleted objects
+				Enabled: bucket.Versioning != nil && bucket.Versioning.Enabled,
+			},
+		},
+		Redundancies: bucketRedundancies(bucket),
+		ParentId:     util.Ref(projectResourceID(projectID)),
+		Raw:          discovery.Raw(bucket, policy),
+	}
+
+	log.Infof("Adding object storage '%s'", r.Name)
+
+	return r, nil
+}
+
+// bucketIsPublic returns true, if the IAM policy of the bucket grants a role to all users or all authenticated users
+// and public access prevention is not enforced
+func bucketIsPublic(bucket *storage.Bucket, policy *storage.Policy) bool {
+	if bucket.IamConfiguration != nil && bucket.IamConfiguration.PublicAccessPrevention == PublicAccessPreventionEnforced {
+		return false
+	}
+
+	for _, binding := range policy.Bindings {
+		if slices.Contains(binding.Members, MemberAllUsers) || slices.Contains(binding.Members, MemberAllAuthenticatedUsers) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bucketRedundancies returns a geo redundancy for buckets in a dual- or multi-region and a zone redundancy for buckets
+// in a single region, since Cloud Storage stores the data of regional buckets redundantly across zones
+func bucketRedundancies(bucket *storage.Bucket) []*ontology.Redundancy {
+	switch bucket.LocationType {
+	case "multi-region", "dual-region":
+		return []*ontology.Redundancy{
+			{
+				Type: &ontology.Redundancy_GeoRedundancy{
+					GeoRedundancy: &ontology.GeoRedundancy{
+						GeoLocations: []*ontology.GeoLocation{location(bucket.Location)},
+					},
+				},
+			},
+		}
+	case "region":
+		return []*ontology.Redundancy{
+			{
+				Type: &ontology.Redundancy_ZoneRedundancy{
+					ZoneRedundancy: &ontology.ZoneRedundancy{
+						GeoLocations: []*ontology.GeoLocation{location(bucket.Location)},
+					},
+				},
+			},
+		}
+	default:
+		return nil
+	}
+}
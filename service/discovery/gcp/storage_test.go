@@ -0,0 +1,164 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package gcp
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/gcptest"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_gcpDiscovery_discoverBuckets(t *testing.T) {
+	tests := []struct {
+		name     string
+		handlers func(srv *gcptest.Server)
+		clients  bool
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "error client not initialized",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  false,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "storage client not initialized")
+			},
+		},
+		{
+			name:     "error list buckets",
+			handlers: func(srv *gcptest.Server) {},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not list buckets")
+			},
+		},
+		{
+			name: "error get IAM policy",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleError("GET /b/{bucket}/iam", 403, "permission denied")
+				srv.Mux.HandleFunc("GET /b", func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(gcptest.BucketListBody))
+				})
+			},
+			clients:  true,
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not get IAM policy of bucket 'clouditor-public'")
+			},
+		},
+		{
+			name: "Happy path",
+			handlers: func(srv *gcptest.Server) {
+				srv.HandleBucketListSuccessfully()
+			},
+			clients: true,
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2024-02-10T08:00:00.000Z")
+				assert.NoError(t, err)
+
+				want := &ontology.ObjectStorage{
+					Id:           "https://www.googleapis.com/storage/v1/b/clouditor-public",
+					Name:         "clouditor-public",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "eu",
+					},
+					Labels: map[string]string{
+						"env": "prod",
+					},
+					PublicAccess: true,
+					AtRestEncryption: &ontology.AtRestEncryption{
+						Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+							ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+								Algorithm: constants.AES256,
+								Enabled:   true,
+							},
+						},
+					},
+					Immutability: &ontology.Immutability{
+						Enabled: false,
+					},
+					Backups: []*ontology.Backup{
+						{
+							Enabled: true,
+						},
+					},
+					Redundancies: []*ontology.Redundancy{
+						{
+							Type: &ontology.Redundancy_GeoRedundancy{
+								GeoRedundancy: &ontology.GeoRedundancy{
+									GeoLocations: []*ontology.GeoLocation{{Region: "eu"}},
+								},
+							},
+						},
+					},
+					ParentId: util.Ref("projects/clouditor-test"),
+				}
+
+				got0 := assert.Is[*ontology.ObjectStorage](t, got[0])
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, want, got0)
+
+				got1 := assert.Is[*ontology.ObjectStorage](t, got[1])
+				assert.False(t, got1.GetPublicAccess())
+				assert.True(t, got1.GetImmutability().GetEnabled())
+				assert.False(t, got1.GetBackups()[0].GetEnabled())
+				assert.NotNil(t, got1.GetRedundancies()[0].GetZoneRedundancy())
+				return assert.Equal(t, "projects/clouditor-test/locations/europe-west3/keyRings/clouditor/cryptoKeys/bucket-key", got1.GetAtRestEncryption().GetCustomerKeyEncryption().GetKeyUrl())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := gcptest.NewServer(t)
+			tt.handlers(srv)
+
+			d := &gcpDiscovery{}
+			if tt.clients {
+				d = newTestDiscovery(t, srv)
+			}
+
+			gotList, err := d.discoverBuckets(gcptest.MockProjectID)
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
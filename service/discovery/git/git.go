@@ -0,0 +1,209 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package git contains a discoverer that inspects local git repositories, including bare clones, without access to
+// the API of the hosting platform.
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log *logrus.Entry
+
+	ErrMissingPaths = errors.New("no repository paths configured")
+)
+
+type gitDiscovery struct {
+	ctID string
+	// paths are the repositories to discover. A path that is not a repository stands for all repositories directly
+	// contained in it.
+	paths []string
+	// keyRing is the path of an armored OpenPGP key ring, which contains the trusted keys to verify commit signatures.
+	keyRing string
+}
+
+type DiscoveryOption func(d *gitDiscovery)
+
+func WithTargetOfEvaluationID(ctID string) DiscoveryOption {
+	return func(d *gitDiscovery) {
+		d.ctID = ctID
+	}
+}
+
+// WithPaths is an option to set the repositories that are discovered
+func WithPaths(paths []string) DiscoveryOption {
+	return func(d *gitDiscovery) {
+		d.paths = paths
+	}
+}
+
+// WithKeyRing is an option to set the armored OpenPGP key ring, which contains the trusted keys to verify commit
+// signatures. If it is not set, the ratio of verified commits is not discovered.
+func WithKeyRing(path string) DiscoveryOption {
+	return func(d *gitDiscovery) {
+		d.keyRing = path
+	}
+}
+
+func init() {
+	log = logrus.WithField("component", "git-discovery")
+}
+
+func NewGitDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
+	d := &gitDiscovery{
+		ctID: config.DefaultTargetOfEvaluationID,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (*gitDiscovery) Name() string {
+	return "Git"
+}
+
+func (*gitDiscovery) Description() string {
+	return "Discovery of local git repositories."
+}
+
+func (d *gitDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// List discovers the configured repositories and the lockfiles they contain.
+func (d *gitDiscovery) List() (list []ontology.IsResource, err error) {
+	var keyRing openpgp.EntityList
+
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if len(d.paths) == 0 {
+		return nil, ErrMissingPaths
+	}
+
+	if d.keyRing != "" {
+		keyRing, err = readKeyRing(d.keyRing)
+		if err != nil {
+			return nil, fmt.Errorf("could not read key ring '%s': %w", d.keyRing, err)
+		}
+	}
+
+	for _, path := range d.paths {
+		repos, err := openRepositories(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not open repository '%s': %w", path, err)
+		}
+
+		for _, repo := range repos {
+			r, err := handleRepository(repo, keyRing)
+			if err != nil {
+				return nil, fmt.Errorf("could not discover repository '%s': %w", repo.path, err)
+			}
+
+			list = append(list, r...)
+		}
+	}
+
+	return
+}
+
+// repository is an opened git repository
+type repository struct {
+	*gogit.Repository
+
+	// path is the absolute path of the repository
+	path string
+}
+
+// openRepositories opens the repository at path. If path is not a repository, all repositories directly contained in it
+// are opened instead, e.g., the bare clones of a mirror directory.
+func openRepositories(path string) (repos []*repository, err error) {
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := gogit.PlainOpen(path)
+	if err == nil {
+		return []*repository{{Repository: repo, path: path}}, nil
+	} else if !errors.Is(err, gogit.ErrRepositoryNotExists) {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		child := filepath.Join(path, entry.Name())
+
+		repo, err = gogit.PlainOpen(child)
+		if errors.Is(err, gogit.ErrRepositoryNotExists) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		repos = append(repos, &repository{Repository: repo, path: child})
+	}
+
+	if len(repos) == 0 {
+		return nil, gogit.ErrRepositoryNotExists
+	}
+
+	return
+}
+
+// readKeyRing reads an armored OpenPGP key ring
+func readKeyRing(path string) (openpgp.EntityList, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return openpgp.ReadArmoredKeyRing(f)
+}
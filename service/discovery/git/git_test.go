@@ -0,0 +1,246 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package git
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+func TestNewGitDiscovery(t *testing.T) {
+	type args struct {
+		opts []DiscoveryOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[discovery.Discoverer]
+	}{
+		{
+			name: "Happy path: default values",
+			args: args{},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*gitDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "Git", d.Name())
+				assert.Empty(t, d.paths)
+				assert.Empty(t, d.keyRing)
+				return assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+			},
+		},
+		{
+			name: "Happy path: with options",
+			args: args{
+				opts: []DiscoveryOption{
+					WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1),
+					WithPaths([]string{"repos"}),
+					WithKeyRing("keys.asc"),
+				},
+			},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*gitDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"repos"}, d.paths)
+				assert.Equal(t, "keys.asc", d.keyRing)
+				return assert.Equal(t, testdata.MockTargetOfEvaluationID1, d.TargetOfEvaluationID())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewGitDiscovery(tt.args.opts...)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_gitDiscovery_List(t *testing.T) {
+	var (
+		key  = newSigningKey(t)
+		dir  = t.TempDir()
+		repo = filepath.Join(dir, "service")
+	)
+
+	// A repository and a bare clone of it in a mirror directory
+	newTestRepository(t, repo,
+		testCommit{files: map[string]string{"go.sum": "", ".github/CODEOWNERS": "* @platform"}, key: key},
+		testCommit{files: map[string]string{"README.md": "# Service"}},
+	)
+	_, err := gogit.PlainClone(filepath.Join(dir, "mirror", "service.git"), true, &gogit.CloneOptions{URL: repo})
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "mirror", "README.md"), nil, 0600))
+
+	tests := []struct {
+		name     string
+		fields   gitDiscovery
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "paths not set",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingPaths)
+			},
+		},
+		{
+			name:     "path does not exist",
+			fields:   gitDiscovery{paths: []string{filepath.Join(dir, "does-not-exist")}},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.ErrorIs(t, err, os.ErrNotExist)
+				return assert.ErrorContains(t, err, "could not open repository")
+			},
+		},
+		{
+			name:     "no repository",
+			fields:   gitDiscovery{paths: []string{t.TempDir()}},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, gogit.ErrRepositoryNotExists)
+			},
+		},
+		{
+			name:     "invalid key ring",
+			fields:   gitDiscovery{paths: []string{repo}, keyRing: writeFile(t, "keys.asc", "no key")},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not read key ring")
+			},
+		},
+		{
+			name:   "Happy path",
+			fields: gitDiscovery{paths: []string{repo, filepath.Join(dir, "mirror")}, keyRing: writeKeyRing(t, key)},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// The bare clone is identified by its origin
+				assert.Equal(t, []string{repo, repo + "/go.sum", repo, repo + "/go.sum"}, ontology.ResourceIDs(got))
+
+				r, ok := got[2].(*ontology.CodeRepository)
+				assert.True(t, ok)
+				assert.Equal(t, "service", r.GetName())
+				assert.Equal(t, float32(50), r.GetSignedCommits().GetPercentage())
+				return assert.Equal(t, float32(50), r.GetVerifiedCommits().GetPercentage())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &tt.fields
+
+			gotList, err := d.List()
+			tt.wantErr(t, err)
+			tt.wantList(t, gotList)
+		})
+	}
+}
+
+// testCommit contains the files and properties of a commit of a test repository
+type testCommit struct {
+	files   map[string]string
+	message string
+	when    time.Time
+	// key signs the commit, if set
+	key *openpgp.Entity
+}
+
+// newTestRepository creates a repository at path with the commits
+func newTestRepository(t *testing.T, path string, commits ...testCommit) *gogit.Repository {
+	repo, err := gogit.PlainInit(path, false)
+	assert.NoError(t, err)
+
+	wt, err := repo.Worktree()
+	assert.NoError(t, err)
+
+	for _, c := range commits {
+		for name, content := range c.files {
+			assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), 0700))
+			assert.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content), 0600))
+
+			_, err = wt.Add(name)
+			assert.NoError(t, err)
+		}
+
+		if c.message == "" {
+			c.message = "Update files"
+		}
+
+		if c.when.IsZero() {
+			c.when = time.Now()
+		}
+
+		_, err = wt.Commit(c.message, &gogit.CommitOptions{
+			Author:            &object.Signature{Name: "Alice", Email: "alice@example.com", When: c.when},
+			SignKey:           c.key,
+			AllowEmptyCommits: true,
+		})
+		assert.NoError(t, err)
+	}
+
+	return repo
+}
+
+// newSigningKey returns a new OpenPGP key to sign commits
+func newSigningKey(t *testing.T) *openpgp.Entity {
+	key, err := openpgp.NewEntity("Alice", "", "alice@example.com", nil)
+	assert.NoError(t, err)
+
+	return key
+}
+
+// writeKeyRing writes the armored public key to a file and returns its path
+func writeKeyRing(t *testing.T, key *openpgp.Entity) string {
+	var buf bytes.Buffer
+
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, key.Serialize(w))
+	assert.NoError(t, w.Close())
+
+	return writeFile(t, "keys.asc", buf.String())
+}
+
+// writeFile writes the content to a file in a temporary directory and returns its path
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
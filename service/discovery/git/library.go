@@ -0,0 +1,98 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package git
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// lockfiles contains the names of the supported lockfiles and their package managers
+var lockfiles = map[string]string{
+	"go.sum":              "Go modules",
+	"package-lock.json":   "npm",
+	"npm-shrinkwrap.json": "npm",
+	"yarn.lock":           "Yarn",
+	"pnpm-lock.yaml":      "pnpm",
+	"Cargo.lock":          "Cargo",
+	"poetry.lock":         "Poetry",
+	"Pipfile.lock":        "Pipenv",
+	"uv.lock":             "uv",
+	"Gemfile.lock":        "Bundler",
+	"composer.lock":       "Composer",
+	"gradle.lockfile":     "Gradle",
+	"packages.lock.json":  "NuGet",
+	"Package.resolved":    "Swift Package Manager",
+}
+
+// skippedDirectories contain dependencies or test data, whose lockfiles do not belong to the repository
+var skippedDirectories = []string{"vendor", "node_modules", "testdata"}
+
+// lockfile contains the properties of a lockfile, which are added to the raw representation of the library
+type lockfile struct {
+	Path           string `json:"path"`
+	PackageManager string `json:"packageManager"`
+	Hash           string `json:"hash"`
+}
+
+// handleLockfiles returns a [ontology.Library] for each lockfile in the tree. The library stands for the locked
+// dependencies of the code in the directory of the lockfile.
+func handleLockfiles(tree *object.Tree, repoID string) (list []ontology.IsResource) {
+	_ = tree.Files().ForEach(func(f *object.File) error {
+		manager, ok := lockfiles[path.Base(f.Name)]
+		if !ok || slices.ContainsFunc(strings.Split(path.Dir(f.Name), "/"), func(dir string) bool {
+			return slices.Contains(skippedDirectories, dir)
+		}) {
+			return nil
+		}
+
+		lf := &lockfile{
+			Path:           f.Name,
+			PackageManager: manager,
+			Hash:           f.Hash.String(),
+		}
+
+		list = append(list, &ontology.Library{
+			Id:               fmt.Sprintf("%s/%s", strings.TrimSuffix(repoID, "/"), f.Name),
+			Name:             f.Name,
+			Description:      fmt.Sprintf("Dependencies locked by %s", manager),
+			CodeRepositoryId: util.Ref(repoID),
+			Raw:              discovery.Raw(lf),
+		})
+
+		return nil
+	})
+
+	return
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package git
+
+import (
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func Test_handleLockfiles(t *testing.T) {
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "monorepo"), testCommit{
+		files: map[string]string{
+			"go.mod":                            "module example.com/monorepo",
+			"go.sum":                            "",
+			"vendor/example.com/lib/go.sum":     "",
+			"ui/package.json":                   "{}",
+			"ui/package-lock.json":              "{}",
+			"ui/node_modules/lib/yarn.lock":     "",
+			"tools/cli/Cargo.lock":              "",
+			"internal/testdata/project/uv.lock": "",
+			"docs/Cargo.lock.md":                "",
+		},
+	})
+
+	head, err := repo.Head()
+	assert.NoError(t, err)
+	commit, err := repo.CommitObject(head.Hash())
+	assert.NoError(t, err)
+	tree, err := commit.Tree()
+	assert.NoError(t, err)
+
+	got := handleLockfiles(tree, "https://github.com/example/monorepo/")
+
+	// Lockfiles of dependencies and test data are skipped
+	assert.Equal(t, []string{
+		"https://github.com/example/monorepo/go.sum",
+		"https://github.com/example/monorepo/tools/cli/Cargo.lock",
+		"https://github.com/example/monorepo/ui/package-lock.json",
+	}, ontology.ResourceIDs(got))
+
+	lib, ok := got[2].(*ontology.Library)
+	assert.True(t, ok)
+	assert.NotEmpty(t, lib.GetRaw())
+	lib.Raw = ""
+	assert.Equal(t, &ontology.Library{
+		Id:               "https://github.com/example/monorepo/ui/package-lock.json",
+		Name:             "ui/package-lock.json",
+		Description:      "Dependencies locked by npm",
+		CodeRepositoryId: util.Ref("https://github.com/example/monorepo/"),
+	}, lib)
+}
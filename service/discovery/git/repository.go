@@ -0,0 +1,333 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/yaml"
+)
+
+// LastMonth is the period of the ratios of the last month
+const LastMonth = 30 * 24 * time.Hour
+
+// SettingsPath is the path of the configuration of the GitHub settings app (https://github.com/repository-settings/app),
+// which is the only source of branch protection rules that is part of the repository itself.
+const SettingsPath = ".github/settings.yml"
+
+// codeOwnersPaths are the paths, at which GitHub and GitLab look for a CODEOWNERS file
+var codeOwnersPaths = []string{"CODEOWNERS", ".github/CODEOWNERS", ".gitlab/CODEOWNERS", "docs/CODEOWNERS"}
+
+// repositoryProperties contains the properties of a repository, for which the ontology has no dedicated fields. They
+// are added to the raw representation of the repository.
+type repositoryProperties struct {
+	Path             string            `json:"path"`
+	Branch           string            `json:"branch"`
+	Head             string            `json:"head"`
+	Commits          int               `json:"commits"`
+	CommitsLastMonth int               `json:"commitsLastMonth"`
+	CodeOwners       string            `json:"codeOwners"`
+	BranchProtection *branchProtection `json:"branchProtection"`
+}
+
+// branchProtection contains the protection rules of a branch in the format of the GitHub settings app
+type branchProtection struct {
+	RequiredPullRequestReviews *pullRequestReviews `json:"required_pull_request_reviews"`
+	RequiredSignatures         bool                `json:"required_signatures"`
+	EnforceAdmins              bool                `json:"enforce_admins"`
+}
+
+type pullRequestReviews struct {
+	RequiredApprovingReviewCount int32 `json:"required_approving_review_count"`
+	RequireCodeOwnerReviews      bool  `json:"require_code_owner_reviews"`
+}
+
+// commitCounts contains the number of commits with a certain property
+type commitCounts struct {
+	total     int
+	signed    int
+	verified  int
+	signedOff int
+}
+
+// handleRepository returns a [ontology.CodeRepository] for the repository and a [ontology.Library] for each lockfile
+// of its current branch
+func handleRepository(repo *repository, keyRing openpgp.EntityList) (list []ontology.IsResource, err error) {
+	var (
+		all       commitCounts
+		lastMonth commitCounts
+		first     time.Time
+		props     = &repositoryProperties{Path: repo.path}
+		since     = time.Now().Add(-LastMonth)
+		r         = &ontology.CodeRepository{
+			Id:   repositoryID(repo),
+			Name: strings.TrimSuffix(filepath.Base(repo.path), ".git"),
+		}
+	)
+
+	head, err := repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		// The repository does not contain any commits yet
+		r.Description = repositoryDescription(props)
+		r.Raw = discovery.Raw(props)
+
+		return []ontology.IsResource{r}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not resolve HEAD: %w", err)
+	}
+
+	if head.Name().IsBranch() {
+		props.Branch = head.Name().Short()
+	}
+	props.Head = head.Hash().String()
+
+	commits, err := repo.Log(&gogit.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("could not list commits: %w", err)
+	}
+
+	err = commits.ForEach(func(c *object.Commit) error {
+		all.add(c, keyRing)
+
+		// The history is not necessarily sorted by time
+		if first.IsZero() || c.Committer.When.Before(first) {
+			first = c.Committer.When
+		}
+
+		if c.Committer.When.After(since) {
+			lastMonth.add(c, keyRing)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list commits: %w", err)
+	}
+
+	props.Commits = all.total
+	props.CommitsLastMonth = lastMonth.total
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not read commit %s: %w", head.Hash(), err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("could not read tree of commit %s: %w", head.Hash(), err)
+	}
+
+	props.CodeOwners = findFile(tree, codeOwnersPaths)
+
+	props.BranchProtection, err = readBranchProtection(tree, props.Branch)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", SettingsPath, err)
+	}
+
+	// The repository was created with its first commit
+	r.CreationTime = timestamppb.New(first)
+	r.Description = repositoryDescription(props)
+	r.SignedCommits = &ontology.SignedCommits{
+		Enforced:            props.BranchProtection != nil && props.BranchProtection.RequiredSignatures,
+		Percentage:          percentage(all.signed, all.total),
+		PercentageLastMonth: percentage(lastMonth.signed, lastMonth.total),
+	}
+	r.CodeSignoff = &ontology.CodeSignoff{
+		Percentage:          percentage(all.signedOff, all.total),
+		PercentageLastMonth: percentage(lastMonth.signedOff, lastMonth.total),
+	}
+
+	// Commits can only be verified against trusted keys
+	if keyRing != nil {
+		r.VerifiedCommits = &ontology.VerifiedCommits{
+			Percentage:          percentage(all.verified, all.total),
+			PercentageLastMonth: percentage(lastMonth.verified, lastMonth.total),
+		}
+	}
+
+	if reviews := props.BranchProtection.reviews(); reviews != nil {
+		r.NumberOfRequiredReviewers = reviews.RequiredApprovingReviewCount
+		// Changes need to be approved by the code owners
+		r.ApprovedCommitAuthorEnforced = reviews.RequireCodeOwnerReviews && props.CodeOwners != ""
+	}
+
+	r.Raw = discovery.Raw(props)
+
+	list = append(list, r)
+	list = append(list, handleLockfiles(tree, r.Id)...)
+
+	return
+}
+
+// add counts the commit
+func (n *commitCounts) add(c *object.Commit, keyRing openpgp.EntityList) {
+	n.total++
+
+	if c.PGPSignature != "" {
+		n.signed++
+
+		if keyRing != nil && verifyCommit(c, keyRing) == nil {
+			n.verified++
+		}
+	}
+
+	if strings.Contains(c.Message, "\nSigned-off-by: ") {
+		n.signedOff++
+	}
+}
+
+// verifyCommit verifies the OpenPGP signature of the commit against the trusted keys. Other signature formats, e.g.,
+// SSH signatures, cannot be verified.
+func verifyCommit(c *object.Commit, keyRing openpgp.EntityList) error {
+	encoded := &plumbing.MemoryObject{}
+	if err := c.EncodeWithoutSignature(encoded); err != nil {
+		return err
+	}
+
+	r, err := encoded.Reader()
+	if err != nil {
+		return err
+	}
+
+	_, err = openpgp.CheckArmoredDetachedSignature(keyRing, r, strings.NewReader(c.PGPSignature), nil)
+
+	return err
+}
+
+// readBranchProtection returns the protection rules of the branch, if they are configured in the settings file
+func readBranchProtection(tree *object.Tree, branch string) (*branchProtection, error) {
+	var settings struct {
+		Branches []struct {
+			Name       string            `json:"name"`
+			Protection *branchProtection `json:"protection"`
+		} `json:"branches"`
+	}
+
+	f, err := tree.File(SettingsPath)
+	if errors.Is(err, object.ErrFileNotFound) || branch == "" {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	contents, err := f.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal([]byte(contents), &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range settings.Branches {
+		if b.Name == branch {
+			return b.Protection, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// reviews returns the required reviews of pull requests or nil, if the branch is not protected
+func (p *branchProtection) reviews() *pullRequestReviews {
+	if p == nil {
+		return nil
+	}
+
+	return p.RequiredPullRequestReviews
+}
+
+// findFile returns the first of the paths that exists in the tree or an empty string
+func findFile(tree *object.Tree, paths []string) string {
+	for _, path := range paths {
+		if _, err := tree.File(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// repositoryID returns the URL of the origin remote without credentials. If there is no origin, the path of the
+// repository is used.
+func repositoryID(repo *repository) string {
+	remote, err := repo.Remote(gogit.DefaultRemoteName)
+	if err != nil || len(remote.Config().URLs) == 0 {
+		return repo.path
+	}
+
+	id := remote.Config().URLs[0]
+
+	// SCP-like URLs, e.g., git@github.com:clouditor/clouditor.git, cannot be parsed and do not contain credentials
+	if u, err := url.Parse(id); err == nil && u.User != nil {
+		u.User = nil
+		id = u.String()
+	}
+
+	return id
+}
+
+// percentage returns the percentage of n in total
+func percentage(n int, total int) float32 {
+	if total == 0 {
+		return 0
+	}
+
+	return float32(n) / float32(total) * 100
+}
+
+// repositoryDescription returns a human-readable summary of the repository properties.
+func repositoryDescription(props *repositoryProperties) string {
+	var (
+		codeOwners = props.CodeOwners
+		protection = "not configured"
+	)
+
+	if codeOwners == "" {
+		codeOwners = "none"
+	}
+
+	if props.BranchProtection != nil {
+		protection = "configured in " + SettingsPath
+	}
+
+	return fmt.Sprintf("Git repository: commits: %d; commits in the last month: %d; CODEOWNERS: %s; branch protection: %s",
+		props.Commits, props.CommitsLastMonth, codeOwners, protection)
+}
@@ -28,10 +28,13 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "k8s.io/api/core/v1"
@@ -39,14 +42,62 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+// LabelPodSecurityEnforce is the namespace label that configures the enforced Pod Security Admission level.
+const LabelPodSecurityEnforce = "pod-security.kubernetes.io/enforce"
+
+// Labels of a container that contain its pod security properties
+const (
+	LabelPrivileged                = "k8s:privileged"
+	LabelRunAsNonRoot              = "k8s:run-as-non-root"
+	LabelHostNetwork               = "k8s:host-network"
+	LabelHostPath                  = "k8s:host-path"
+	LabelAddedCapabilities         = "k8s:added-capabilities"
+	LabelReadOnlyRootFilesystem    = "k8s:read-only-root-filesystem"
+	LabelPodSecurityAdmissionLevel = "k8s:pod-security-admission-level"
+)
+
 type k8sComputeDiscovery struct{ k8sDiscovery }
 
-func NewKubernetesComputeDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string) discovery.Discoverer {
-	return &k8sComputeDiscovery{k8sDiscovery{intf, TargetOfEvaluationID}}
+// podSecurityProperties contains the security context of a pod, for which the ontology has no dedicated fields. They
+// are added as labels and to the raw representation of the container.
+type podSecurityProperties struct {
+	Privileged                bool     `json:"privileged"`
+	RunAsNonRoot              bool     `json:"runAsNonRoot"`
+	HostNetwork               bool     `json:"hostNetwork"`
+	HostPath                  bool     `json:"hostPath"`
+	AddedCapabilities         []string `json:"addedCapabilities"`
+	ReadOnlyRootFilesystem    bool     `json:"readOnlyRootFilesystem"`
+	PodSecurityAdmissionLevel string   `json:"podSecurityAdmissionLevel"`
+}
+
+// labels returns the properties as resource labels. Added capabilities and the admission level are only set, if
+// present.
+func (p *podSecurityProperties) labels() map[string]string {
+	labels := map[string]string{
+		LabelPrivileged:             strconv.FormatBool(p.Privileged),
+		LabelRunAsNonRoot:           strconv.FormatBool(p.RunAsNonRoot),
+		LabelHostNetwork:            strconv.FormatBool(p.HostNetwork),
+		LabelHostPath:               strconv.FormatBool(p.HostPath),
+		LabelReadOnlyRootFilesystem: strconv.FormatBool(p.ReadOnlyRootFilesystem),
+	}
+
+	if len(p.AddedCapabilities) > 0 {
+		labels[LabelAddedCapabilities] = strings.Join(p.AddedCapabilities, ",")
+	}
+
+	if p.PodSecurityAdmissionLevel != "" {
+		labels[LabelPodSecurityAdmissionLevel] = p.PodSecurityAdmissionLevel
+	}
+
+	return labels
+}
+
+func NewKubernetesComputeDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sComputeDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
 }
 
-func (*k8sComputeDiscovery) Name() string {
-	return "Kubernetes Compute"
+func (d *k8sComputeDiscovery) Name() string {
+	return d.name("Kubernetes Compute")
 }
 
 func (*k8sComputeDiscovery) Description() string {
@@ -54,7 +105,24 @@ func (*k8sComputeDiscovery) Description() string {
 }
 
 func (d *k8sComputeDiscovery) List() ([]ontology.IsResource, error) {
-	var list []ontology.IsResource
+	var (
+		list       []ontology.IsResource
+		namespaces = make(map[string]*v1.Namespace)
+	)
+
+	// Get namespaces, which contain the Pod Security Admission configuration. They are optional, so that pods can
+	// still be discovered without the permission to list namespaces.
+	nsList, err := d.intf.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Could not list namespaces, pod security admission levels will be missing: %v", err)
+	} else {
+		for i := range nsList.Items {
+			namespaces[nsList.Items[i].Name] = &nsList.Items[i]
+		}
+	}
+
+	// Get the owners of replica sets and jobs, which connect pods to their workloads
+	owners := d.workloadOwners()
 
 	// Get pods
 	pods, err := d.intf.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
@@ -63,8 +131,12 @@ func (d *k8sComputeDiscovery) List() ([]ontology.IsResource, error) {
 	}
 
 	for i := range pods.Items {
+		if !d.namespaceAllowed(pods.Items[i].Namespace) {
+			continue
+		}
+
 		// Get virtual machines
-		c := d.handlePod(&pods.Items[i])
+		c := d.handlePod(&pods.Items[i], namespaces[pods.Items[i].Namespace], podParentID(&pods.Items[i], owners))
 		log.Infof("Adding container %+v", c.GetId())
 		list = append(list, c)
 
@@ -77,24 +149,94 @@ func (d *k8sComputeDiscovery) List() ([]ontology.IsResource, error) {
 		}
 	}
 
-	return list, nil
+	return d.withCluster(list), nil
 }
 
 // handlePod returns all existing pods
-func (d *k8sComputeDiscovery) handlePod(pod *v1.Pod) *ontology.Container {
+func (d *k8sComputeDiscovery) handlePod(pod *v1.Pod, ns *v1.Namespace, parentID *string) *ontology.Container {
+	var (
+		props   = podSecurity(pod, ns)
+		imageID *string
+	)
+
+	if len(pod.Spec.Containers) > 0 {
+		imageID = util.Ref(getContainerImageResourceID(pod.Spec.Containers[0].Image))
+	}
+
 	r := &ontology.Container{
 		Id:           getContainerResourceID(pod),
 		Name:         pod.Name,
+		Description:  podSecurityDescription(props),
 		CreationTime: timestamppb.New(pod.CreationTimestamp.Time),
-		Labels:       pod.Labels,
-		Raw:          discovery.Raw(pod),
+		Labels:       withLabels(pod.Labels, props.labels()),
+		ImageId:      imageID,
+		ParentId:     parentID,
+		Raw:          discovery.Raw(pod, props),
 	}
 
-	r.NetworkInterfaceIds = append(r.NetworkInterfaceIds, pod.Namespace)
+	// The pod network is represented by the namespace of the pod
+	r.NetworkInterfaceIds = append(r.NetworkInterfaceIds, getNamespaceResourceID(pod.Namespace))
 
 	return r
 }
 
+// workloadOwners returns the controlling owners of all replica sets and jobs, indexed by their namespace, kind and
+// name. The owners are optional: if replica sets or jobs cannot be listed, e.g., because of missing permissions, the
+// pods they control are discovered without a parent.
+func (d *k8sComputeDiscovery) workloadOwners() (owners map[string]*metav1.OwnerReference) {
+	owners = make(map[string]*metav1.OwnerReference)
+
+	replicaSets, err := d.intf.AppsV1().ReplicaSets("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Could not list replica sets: %v", err)
+	} else {
+		for i := range replicaSets.Items {
+			rs := &replicaSets.Items[i]
+			owners[ownerKey(rs.Namespace, "ReplicaSet", rs.Name)] = metav1.GetControllerOf(rs)
+		}
+	}
+
+	jobs, err := d.intf.BatchV1().Jobs("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Could not list jobs: %v", err)
+	} else {
+		for i := range jobs.Items {
+			job := &jobs.Items[i]
+			owners[ownerKey(job.Namespace, "Job", job.Name)] = metav1.GetControllerOf(job)
+		}
+	}
+
+	return owners
+}
+
+// podParentID returns the ID of the workload (Deployment, StatefulSet, DaemonSet or CronJob) that controls the pod.
+// Deployments and CronJobs control their pods indirectly via replica sets and jobs.
+func podParentID(pod *v1.Pod, owners map[string]*metav1.OwnerReference) *string {
+	owner := metav1.GetControllerOf(pod)
+	if owner != nil && (owner.Kind == "ReplicaSet" || owner.Kind == "Job") {
+		owner = owners[ownerKey(pod.Namespace, owner.Kind, owner.Name)]
+	}
+
+	if owner == nil {
+		return nil
+	}
+
+	switch owner.Kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "CronJob":
+		return util.Ref(getWorkloadResourceID(owner.Kind, pod.Namespace, owner.Name))
+	default:
+		return nil
+	}
+}
+
+func ownerKey(namespace string, kind string, name string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, kind, name)
+}
+
+func getNamespaceResourceID(namespace string) string {
+	return fmt.Sprintf("/namespaces/%s", namespace)
+}
+
 func getContainerResourceID(pod *v1.Pod) string {
 	return fmt.Sprintf("/namespaces/%s/containers/%s", pod.Namespace, pod.Name)
 }
@@ -167,3 +309,76 @@ func (d *k8sComputeDiscovery) handlePodVolume(pod *v1.Pod) []ontology.IsResource
 
 	return volumes
 }
+
+// podSecurity returns the effective security context of all (init) containers of the pod. Container settings take
+// precedence over the settings of the pod. A flag such as runAsNonRoot is only set, if it applies to all containers.
+func podSecurity(pod *v1.Pod, ns *v1.Namespace) *podSecurityProperties {
+	var (
+		props      = &podSecurityProperties{HostNetwork: pod.Spec.HostNetwork}
+		containers = append(slices.Clone(pod.Spec.InitContainers), pod.Spec.Containers...)
+		podNonRoot = pod.Spec.SecurityContext != nil && util.Deref(pod.Spec.SecurityContext.RunAsNonRoot)
+	)
+
+	props.HostPath = slices.ContainsFunc(pod.Spec.Volumes, func(v v1.Volume) bool {
+		return v.HostPath != nil
+	})
+
+	if ns != nil {
+		props.PodSecurityAdmissionLevel = ns.Labels[LabelPodSecurityEnforce]
+	}
+
+	if len(containers) == 0 {
+		return props
+	}
+
+	props.RunAsNonRoot = true
+	props.ReadOnlyRootFilesystem = true
+
+	for _, c := range containers {
+		var (
+			sc      = c.SecurityContext
+			nonRoot = podNonRoot
+		)
+
+		if sc == nil {
+			sc = &v1.SecurityContext{}
+		}
+
+		if sc.RunAsNonRoot != nil {
+			nonRoot = *sc.RunAsNonRoot
+		}
+
+		props.Privileged = props.Privileged || util.Deref(sc.Privileged)
+		props.RunAsNonRoot = props.RunAsNonRoot && nonRoot
+		props.ReadOnlyRootFilesystem = props.ReadOnlyRootFilesystem && util.Deref(sc.ReadOnlyRootFilesystem)
+
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if !slices.Contains(props.AddedCapabilities, string(capability)) {
+					props.AddedCapabilities = append(props.AddedCapabilities, string(capability))
+				}
+			}
+		}
+	}
+
+	return props
+}
+
+// podSecurityDescription returns a human-readable summary of the pod security properties.
+func podSecurityDescription(props *podSecurityProperties) string {
+	var (
+		level        = props.PodSecurityAdmissionLevel
+		capabilities = strings.Join(props.AddedCapabilities, ",")
+	)
+
+	if level == "" {
+		level = "not set"
+	}
+
+	if capabilities == "" {
+		capabilities = "none"
+	}
+
+	return fmt.Sprintf("Pod security: privileged: %t; runAsNonRoot: %t; hostNetwork: %t; hostPath: %t; added capabilities: %s; readOnlyRootFilesystem: %t; pod security admission level: %s",
+		props.Privileged, props.RunAsNonRoot, props.HostNetwork, props.HostPath, capabilities, props.ReadOnlyRootFilesystem, level)
+}
@@ -27,18 +27,24 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
 
 	"google.golang.org/protobuf/testing/protocmp"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestNewKubernetesComputeDiscovery(t *testing.T) {
@@ -91,7 +97,12 @@ func Test_k8sComputeDiscovery_List(t *testing.T) {
 		podLabel        = map[string]string{"my": "label"}
 	)
 
-	client := fake.NewSimpleClientset()
+	client := fake.NewSimpleClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   podNamespace,
+			Labels: map[string]string{LabelPodSecurityEnforce: "restricted"},
+		},
+	})
 
 	// Create an Pod with name, creationTimestamp and a AzureDisk volume
 	p := &corev1.Pod{
@@ -118,6 +129,16 @@ func Test_k8sComputeDiscovery_List(t *testing.T) {
 		t.Fatalf("error injecting pod add: %v", err)
 	}
 
+	// Create a client that is only allowed to list pods
+	rp := p.DeepCopy()
+	rp.Namespace = podNamespace
+	restricted := fake.NewSimpleClientset(rp)
+	for _, resource := range []string{"namespaces", "replicasets", "jobs"} {
+		restricted.PrependReactor("list", resource, func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: resource}, "", errors.New("forbidden"))
+		})
+	}
+
 	type fields struct {
 		discovery discovery.Discoverer
 	}
@@ -139,11 +160,20 @@ func Test_k8sComputeDiscovery_List(t *testing.T) {
 				}
 				// Create expected ontology.Container
 				expectedContainer := &ontology.Container{
-					Id:     podID,
-					Name:   podName,
-					Labels: podLabel,
+					Id:          podID,
+					Name:        podName,
+					Description: "Pod security: privileged: false; runAsNonRoot: false; hostNetwork: false; hostPath: false; added capabilities: none; readOnlyRootFilesystem: false; pod security admission level: restricted",
+					Labels: map[string]string{
+						"my":                           "label",
+						LabelPrivileged:                "false",
+						LabelRunAsNonRoot:              "false",
+						LabelHostNetwork:               "false",
+						LabelHostPath:                  "false",
+						LabelReadOnlyRootFilesystem:    "false",
+						LabelPodSecurityAdmissionLevel: "restricted",
+					},
 					NetworkInterfaceIds: []string{
-						podNamespace,
+						"/namespaces/" + podNamespace,
 					},
 				}
 
@@ -169,6 +199,24 @@ func Test_k8sComputeDiscovery_List(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "missing permissions for namespaces, replica sets and jobs",
+			fields: fields{
+				NewKubernetesComputeDiscovery(restricted, testdata.MockTargetOfEvaluationID1),
+			},
+			want: func(t *testing.T, got []ontology.IsResource) bool {
+				if !assert.Equal(t, 2, len(got)) {
+					return false
+				}
+
+				container, ok := got[0].(*ontology.Container)
+				return assert.True(t, ok) &&
+					assert.Equal(t, podID, container.Id) &&
+					assert.Equal(t, "false", container.Labels[LabelPrivileged]) &&
+					assert.Equal(t, "", container.Labels[LabelPodSecurityAdmissionLevel])
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,3 +285,137 @@ func Test_k8sComputeDiscovery_handlePodVolume(t *testing.T) {
 		})
 	}
 }
+
+func Test_podSecurity(t *testing.T) {
+	type args struct {
+		pod *corev1.Pod
+		ns  *corev1.Namespace
+	}
+	tests := []struct {
+		name string
+		args args
+		want *podSecurityProperties
+	}{
+		{
+			name: "no containers",
+			args: args{
+				pod: &corev1.Pod{},
+			},
+			want: &podSecurityProperties{},
+		},
+		{
+			name: "hardened pod",
+			args: args{
+				pod: &corev1.Pod{
+					Spec: corev1.PodSpec{
+						SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: util.Ref(true)},
+						Containers: []corev1.Container{
+							{
+								Name:            "app",
+								SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: util.Ref(true)},
+							},
+						},
+					},
+				},
+				ns: &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{LabelPodSecurityEnforce: "restricted"}},
+				},
+			},
+			want: &podSecurityProperties{
+				RunAsNonRoot:              true,
+				ReadOnlyRootFilesystem:    true,
+				PodSecurityAdmissionLevel: "restricted",
+			},
+		},
+		{
+			name: "privileged container overrides pod settings",
+			args: args{
+				pod: &corev1.Pod{
+					Spec: corev1.PodSpec{
+						HostNetwork: true,
+						Volumes: []corev1.Volume{
+							{
+								Name:         "host",
+								VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/run"}},
+							},
+						},
+						SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: util.Ref(true)},
+						InitContainers: []corev1.Container{
+							{
+								Name: "init",
+								SecurityContext: &corev1.SecurityContext{
+									Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}},
+								},
+							},
+						},
+						Containers: []corev1.Container{
+							{
+								Name: "app",
+								SecurityContext: &corev1.SecurityContext{
+									Privileged:   util.Ref(true),
+									RunAsNonRoot: util.Ref(false),
+									Capabilities: &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN", "SYS_ADMIN"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &podSecurityProperties{
+				Privileged:        true,
+				HostNetwork:       true,
+				HostPath:          true,
+				AddedCapabilities: []string{"NET_ADMIN", "SYS_ADMIN"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := podSecurity(tt.args.pod, tt.args.ns)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_podParentID(t *testing.T) {
+	var controller = func(kind string, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: util.Ref(true)}}
+	}
+
+	owners := map[string]*metav1.OwnerReference{
+		ownerKey("my-namespace", "ReplicaSet", "web-5d8f"): &controller("Deployment", "web")[0],
+		ownerKey("my-namespace", "Job", "standalone"):      nil,
+	}
+
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want *string
+	}{
+		{
+			name: "bare pod",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-namespace"}},
+			want: nil,
+		},
+		{
+			name: "deployment via replica set",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-namespace", OwnerReferences: controller("ReplicaSet", "web-5d8f")}},
+			want: util.Ref("/namespaces/my-namespace/deployments/web"),
+		},
+		{
+			name: "stateful set",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-namespace", OwnerReferences: controller("StatefulSet", "db")}},
+			want: util.Ref("/namespaces/my-namespace/statefulsets/db"),
+		},
+		{
+			name: "job without cron job",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "my-namespace", OwnerReferences: controller("Job", "standalone")}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, podParentID(tt.pod, owners))
+		})
+	}
+}
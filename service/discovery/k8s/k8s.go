@@ -28,14 +28,27 @@ package k8s
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 )
 
+// LabelCluster is the label that contains the name of the cluster, i.e., the kubeconfig context, a resource was
+// discovered in.
+const LabelCluster = "k8s:cluster"
+
 var log *logrus.Entry
 
 func init() {
@@ -45,26 +58,187 @@ func init() {
 type k8sDiscovery struct {
 	intf kubernetes.Interface
 	ctID string
+
+	// metadata is used to list objects without their content, e.g., secrets without their values.
+	metadata metadata.Interface
+
+	// cluster is the name of the cluster. If set, resources are labelled with it and their IDs are prefixed with it.
+	cluster string
+
+	// namespaces restricts the discovery to these namespaces, if not empty.
+	namespaces []string
+
+	// excludedNamespaces are not discovered.
+	excludedNamespaces []string
+}
+
+// DiscoveryOption is a functional option type to configure the Kubernetes discoverers.
+type DiscoveryOption func(d *k8sDiscovery)
+
+// WithCluster is an option to set the name of the discovered cluster. This is needed to distinguish the resources of
+// multiple clusters.
+func WithCluster(name string) DiscoveryOption {
+	return func(d *k8sDiscovery) {
+		d.cluster = name
+	}
+}
+
+// WithMetadataClient is an option to set the client that lists objects by their metadata only. Without it, secrets are
+// not discovered, since their values must never be retrieved.
+func WithMetadataClient(client metadata.Interface) DiscoveryOption {
+	return func(d *k8sDiscovery) {
+		d.metadata = client
+	}
+}
+
+// WithNamespaces is an option to restrict the discovery to the given namespaces. Cluster-scoped resources are still
+// discovered.
+func WithNamespaces(namespaces []string) DiscoveryOption {
+	return func(d *k8sDiscovery) {
+		d.namespaces = namespaces
+	}
+}
+
+// WithExcludedNamespaces is an option to exclude the given namespaces from the discovery.
+func WithExcludedNamespaces(namespaces []string) DiscoveryOption {
+	return func(d *k8sDiscovery) {
+		d.excludedNamespaces = namespaces
+	}
+}
+
+func newK8sDiscovery(intf kubernetes.Interface, ctID string, opts ...DiscoveryOption) k8sDiscovery {
+	d := k8sDiscovery{
+		intf: intf,
+		ctID: ctID,
+	}
+
+	for _, opt := range opts {
+		opt(&d)
+	}
+
+	return d
+}
+
+// NewKubernetesDiscoverers returns all Kubernetes discoverers for the cluster.
+func NewKubernetesDiscoverers(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) []discovery.Discoverer {
+	return []discovery.Discoverer{
+		NewKubernetesComputeDiscovery(intf, TargetOfEvaluationID, opts...),
+		NewKubernetesNetworkDiscovery(intf, TargetOfEvaluationID, opts...),
+		NewKubernetesPolicyDiscovery(intf, TargetOfEvaluationID, opts...),
+		NewKubernetesRBACDiscovery(intf, TargetOfEvaluationID, opts...),
+		NewKubernetesStorageDiscovery(intf, TargetOfEvaluationID, opts...),
+		NewKubernetesWorkloadDiscovery(intf, TargetOfEvaluationID, opts...),
+	}
 }
 
 func (d *k8sDiscovery) TargetOfEvaluationID() string {
 	return d.ctID
 }
 
-func AuthFromKubeConfig() (intf kubernetes.Interface, err error) {
-	var kubeconfig string
+// name returns the discoverer name, including the cluster if set. Discoverer names need to be unique.
+func (d *k8sDiscovery) name(name string) string {
+	if d.cluster == "" {
+		return name
+	}
 
-	// TODO(oxisto): this crashes if called twice
-	if home := homedir.HomeDir(); home != "" {
-		kubeconfig = filepath.Join(home, ".kube", "config")
-	} else {
-		return nil, errors.New("could not find kubeconfig")
+	return fmt.Sprintf("%s (%s)", name, d.cluster)
+}
+
+// namespaceAllowed returns true, if resources of the namespace should be discovered. Cluster-scoped resources have an
+// empty namespace and are always discovered.
+func (d *k8sDiscovery) namespaceAllowed(namespace string) bool {
+	if namespace == "" {
+		return true
+	}
+
+	if len(d.namespaces) > 0 && !slices.Contains(d.namespaces, namespace) {
+		return false
+	}
+
+	return !slices.Contains(d.excludedNamespaces, namespace)
+}
+
+// withCluster labels the resources with the cluster they were discovered in. Since resource IDs are only unique
+// within a cluster, the IDs as well as the references to other resources are prefixed with the cluster.
+func (d *k8sDiscovery) withCluster(list []ontology.IsResource) []ontology.IsResource {
+	if d.cluster == "" {
+		return list
+	}
+
+	prefix := getClusterResourceID(d.cluster)
+
+	for _, r := range list {
+		m := r.ProtoReflect()
+		fields := m.Descriptor().Fields()
+
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !isResourceIDField(fd) || !m.Has(fd) {
+				continue
+			}
+
+			if fd.IsList() {
+				l := m.Mutable(fd).List()
+				for j := 0; j < l.Len(); j++ {
+					l.Set(j, protoreflect.ValueOfString(prefixResourceID(prefix, l.Get(j).String())))
+				}
+			} else {
+				m.Set(fd, protoreflect.ValueOfString(prefixResourceID(prefix, m.Get(fd).String())))
+			}
+		}
+
+		ontology.SetLabel(r, LabelCluster, d.cluster)
+	}
+
+	return list
+}
+
+// isResourceIDField returns true, if the field contains the ID of the resource or references other resources.
+func isResourceIDField(fd protoreflect.FieldDescriptor) bool {
+	name := string(fd.Name())
+
+	return fd.Kind() == protoreflect.StringKind && !fd.IsMap() &&
+		(name == "id" || strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_ids"))
+}
+
+// prefixResourceID prefixes the ID with the cluster. Only IDs that are built by this package, i.e., paths starting
+// with a slash, are prefixed.
+func prefixResourceID(prefix string, id string) string {
+	if !strings.HasPrefix(id, "/") {
+		return id
 	}
 
-	// use the current context in kubeconfig
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	return prefix + id
+}
+
+// withLabels returns the labels of a Kubernetes object extended by the given labels. The labels of the object itself
+// are not modified.
+func withLabels(labels map[string]string, extra map[string]string) map[string]string {
+	m := maps.Clone(labels)
+	if m == nil {
+		m = make(map[string]string, len(extra))
+	}
+
+	maps.Copy(m, extra)
+
+	return m
+}
+
+func getClusterResourceID(cluster string) string {
+	return fmt.Sprintf("/clusters/%s", cluster)
+}
+
+// AuthFromKubeConfig creates a client for the current context of the kubeconfig.
+func AuthFromKubeConfig() (intf kubernetes.Interface, err error) {
+	return AuthFromKubeConfigContext("")
+}
+
+// AuthFromKubeConfigContext creates a client for the given context of the kubeconfig. If the context is empty, the
+// current context is used.
+func AuthFromKubeConfigContext(kubeContext string) (intf kubernetes.Interface, err error) {
+	config, err := kubeConfig(kubeContext)
 	if err != nil {
-		return nil, fmt.Errorf("could not read kubeconfig: %w", err)
+		return nil, err
 	}
 
 	// create the clientset
@@ -75,3 +249,41 @@ func AuthFromKubeConfig() (intf kubernetes.Interface, err error) {
 
 	return client, nil
 }
+
+// MetadataFromKubeConfigContext creates a metadata client for the given context of the kubeconfig. If the context is
+// empty, the current context is used.
+func MetadataFromKubeConfigContext(kubeContext string) (client metadata.Interface, err error) {
+	config, err := kubeConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err = metadata.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create metadata client: %w", err)
+	}
+
+	return client, nil
+}
+
+// kubeConfig reads the REST configuration of the given context of the kubeconfig.
+func kubeConfig(kubeContext string) (config *rest.Config, err error) {
+	var kubeconfig string
+
+	if home := homedir.HomeDir(); home != "" {
+		kubeconfig = filepath.Join(home, ".kube", "config")
+	} else {
+		return nil, errors.New("could not find kubeconfig")
+	}
+
+	// use the requested (or current) context in kubeconfig
+	config, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not read kubeconfig: %w", err)
+	}
+
+	return config, nil
+}
@@ -28,8 +28,16 @@ package k8s
 import (
 	"testing"
 
+	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func Test_k8sDiscovery_TargetOfEvaluationID(t *testing.T) {
@@ -62,3 +70,86 @@ func Test_k8sDiscovery_TargetOfEvaluationID(t *testing.T) {
 		})
 	}
 }
+
+func Test_k8sDiscovery_namespaceAllowed(t *testing.T) {
+	type fields struct {
+		namespaces         []string
+		excludedNamespaces []string
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		namespace string
+		want      bool
+	}{
+		{
+			name:      "no filter",
+			namespace: "default",
+			want:      true,
+		},
+		{
+			name:      "cluster-scoped",
+			fields:    fields{namespaces: []string{"app"}},
+			namespace: "",
+			want:      true,
+		},
+		{
+			name:      "not in allow list",
+			fields:    fields{namespaces: []string{"app"}},
+			namespace: "default",
+			want:      false,
+		},
+		{
+			name:      "in allow list",
+			fields:    fields{namespaces: []string{"app"}},
+			namespace: "app",
+			want:      true,
+		},
+		{
+			name:      "in deny list",
+			fields:    fields{excludedNamespaces: []string{"kube-system"}},
+			namespace: "kube-system",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newK8sDiscovery(nil, testdata.MockTargetOfEvaluationID1, WithNamespaces(tt.fields.namespaces), WithExcludedNamespaces(tt.fields.excludedNamespaces))
+			assert.Equal(t, tt.want, d.namespaceAllowed(tt.namespace))
+		})
+	}
+}
+
+func TestNewKubernetesDiscoverers(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "dns", Namespace: "kube-system"}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "app"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx"}}},
+		},
+	)
+
+	discoverers := NewKubernetesDiscoverers(client, testdata.MockTargetOfEvaluationID1, WithCluster("prod"), WithExcludedNamespaces([]string{"kube-system"}))
+	assert.Equal(t, 6, len(discoverers))
+	assert.Equal(t, "Kubernetes Compute (prod)", discoverers[0].Name())
+
+	// Only the service of the app namespace is discovered
+	got, err := discoverers[1].List()
+	assert.NoError(t, err)
+	assert.Equal(t, []ontology.IsResource{
+		&ontology.GenericNetworkService{
+			Id:     "/clusters/prod/namespaces/app/services/web",
+			Name:   "web",
+			Labels: map[string]string{LabelCluster: "prod"},
+		},
+	}, got, protocmp.IgnoreFields(&ontology.GenericNetworkService{}, "creation_time", "raw"))
+
+	// References to other resources are prefixed as well
+	got, err = discoverers[0].List()
+	assert.NoError(t, err)
+	container := assert.Is[*ontology.Container](t, got[0])
+	assert.Equal(t, "/clusters/prod/namespaces/app/containers/web", container.Id)
+	assert.Equal(t, util.Ref("/clusters/prod/images/nginx"), container.ImageId)
+	assert.Equal(t, []string{"/clusters/prod/namespaces/app"}, container.NetworkInterfaceIds)
+}
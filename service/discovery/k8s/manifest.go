@@ -0,0 +1,333 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// ErrMissingManifestPath is returned, if the path of the manifests is not configured.
+var ErrMissingManifestPath = errors.New("no path to the Kubernetes manifests configured")
+
+// k8sManifestDiscovery discovers the resources of Kubernetes manifests, e.g., the rendered output of a Helm chart,
+// without a live cluster. The resources are mapped in the same way as by [k8sComputeDiscovery], [k8sNetworkDiscovery]
+// and [k8sWorkloadDiscovery].
+type k8sManifestDiscovery struct {
+	k8sDiscovery
+
+	// path is a manifest file or a directory containing manifest files.
+	path string
+}
+
+// NewKubernetesManifestDiscovery returns a discoverer for the Kubernetes manifests in path, which is either a file or a
+// directory.
+func NewKubernetesManifestDiscovery(path string, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sManifestDiscovery{
+		k8sDiscovery: newK8sDiscovery(nil, TargetOfEvaluationID, opts...),
+		path:         path,
+	}
+}
+
+func (d *k8sManifestDiscovery) Name() string {
+	return d.name("Kubernetes Manifests")
+}
+
+func (*k8sManifestDiscovery) Description() string {
+	return "Discover Kubernetes compute, network and workload resources in manifest files."
+}
+
+func (d *k8sManifestDiscovery) List() ([]ontology.IsResource, error) {
+	var (
+		list       []ontology.IsResource
+		namespaces = make(map[string]*corev1.Namespace)
+		compute    = &k8sComputeDiscovery{d.k8sDiscovery}
+		network    = &k8sNetworkDiscovery{d.k8sDiscovery}
+		workload   = &k8sWorkloadDiscovery{d.k8sDiscovery}
+	)
+
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if d.path == "" {
+		return nil, ErrMissingManifestPath
+	}
+
+	objects, err := readManifests(d.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifests: %w", err)
+	}
+
+	// Get namespaces, which contain the Pod Security Admission configuration
+	for _, obj := range objects {
+		if ns, ok := obj.(*corev1.Namespace); ok {
+			namespaces[ns.Name] = ns
+		}
+	}
+
+	for _, obj := range objects {
+		var r []ontology.IsResource
+
+		switch o := obj.(type) {
+		case *corev1.Pod:
+			r = d.handlePod(compute, o, namespaces, nil)
+		case *appsv1.Deployment:
+			r = d.handleWorkload(compute, workload, &o.ObjectMeta, "Deployment", &o.Spec.Template, namespaces,
+				fmt.Sprintf("replicas: %d", util.Deref(o.Spec.Replicas)), o)
+		case *appsv1.StatefulSet:
+			r = d.handleWorkload(compute, workload, &o.ObjectMeta, "StatefulSet", &o.Spec.Template, namespaces,
+				fmt.Sprintf("replicas: %d", util.Deref(o.Spec.Replicas)), o)
+		case *appsv1.DaemonSet:
+			r = d.handleWorkload(compute, workload, &o.ObjectMeta, "DaemonSet", &o.Spec.Template, namespaces,
+				"runs on every node", o)
+		case *appsv1.ReplicaSet:
+			r = d.handlePod(compute, templatePod(&o.ObjectMeta, &o.Spec.Template), namespaces, nil)
+		case *batchv1.Job:
+			r = d.handlePod(compute, templatePod(&o.ObjectMeta, &o.Spec.Template), namespaces, nil)
+		case *batchv1.CronJob:
+			r = d.handleWorkload(compute, workload, &o.ObjectMeta, "CronJob", &o.Spec.JobTemplate.Spec.Template, namespaces,
+				fmt.Sprintf("schedule: %s", o.Spec.Schedule), o)
+		case *corev1.Service:
+			o.Namespace = defaultNamespace(o.Namespace)
+			if d.namespaceAllowed(o.Namespace) {
+				r = append(r, network.handleService(o))
+			}
+		case *networkingv1.Ingress:
+			o.Namespace = defaultNamespace(o.Namespace)
+			if d.namespaceAllowed(o.Namespace) {
+				r = append(r, network.handleIngress(o))
+			}
+		}
+
+		for _, resource := range r {
+			// Manifests do not have a creation time
+			clearCreationTime(resource)
+
+			log.Infof("Adding %s %+v", strings.ToLower(ontology.ResourceTypes(resource)[0]), resource.GetId())
+		}
+
+		list = append(list, r...)
+	}
+
+	return d.withCluster(list), nil
+}
+
+// handlePod returns the container and the volumes of the pod, if its namespace is discovered.
+func (d *k8sManifestDiscovery) handlePod(compute *k8sComputeDiscovery, pod *corev1.Pod, namespaces map[string]*corev1.Namespace, parentID *string) (list []ontology.IsResource) {
+	pod.Namespace = defaultNamespace(pod.Namespace)
+	if !d.namespaceAllowed(pod.Namespace) {
+		return nil
+	}
+
+	list = append(list, compute.handlePod(pod, namespaces[pod.Namespace], parentID))
+	list = append(list, compute.handlePodVolume(pod)...)
+
+	return
+}
+
+// handleWorkload returns the workload together with the container and the volumes of its pod template, if its
+// namespace is discovered. The container belongs to the workload.
+func (d *k8sManifestDiscovery) handleWorkload(compute *k8sComputeDiscovery, workload *k8sWorkloadDiscovery, meta *metav1.ObjectMeta, kind string, template *corev1.PodTemplateSpec, namespaces map[string]*corev1.Namespace, details string, raw any) (list []ontology.IsResource) {
+	meta.Namespace = defaultNamespace(meta.Namespace)
+	if !d.namespaceAllowed(meta.Namespace) {
+		return nil
+	}
+
+	w := workload.handleWorkload(meta, kind, &template.Spec, details, raw)
+
+	list = append(list, w)
+	list = append(list, d.handlePod(compute, templatePod(meta, template), namespaces, util.Ref(w.GetId()))...)
+
+	return
+}
+
+// templatePod returns the pod that a workload creates out of its pod template. Since the pods of a workload only exist
+// in a live cluster, the pod is named after the workload.
+func templatePod(meta *metav1.ObjectMeta, template *corev1.PodTemplateSpec) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: *template.ObjectMeta.DeepCopy(),
+		Spec:       *template.Spec.DeepCopy(),
+	}
+
+	pod.Name = meta.Name
+	pod.Namespace = meta.Namespace
+
+	return pod
+}
+
+// defaultNamespace returns the namespace or "default", if it is not set. Rendered Helm charts usually do not set a
+// namespace, since it is set when the chart is installed.
+func defaultNamespace(namespace string) string {
+	if namespace == "" {
+		return metav1.NamespaceDefault
+	}
+
+	return namespace
+}
+
+// clearCreationTime removes the creation time of the resource.
+func clearCreationTime(r ontology.IsResource) {
+	m := r.ProtoReflect()
+
+	fd := m.Descriptor().Fields().ByName("creation_time")
+	if fd == nil {
+		return
+	}
+
+	m.Clear(fd)
+}
+
+// readManifests returns the Kubernetes objects of the manifest file or of all manifest files in the directory and its
+// subdirectories, which have the extension .yaml, .yml or .json. Hidden files and directories are skipped.
+func readManifests(root string) (objects []runtime.Object, err error) {
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip hidden files and directories, but not the root itself
+		if path != root && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() || (path != root && !isManifestFile(path)) {
+			return nil
+		}
+
+		o, err := readManifestFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read manifest '%s': %w", path, err)
+		}
+
+		objects = append(objects, o...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// readManifestFile returns the Kubernetes objects of all documents in the file. Objects of kinds that are not built
+// into Kubernetes, e.g., custom resources, are skipped.
+func readManifestFile(path string) (objects []runtime.Object, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(f))
+
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		o, err := decodeManifest(doc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document at index %d: %w", i, err)
+		}
+
+		objects = append(objects, o...)
+	}
+
+	return
+}
+
+// decodeManifest decodes a single YAML or JSON document. A list (kind: List) is decoded into its items.
+func decodeManifest(doc []byte) (objects []runtime.Object, err error) {
+	b, err := utilyaml.ToJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rendered Helm charts contain documents that only consist of comments
+	if b = bytes.TrimSpace(b); len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+
+	obj, gvk, err := scheme.Codecs.UniversalDeserializer().Decode(b, nil, nil)
+	if runtime.IsNotRegisteredError(err) {
+		log.Debugf("Skipping object of unsupported kind: %v", err)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	list, ok := obj.(*corev1.List)
+	if !ok {
+		return []runtime.Object{obj}, nil
+	}
+
+	for i, item := range list.Items {
+		o, err := decodeManifest(item.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item %d of %s: %w", i, gvk.Kind, err)
+		}
+
+		objects = append(objects, o...)
+	}
+
+	return
+}
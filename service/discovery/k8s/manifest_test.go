@@ -0,0 +1,254 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNewKubernetesManifestDiscovery(t *testing.T) {
+	got := NewKubernetesManifestDiscovery("testdata/manifests", testdata.MockTargetOfEvaluationID1, WithCluster("staging"))
+	assert.Equal(t, discovery.Discoverer(&k8sManifestDiscovery{
+		k8sDiscovery: k8sDiscovery{
+			ctID:    testdata.MockTargetOfEvaluationID1,
+			cluster: "staging",
+		},
+		path: "testdata/manifests",
+	}), got, assert.CompareAllUnexported())
+	assert.Equal(t, "Kubernetes Manifests (staging)", got.Name())
+	assert.Equal(t, testdata.MockTargetOfEvaluationID1, got.TargetOfEvaluationID())
+}
+
+func Test_k8sManifestDiscovery_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		opts     []DiscoveryOption
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "path not set",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingManifestPath)
+			},
+		},
+		{
+			name:     "path does not exist",
+			path:     "testdata/does-not-exist",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.ErrorIs(t, err, os.ErrNotExist)
+				return assert.ErrorContains(t, err, "could not read manifests")
+			},
+		},
+		{
+			name:     "invalid manifest",
+			path:     writeManifest(t, "invalid.yaml", "apiVersion: v1\nkind: Pod\n---\napiVersion: v1\nkind: Service\nspec: [\n"),
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "invalid document at index 1")
+			},
+		},
+		{
+			name: "Happy path",
+			path: "testdata/manifests",
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// The files are read in lexical order. Hidden directories, other file types and custom resources are
+				// skipped.
+				assert.Equal(t, []string{
+					"/namespaces/kube-system/containers/debug",
+					"/namespaces/default/cronjobs/cleanup",
+					"/namespaces/default/containers/cleanup",
+					"/namespaces/shop/services/shop",
+					"/namespaces/shop/deployments/shop",
+					"/namespaces/shop/containers/shop",
+					"tmp",
+					"/namespaces/shop/ingresses/shop",
+				}, ontology.ResourceIDs(got))
+
+				// Workloads are mapped in the same way as by the workload discoverer
+				w, ok := got[4].(*ontology.Job)
+				assert.True(t, ok)
+				assert.Nil(t, w.GetCreationTime())
+				assert.Equal(t, "shop", w.GetName())
+
+				// The container of a workload is named after the workload and uses the Pod Security Admission level of
+				// its namespace
+				c, ok := got[5].(*ontology.Container)
+				assert.True(t, ok)
+				assert.NotEmpty(t, c.GetRaw())
+				c.Raw = ""
+				assert.Equal(t, &ontology.Container{
+					Id:          "/namespaces/shop/containers/shop",
+					Name:        "shop",
+					Description: "Pod security: privileged: false; runAsNonRoot: true; hostNetwork: false; hostPath: false; added capabilities: none; readOnlyRootFilesystem: true; pod security admission level: restricted",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":       "shop",
+						LabelPrivileged:                "false",
+						LabelRunAsNonRoot:              "true",
+						LabelHostNetwork:               "false",
+						LabelHostPath:                  "false",
+						LabelReadOnlyRootFilesystem:    "true",
+						LabelPodSecurityAdmissionLevel: "restricted",
+					},
+					ImageId:             util.Ref("/images/ghcr.io/example/shop:1.4.2"),
+					ParentId:            util.Ref("/namespaces/shop/deployments/shop"),
+					NetworkInterfaceIds: []string{"/namespaces/shop"},
+				}, c)
+
+				// Resources without a namespace are placed in the default namespace
+				c, ok = got[2].(*ontology.Container)
+				assert.True(t, ok)
+				assert.Equal(t, got[1].GetId(), c.GetParentId())
+
+				s, ok := got[3].(*ontology.GenericNetworkService)
+				assert.True(t, ok)
+				assert.Nil(t, s.GetCreationTime())
+				assert.Equal(t, []uint32{8080}, s.GetPorts())
+
+				lb, ok := got[7].(*ontology.LoadBalancer)
+				assert.True(t, ok)
+				return assert.True(t, lb.GetHttpEndpoints()[0].GetTransportEncryption().GetEnforced())
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: single file with cluster",
+			path: "testdata/manifests/debug.json",
+			opts: []DiscoveryOption{WithCluster("staging")},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, []string{"/clusters/staging/namespaces/kube-system/containers/debug"}, ontology.ResourceIDs(got))
+				labels := got[0].(*ontology.Container).GetLabels()
+				return assert.Equal(t, "staging", labels[LabelCluster]) && assert.Equal(t, "true", labels[LabelHostNetwork])
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: restricted to namespaces",
+			path: "testdata/manifests",
+			opts: []DiscoveryOption{WithNamespaces([]string{"shop"})},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				return assert.Equal(t, []string{
+					"/namespaces/shop/services/shop",
+					"/namespaces/shop/deployments/shop",
+					"/namespaces/shop/containers/shop",
+					"tmp",
+					"/namespaces/shop/ingresses/shop",
+				}, ontology.ResourceIDs(got))
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewKubernetesManifestDiscovery(tt.path, testdata.MockTargetOfEvaluationID1, tt.opts...)
+
+			gotList, err := d.List()
+			tt.wantErr(t, err)
+			tt.wantList(t, gotList)
+		})
+	}
+}
+
+func Test_decodeManifest(t *testing.T) {
+	tests := []struct {
+		name        string
+		doc         string
+		wantObjects assert.Want[[]runtime.Object]
+		wantErr     assert.WantErr
+	}{
+		{
+			name:        "comments only",
+			doc:         "# Source: shop/templates/hpa.yaml\n",
+			wantObjects: assert.Nil[[]runtime.Object],
+			wantErr:     assert.Nil[error],
+		},
+		{
+			name:        "custom resource",
+			doc:         "apiVersion: monitoring.coreos.com/v1\nkind: ServiceMonitor\nmetadata:\n  name: shop\n",
+			wantObjects: assert.Nil[[]runtime.Object],
+			wantErr:     assert.Nil[error],
+		},
+		{
+			name:        "missing kind",
+			doc:         "apiVersion: v1\nmetadata:\n  name: shop\n",
+			wantObjects: assert.Nil[[]runtime.Object],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "Kind")
+			},
+		},
+		{
+			name: "Happy path: list",
+			doc:  `{"apiVersion": "v1", "kind": "List", "items": [{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "shop"}}]}`,
+			wantObjects: func(t *testing.T, got []runtime.Object) bool {
+				assert.Equal(t, 1, len(got))
+				d, ok := got[0].(*appsv1.Deployment)
+				assert.True(t, ok)
+				return assert.Equal(t, "shop", d.Name)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: YAML",
+			doc:  "apiVersion: v1\nkind: Service\nmetadata:\n  name: shop\n",
+			wantObjects: func(t *testing.T, got []runtime.Object) bool {
+				assert.Equal(t, 1, len(got))
+				_, ok := got[0].(*corev1.Service)
+				return assert.True(t, ok)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotObjects, err := decodeManifest([]byte(tt.doc))
+			tt.wantErr(t, err)
+			tt.wantObjects(t, gotObjects)
+		})
+	}
+}
+
+// writeManifest writes the content to a file in a temporary directory and returns its path
+func writeManifest(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
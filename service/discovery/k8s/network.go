@@ -40,12 +40,12 @@ import (
 
 type k8sNetworkDiscovery struct{ k8sDiscovery }
 
-func NewKubernetesNetworkDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string) discovery.Discoverer {
-	return &k8sNetworkDiscovery{k8sDiscovery{intf, TargetOfEvaluationID}}
+func NewKubernetesNetworkDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sNetworkDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
 }
 
-func (*k8sNetworkDiscovery) Name() string {
-	return "Kubernetes Network"
+func (d *k8sNetworkDiscovery) Name() string {
+	return d.name("Kubernetes Network")
 }
 
 func (*k8sNetworkDiscovery) Description() string {
@@ -61,6 +61,10 @@ func (d *k8sNetworkDiscovery) List() ([]ontology.IsResource, error) {
 	}
 
 	for i := range services.Items {
+		if !d.namespaceAllowed(services.Items[i].Namespace) {
+			continue
+		}
+
 		c := d.handleService(&services.Items[i])
 
 		log.Infof("Adding service %+v", c.GetId())
@@ -75,6 +79,10 @@ func (d *k8sNetworkDiscovery) List() ([]ontology.IsResource, error) {
 	}
 
 	for i := range ingresses.Items {
+		if !d.namespaceAllowed(ingresses.Items[i].Namespace) {
+			continue
+		}
+
 		c := d.handleIngress(&ingresses.Items[i])
 
 		log.Infof("Adding ingress %+v", c.GetId())
@@ -82,7 +90,7 @@ func (d *k8sNetworkDiscovery) List() ([]ontology.IsResource, error) {
 		list = append(list, c)
 	}
 
-	return list, nil
+	return d.withCluster(list), nil
 }
 
 func (d *k8sNetworkDiscovery) handleService(service *corev1.Service) ontology.IsResource {
@@ -0,0 +1,158 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type k8sPolicyDiscovery struct{ k8sDiscovery }
+
+// networkPolicyProperties contains the properties of a network policy, for which the ontology has no dedicated
+// fields. They are added to the raw representation of the network security group.
+type networkPolicyProperties struct {
+	PolicyTypes        []string `json:"policyTypes"`
+	DefaultDenyIngress bool     `json:"defaultDenyIngress"`
+	DefaultDenyEgress  bool     `json:"defaultDenyEgress"`
+	AllowsAnyIngress   bool     `json:"allowsAnyIngress"`
+}
+
+func NewKubernetesPolicyDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sPolicyDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
+}
+
+func (d *k8sPolicyDiscovery) Name() string {
+	return d.name("Kubernetes Policy")
+}
+
+func (*k8sPolicyDiscovery) Description() string {
+	return "Discover Kubernetes policy resources."
+}
+
+func (d *k8sPolicyDiscovery) List() ([]ontology.IsResource, error) {
+	var list []ontology.IsResource
+
+	policies, err := d.intf.NetworkingV1().NetworkPolicies("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list network policies: %w", err)
+	}
+
+	for i := range policies.Items {
+		if !d.namespaceAllowed(policies.Items[i].Namespace) {
+			continue
+		}
+
+		r := d.handleNetworkPolicy(&policies.Items[i])
+
+		log.Infof("Adding network policy %+v", r.GetId())
+
+		list = append(list, r)
+	}
+
+	return d.withCluster(list), nil
+}
+
+func (d *k8sPolicyDiscovery) handleNetworkPolicy(policy *v1.NetworkPolicy) *ontology.NetworkSecurityGroup {
+	props := networkPolicy(policy)
+
+	return &ontology.NetworkSecurityGroup{
+		Id:                         getNetworkSecurityGroupResourceID(policy),
+		Name:                       policy.Name,
+		Description:                fmt.Sprintf("Network policy selecting pods %s; policy types: %s; default deny ingress: %t; default deny egress: %t", metav1.FormatLabelSelector(&policy.Spec.PodSelector), strings.Join(props.PolicyTypes, ","), props.DefaultDenyIngress, props.DefaultDenyEgress),
+		CreationTime:               timestamppb.New(policy.CreationTimestamp.Time),
+		Labels:                     policy.Labels,
+		InternetAccessibleEndpoint: props.AllowsAnyIngress,
+		Raw:                        discovery.Raw(policy, props),
+	}
+}
+
+// networkPolicy returns the effective properties of the network policy. If no policy types are specified, Kubernetes
+// assumes Ingress and additionally Egress, if the policy contains egress rules.
+func networkPolicy(policy *v1.NetworkPolicy) *networkPolicyProperties {
+	var (
+		props = &networkPolicyProperties{}
+		types = policy.Spec.PolicyTypes
+	)
+
+	if len(types) == 0 {
+		types = []v1.PolicyType{v1.PolicyTypeIngress}
+		if len(policy.Spec.Egress) > 0 {
+			types = append(types, v1.PolicyTypeEgress)
+		}
+	}
+
+	for _, typ := range types {
+		props.PolicyTypes = append(props.PolicyTypes, string(typ))
+	}
+
+	if slices.Contains(types, v1.PolicyTypeIngress) {
+		props.DefaultDenyIngress = len(policy.Spec.Ingress) == 0
+
+		for _, rule := range policy.Spec.Ingress {
+			if allowsAnyPeer(rule.From) {
+				props.AllowsAnyIngress = true
+				break
+			}
+		}
+	}
+
+	if slices.Contains(types, v1.PolicyTypeEgress) {
+		props.DefaultDenyEgress = len(policy.Spec.Egress) == 0
+	}
+
+	return props
+}
+
+// allowsAnyPeer returns true, if the peers of a rule match all sources, i.e., if there are no peers at all or if an
+// IP block covers the whole address space.
+func allowsAnyPeer(peers []v1.NetworkPolicyPeer) bool {
+	if len(peers) == 0 {
+		return true
+	}
+
+	for _, peer := range peers {
+		if peer.IPBlock != nil && (peer.IPBlock.CIDR == "0.0.0.0/0" || peer.IPBlock.CIDR == "::/0") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getNetworkSecurityGroupResourceID(policy *v1.NetworkPolicy) string {
+	return fmt.Sprintf("/namespaces/%s/networkpolicies/%s", policy.Namespace, policy.Name)
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewKubernetesPolicyDiscovery(t *testing.T) {
+	type args struct {
+		intf                 kubernetes.Interface
+		TargetOfEvaluationID string
+	}
+	tests := []struct {
+		name string
+		args args
+		want discovery.Discoverer
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				intf:                 &fake.Clientset{},
+				TargetOfEvaluationID: testdata.MockTargetOfEvaluationID1,
+			},
+			want: &k8sPolicyDiscovery{
+				k8sDiscovery: k8sDiscovery{
+					intf: &fake.Clientset{},
+					ctID: testdata.MockTargetOfEvaluationID1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewKubernetesPolicyDiscovery(tt.args.intf, tt.args.TargetOfEvaluationID)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+			assert.Equal(t, "Kubernetes Policy", got.Name())
+		})
+	}
+}
+
+func Test_k8sPolicyDiscovery_List(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-deny", Namespace: "my-namespace"},
+			Spec: v1.NetworkPolicySpec{
+				PolicyTypes: []v1.PolicyType{v1.PolicyTypeIngress, v1.PolicyTypeEgress},
+			},
+		},
+		&v1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "allow-web", Namespace: "my-namespace"},
+			Spec: v1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Ingress: []v1.NetworkPolicyIngressRule{
+					{From: []v1.NetworkPolicyPeer{{IPBlock: &v1.IPBlock{CIDR: "0.0.0.0/0"}}}},
+				},
+			},
+		},
+	)
+
+	d := NewKubernetesPolicyDiscovery(client, testdata.MockTargetOfEvaluationID1)
+
+	got, err := d.List()
+	assert.NoError(t, err)
+
+	want := []ontology.IsResource{
+		&ontology.NetworkSecurityGroup{
+			Id:                         "/namespaces/my-namespace/networkpolicies/allow-web",
+			Name:                       "allow-web",
+			Description:                "Network policy selecting pods app=web; policy types: Ingress; default deny ingress: false; default deny egress: false",
+			InternetAccessibleEndpoint: true,
+		},
+		&ontology.NetworkSecurityGroup{
+			Id:          "/namespaces/my-namespace/networkpolicies/default-deny",
+			Name:        "default-deny",
+			Description: "Network policy selecting pods <none>; policy types: Ingress,Egress; default deny ingress: true; default deny egress: true",
+		},
+	}
+
+	// We need to ignore creation_time and raw, because they contain the random creation time of the objects
+	assert.Equal(t, want, got, protocmp.IgnoreFields(&ontology.NetworkSecurityGroup{}, "creation_time", "raw"))
+}
+
+func Test_allowsAnyPeer(t *testing.T) {
+	tests := []struct {
+		name  string
+		peers []v1.NetworkPolicyPeer
+		want  bool
+	}{
+		{
+			name: "no peers",
+			want: true,
+		},
+		{
+			name:  "IPv6 any",
+			peers: []v1.NetworkPolicyPeer{{IPBlock: &v1.IPBlock{CIDR: "::/0"}}},
+			want:  true,
+		},
+		{
+			name: "namespace selector",
+			peers: []v1.NetworkPolicyPeer{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+				{IPBlock: &v1.IPBlock{CIDR: "10.0.0.0/8"}},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, allowsAnyPeer(tt.peers))
+		})
+	}
+}
@@ -0,0 +1,363 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClusterAdminRole is the name of the built-in cluster role that grants full access to all resources.
+const ClusterAdminRole = "cluster-admin"
+
+const (
+	// LabelWildcardVerbs is the label of a role assignment that indicates whether the bound role allows all verbs.
+	LabelWildcardVerbs = "k8s:wildcard-verbs"
+
+	// LabelClusterAdmin is the label of a role assignment that indicates whether the cluster-admin role is bound.
+	LabelClusterAdmin = "k8s:cluster-admin"
+
+	// LabelAutomountServiceAccountToken is the label of a service account that indicates whether its token is mounted
+	// into pods.
+	LabelAutomountServiceAccountToken = "k8s:automount-service-account-token"
+)
+
+type k8sRBACDiscovery struct{ k8sDiscovery }
+
+// rbacProperties contains the security-relevant properties of a role binding, for which the ontology has no
+// dedicated fields. They are added as labels and to the raw representation of the role assignment.
+type rbacProperties struct {
+	WildcardVerbs bool `json:"wildcardVerbs"`
+	ClusterAdmin  bool `json:"clusterAdmin"`
+}
+
+// privileged returns true, if the bound role grants (effectively) unrestricted access.
+func (p *rbacProperties) privileged() bool {
+	return p.WildcardVerbs || p.ClusterAdmin
+}
+
+// labels returns the properties as resource labels.
+func (p *rbacProperties) labels() map[string]string {
+	return map[string]string{
+		LabelWildcardVerbs: strconv.FormatBool(p.WildcardVerbs),
+		LabelClusterAdmin:  strconv.FormatBool(p.ClusterAdmin),
+	}
+}
+
+// serviceAccountProperties contains the security-relevant properties of a service account, for which the ontology has
+// no dedicated fields. They are added as labels and to the raw representation of the identity.
+type serviceAccountProperties struct {
+	AutomountServiceAccountToken bool `json:"automountServiceAccountToken"`
+}
+
+// labels returns the properties as resource labels.
+func (p *serviceAccountProperties) labels() map[string]string {
+	return map[string]string{
+		LabelAutomountServiceAccountToken: strconv.FormatBool(p.AutomountServiceAccountToken),
+	}
+}
+
+func NewKubernetesRBACDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sRBACDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
+}
+
+func (d *k8sRBACDiscovery) Name() string {
+	return d.name("Kubernetes RBAC")
+}
+
+func (*k8sRBACDiscovery) Description() string {
+	return "Discover Kubernetes RBAC resources."
+}
+
+func (d *k8sRBACDiscovery) List() ([]ontology.IsResource, error) {
+	var (
+		list        []ontology.IsResource
+		assignments []ontology.IsResource
+		privileged  = make(map[string]bool)
+		subjects    = make(map[string]*rbacv1.Subject)
+	)
+
+	roles, err := d.intf.RbacV1().Roles("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list roles: %w", err)
+	}
+
+	clusterRoles, err := d.intf.RbacV1().ClusterRoles().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list cluster roles: %w", err)
+	}
+
+	roleBindings, err := d.intf.RbacV1().RoleBindings("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list role bindings: %w", err)
+	}
+
+	clusterRoleBindings, err := d.intf.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list cluster role bindings: %w", err)
+	}
+
+	serviceAccounts, err := d.intf.CoreV1().ServiceAccounts("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list service accounts: %w", err)
+	}
+
+	// Convert both binding types into a common representation. Cluster role bindings have no namespace.
+	var bindings []roleBinding
+	for i := range roleBindings.Items {
+		if !d.namespaceAllowed(roleBindings.Items[i].Namespace) {
+			continue
+		}
+
+		b := &roleBindings.Items[i]
+		bindings = append(bindings, roleBinding{
+			id:         getRoleBindingResourceID(b),
+			kind:       "RoleBinding",
+			namespace:  b.Namespace,
+			objectMeta: &b.ObjectMeta,
+			roleRef:    b.RoleRef,
+			subjects:   b.Subjects,
+			raw:        b,
+		})
+	}
+	for i := range clusterRoleBindings.Items {
+		b := &clusterRoleBindings.Items[i]
+		bindings = append(bindings, roleBinding{
+			id:         getClusterRoleBindingResourceID(b),
+			kind:       "ClusterRoleBinding",
+			objectMeta: &b.ObjectMeta,
+			roleRef:    b.RoleRef,
+			subjects:   b.Subjects,
+			raw:        b,
+		})
+	}
+
+	for _, b := range bindings {
+		role := findRole(b, roles.Items, clusterRoles.Items)
+
+		props := &rbacProperties{
+			WildcardVerbs: hasWildcardVerbs(role),
+			ClusterAdmin:  b.roleRef.Kind == "ClusterRole" && b.roleRef.Name == ClusterAdminRole,
+		}
+
+		for i := range b.subjects {
+			s := &b.subjects[i]
+			identityID := getIdentityResourceID(s, b.namespace)
+
+			// Cluster role bindings can refer to service accounts of excluded namespaces
+			if s.Kind == rbacv1.ServiceAccountKind && s.Namespace != "" && !d.namespaceAllowed(s.Namespace) {
+				continue
+			}
+
+			if props.privileged() {
+				privileged[identityID] = true
+			}
+
+			// Users and groups are not objects within the cluster, so we only know them from the bindings
+			if s.Kind != rbacv1.ServiceAccountKind {
+				subjects[identityID] = s
+			}
+
+			r := d.handleRoleBinding(b, i, identityID, role, props)
+
+			log.Infof("Adding role assignment %+v", r.GetId())
+
+			assignments = append(assignments, r)
+		}
+	}
+
+	for i := range serviceAccounts.Items {
+		if !d.namespaceAllowed(serviceAccounts.Items[i].Namespace) {
+			continue
+		}
+
+		sa := &serviceAccounts.Items[i]
+		r := d.handleServiceAccount(sa, privileged[getServiceAccountResourceID(sa)])
+
+		log.Infof("Adding service account %+v", r.GetId())
+
+		list = append(list, r)
+	}
+
+	// Sort users and groups to have a stable output
+	ids := make([]string, 0, len(subjects))
+	for id := range subjects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		r := d.handleSubject(subjects[id], id, privileged[id])
+
+		log.Infof("Adding identity %+v", r.GetId())
+
+		list = append(list, r)
+	}
+
+	return d.withCluster(append(list, assignments...)), nil
+}
+
+// roleBinding is a common representation of [rbacv1.RoleBinding] and [rbacv1.ClusterRoleBinding].
+type roleBinding struct {
+	id         string
+	kind       string
+	namespace  string
+	objectMeta *metav1.ObjectMeta
+	roleRef    rbacv1.RoleRef
+	subjects   []rbacv1.Subject
+	raw        any
+}
+
+func (d *k8sRBACDiscovery) handleRoleBinding(b roleBinding, idx int, identityID string, role any, props *rbacProperties) *ontology.RoleAssignment {
+	s := b.subjects[idx]
+
+	return &ontology.RoleAssignment{
+		Id:           fmt.Sprintf("%s/subjects/%d", b.id, idx),
+		Name:         b.roleRef.Name,
+		Description:  fmt.Sprintf("%s %s bound to %s %s via %s %s", b.roleRef.Kind, b.roleRef.Name, s.Kind, s.Name, b.kind, b.objectMeta.Name),
+		CreationTime: timestamppb.New(b.objectMeta.CreationTimestamp.Time),
+		Labels:       withLabels(b.objectMeta.Labels, props.labels()),
+		Activated:    true,
+		ParentId:     util.Ref(identityID),
+		Raw:          discovery.Raw(b.raw, role, props),
+	}
+}
+
+func (d *k8sRBACDiscovery) handleServiceAccount(sa *corev1.ServiceAccount, privileged bool) *ontology.Identity {
+	// Tokens are mounted into pods by default, unless explicitly disabled
+	props := &serviceAccountProperties{
+		AutomountServiceAccountToken: sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken,
+	}
+
+	return &ontology.Identity{
+		Id:           getServiceAccountResourceID(sa),
+		Name:         sa.Name,
+		Description:  "Service account",
+		CreationTime: timestamppb.New(sa.CreationTimestamp.Time),
+		Labels:       withLabels(sa.Labels, props.labels()),
+		Activated:    true,
+		Privileged:   privileged,
+		Raw:          discovery.Raw(sa, props),
+	}
+}
+
+func (d *k8sRBACDiscovery) handleSubject(s *rbacv1.Subject, id string, privileged bool) *ontology.Identity {
+	return &ontology.Identity{
+		Id:          id,
+		Name:        s.Name,
+		Description: s.Kind,
+		Activated:   true,
+		Privileged:  privileged,
+		Raw:         discovery.Raw(s),
+	}
+}
+
+// findRole returns the role referenced by the binding or nil, if it does not exist. The result is either a
+// [*rbacv1.Role] or a [*rbacv1.ClusterRole].
+func findRole(b roleBinding, roles []rbacv1.Role, clusterRoles []rbacv1.ClusterRole) any {
+	switch b.roleRef.Kind {
+	case "Role":
+		for i := range roles {
+			if roles[i].Namespace == b.namespace && roles[i].Name == b.roleRef.Name {
+				return &roles[i]
+			}
+		}
+	case "ClusterRole":
+		for i := range clusterRoles {
+			if clusterRoles[i].Name == b.roleRef.Name {
+				return &clusterRoles[i]
+			}
+		}
+	}
+
+	// Return a typed nil pointer, so that it can still be used in discovery.Raw
+	return (*rbacv1.Role)(nil)
+}
+
+// hasWildcardVerbs returns true, if any rule of the role allows all verbs.
+func hasWildcardVerbs(role any) bool {
+	var rules []rbacv1.PolicyRule
+
+	switch r := role.(type) {
+	case *rbacv1.Role:
+		if r != nil {
+			rules = r.Rules
+		}
+	case *rbacv1.ClusterRole:
+		if r != nil {
+			rules = r.Rules
+		}
+	}
+
+	for _, rule := range rules {
+		if slices.Contains(rule.Verbs, rbacv1.VerbAll) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getRoleBindingResourceID(b *rbacv1.RoleBinding) string {
+	return fmt.Sprintf("/namespaces/%s/rolebindings/%s", b.Namespace, b.Name)
+}
+
+func getClusterRoleBindingResourceID(b *rbacv1.ClusterRoleBinding) string {
+	return fmt.Sprintf("/clusterrolebindings/%s", b.Name)
+}
+
+func getServiceAccountResourceID(sa *corev1.ServiceAccount) string {
+	return fmt.Sprintf("/namespaces/%s/serviceaccounts/%s", sa.Namespace, sa.Name)
+}
+
+// getIdentityResourceID returns the resource ID of a binding subject. Service accounts without an explicit namespace
+// belong to the namespace of the binding.
+func getIdentityResourceID(s *rbacv1.Subject, namespace string) string {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		if s.Namespace != "" {
+			namespace = s.Namespace
+		}
+		return fmt.Sprintf("/namespaces/%s/serviceaccounts/%s", namespace, s.Name)
+	case rbacv1.GroupKind:
+		return fmt.Sprintf("/groups/%s", s.Name)
+	default:
+		return fmt.Sprintf("/users/%s", s.Name)
+	}
+}
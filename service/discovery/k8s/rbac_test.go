@@ -0,0 +1,207 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewKubernetesRBACDiscovery(t *testing.T) {
+	type args struct {
+		intf                 kubernetes.Interface
+		TargetOfEvaluationID string
+	}
+	tests := []struct {
+		name string
+		args args
+		want discovery.Discoverer
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				intf:                 &fake.Clientset{},
+				TargetOfEvaluationID: testdata.MockTargetOfEvaluationID1,
+			},
+			want: &k8sRBACDiscovery{
+				k8sDiscovery: k8sDiscovery{
+					intf: &fake.Clientset{},
+					ctID: testdata.MockTargetOfEvaluationID1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewKubernetesRBACDiscovery(tt.args.intf, tt.args.TargetOfEvaluationID)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+			assert.Equal(t, "Kubernetes RBAC", got.Name())
+		})
+	}
+}
+
+func Test_k8sRBACDiscovery_List(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "deployer", Namespace: "my-namespace"},
+		},
+		&corev1.ServiceAccount{
+			ObjectMeta:                   metav1.ObjectMeta{Name: "reader", Namespace: "my-namespace"},
+			AutomountServiceAccountToken: util.Ref(false),
+		},
+		&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-reader", Namespace: "my-namespace"},
+			Rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"get", "list"}, Resources: []string{"pods"}},
+			},
+		},
+		&rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterAdminRole},
+			Rules: []rbacv1.PolicyRule{
+				{Verbs: []string{"*"}, Resources: []string{"*"}, APIGroups: []string{"*"}},
+			},
+		},
+		&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "read-pods", Namespace: "my-namespace"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "pod-reader"},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: "reader"},
+			},
+		},
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: ClusterAdminRole},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "my-namespace"},
+				{Kind: rbacv1.GroupKind, Name: "system:masters"},
+			},
+		},
+	)
+
+	d := NewKubernetesRBACDiscovery(client, testdata.MockTargetOfEvaluationID1)
+
+	got, err := d.List()
+	assert.NoError(t, err)
+
+	want := []ontology.IsResource{
+		&ontology.Identity{
+			Id:          "/namespaces/my-namespace/serviceaccounts/deployer",
+			Name:        "deployer",
+			Description: "Service account",
+			Labels:      map[string]string{LabelAutomountServiceAccountToken: "true"},
+			Activated:   true,
+			Privileged:  true,
+		},
+		&ontology.Identity{
+			Id:          "/namespaces/my-namespace/serviceaccounts/reader",
+			Name:        "reader",
+			Description: "Service account",
+			Labels:      map[string]string{LabelAutomountServiceAccountToken: "false"},
+			Activated:   true,
+		},
+		&ontology.Identity{
+			Id:          "/groups/system:masters",
+			Name:        "system:masters",
+			Description: "Group",
+			Activated:   true,
+			Privileged:  true,
+		},
+		&ontology.RoleAssignment{
+			Id:          "/namespaces/my-namespace/rolebindings/read-pods/subjects/0",
+			Name:        "pod-reader",
+			Description: "Role pod-reader bound to ServiceAccount reader via RoleBinding read-pods",
+			Labels:      map[string]string{LabelWildcardVerbs: "false", LabelClusterAdmin: "false"},
+			Activated:   true,
+			ParentId:    util.Ref("/namespaces/my-namespace/serviceaccounts/reader"),
+		},
+		&ontology.RoleAssignment{
+			Id:          "/clusterrolebindings/admins/subjects/0",
+			Name:        ClusterAdminRole,
+			Description: "ClusterRole cluster-admin bound to ServiceAccount deployer via ClusterRoleBinding admins",
+			Labels:      map[string]string{LabelWildcardVerbs: "true", LabelClusterAdmin: "true"},
+			Activated:   true,
+			ParentId:    util.Ref("/namespaces/my-namespace/serviceaccounts/deployer"),
+		},
+		&ontology.RoleAssignment{
+			Id:          "/clusterrolebindings/admins/subjects/1",
+			Name:        ClusterAdminRole,
+			Description: "ClusterRole cluster-admin bound to Group system:masters via ClusterRoleBinding admins",
+			Labels:      map[string]string{LabelWildcardVerbs: "true", LabelClusterAdmin: "true"},
+			Activated:   true,
+			ParentId:    util.Ref("/groups/system:masters"),
+		},
+	}
+
+	// We need to ignore creation_time and raw, because they contain the random creation time of the objects
+	assert.Equal(t, want, got,
+		protocmp.IgnoreFields(&ontology.Identity{}, "creation_time", "raw"),
+		protocmp.IgnoreFields(&ontology.RoleAssignment{}, "creation_time", "raw"))
+
+	for _, r := range got {
+		assert.NotEmpty(t, r.GetRaw())
+	}
+}
+
+func Test_hasWildcardVerbs(t *testing.T) {
+	tests := []struct {
+		name string
+		role any
+		want bool
+	}{
+		{
+			name: "missing role",
+			role: (*rbacv1.Role)(nil),
+			want: false,
+		},
+		{
+			name: "role without wildcard",
+			role: &rbacv1.Role{Rules: []rbacv1.PolicyRule{{Verbs: []string{"get"}}}},
+			want: false,
+		},
+		{
+			name: "cluster role with wildcard",
+			role: &rbacv1.ClusterRole{Rules: []rbacv1.PolicyRule{{Verbs: []string{"get"}}, {Verbs: []string{"*"}}}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasWildcardVerbs(tt.role))
+		})
+	}
+}
@@ -39,12 +39,12 @@ import (
 
 type k8sStorageDiscovery struct{ k8sDiscovery }
 
-func NewKubernetesStorageDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string) discovery.Discoverer {
-	return &k8sStorageDiscovery{k8sDiscovery{intf, TargetOfEvaluationID}}
+func NewKubernetesStorageDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sStorageDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
 }
 
-func (*k8sStorageDiscovery) Name() string {
-	return "Kubernetes Storage"
+func (d *k8sStorageDiscovery) Name() string {
+	return d.name("Kubernetes Storage")
 }
 
 func (*k8sStorageDiscovery) Description() string {
@@ -73,7 +73,7 @@ func (d *k8sStorageDiscovery) List() ([]ontology.IsResource, error) {
 		log.Debugf("No Kubernetes persistent volumes available")
 	}
 
-	return list, nil
+	return d.withCluster(list), nil
 }
 
 // handlePVC returns all PersistentVolumes
@@ -0,0 +1,501 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// EncryptionProviderIdentity is the default Kubernetes encryption provider, which stores secrets unencrypted.
+	EncryptionProviderIdentity = "identity"
+
+	// EncryptionProviderConfigured is used if the first provider of the encryption configuration of the API server
+	// encrypts secrets.
+	EncryptionProviderConfigured = "configured"
+
+	// EncryptionProviderUnknown is used if the API server configuration is not visible, e.g., in managed clusters.
+	EncryptionProviderUnknown = "unknown"
+)
+
+const (
+	// LabelPinnedByDigest is the label of a job or container image that indicates whether its images are pinned by
+	// digest.
+	LabelPinnedByDigest = "k8s:pinned-by-digest"
+
+	// LabelUsesMutableTags is the label of a job that indicates whether any of its images uses a mutable tag.
+	LabelUsesMutableTags = "k8s:uses-mutable-tags"
+
+	// LabelMutableTag is the label of a container image that indicates whether it uses a mutable tag.
+	LabelMutableTag = "k8s:mutable-tag"
+
+	// LabelEncryptionAtRestProvider is the label of a secret that contains the encryption at rest provider.
+	LabelEncryptionAtRestProvider = "k8s:encryption-at-rest-provider"
+)
+
+// encryptionProviderConfigFlag is the API server flag that points to the encryption configuration.
+const encryptionProviderConfigFlag = "--encryption-provider-config"
+
+// hostnameFunc returns the host name of the host the discovery runs on. It can be replaced in tests.
+var hostnameFunc = os.Hostname
+
+type k8sWorkloadDiscovery struct{ k8sDiscovery }
+
+// workloadProperties contains the image properties of a workload, for which the ontology has no dedicated fields.
+// They are added as labels and to the raw representation of the job.
+type workloadProperties struct {
+	Images          []string `json:"images"`
+	PinnedByDigest  bool     `json:"pinnedByDigest"`
+	UsesMutableTags bool     `json:"usesMutableTags"`
+}
+
+// labels returns the properties as resource labels.
+func (p *workloadProperties) labels() map[string]string {
+	return map[string]string{
+		LabelPinnedByDigest:  strconv.FormatBool(p.PinnedByDigest),
+		LabelUsesMutableTags: strconv.FormatBool(p.UsesMutableTags),
+	}
+}
+
+// imageReference is a parsed container image reference, e.g., "registry.io/app:1.0@sha256:...".
+type imageReference struct {
+	Reference      string `json:"reference"`
+	Repository     string `json:"repository"`
+	Tag            string `json:"tag"`
+	Digest         string `json:"digest"`
+	PinnedByDigest bool   `json:"pinnedByDigest"`
+	MutableTag     bool   `json:"mutableTag"`
+}
+
+// labels returns the image properties as resource labels.
+func (ref *imageReference) labels() map[string]string {
+	return map[string]string{
+		LabelPinnedByDigest: strconv.FormatBool(ref.PinnedByDigest),
+		LabelMutableTag:     strconv.FormatBool(ref.MutableTag),
+	}
+}
+
+// secretProperties contains the properties of a secret, for which the ontology has no dedicated fields. They are added
+// as labels and to the raw representation of the secret.
+type secretProperties struct {
+	EncryptionAtRestProvider string `json:"encryptionAtRestProvider"`
+}
+
+// labels returns the properties as resource labels.
+func (p *secretProperties) labels() map[string]string {
+	return map[string]string{
+		LabelEncryptionAtRestProvider: p.EncryptionAtRestProvider,
+	}
+}
+
+// encryptionConfiguration is the part of the encryption configuration of the API server that is needed to determine
+// the provider used to encrypt new secrets.
+type encryptionConfiguration struct {
+	Resources []struct {
+		Resources []string         `json:"resources"`
+		Providers []map[string]any `json:"providers"`
+	} `json:"resources"`
+}
+
+func NewKubernetesWorkloadDiscovery(intf kubernetes.Interface, TargetOfEvaluationID string, opts ...DiscoveryOption) discovery.Discoverer {
+	return &k8sWorkloadDiscovery{newK8sDiscovery(intf, TargetOfEvaluationID, opts...)}
+}
+
+func (d *k8sWorkloadDiscovery) Name() string {
+	return d.name("Kubernetes Workload")
+}
+
+func (*k8sWorkloadDiscovery) Description() string {
+	return "Discover Kubernetes workload resources."
+}
+
+func (d *k8sWorkloadDiscovery) List() ([]ontology.IsResource, error) {
+	var (
+		list   []ontology.IsResource
+		images = make(map[string]bool)
+	)
+
+	deployments, err := d.intf.AppsV1().Deployments("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list deployments: %w", err)
+	}
+
+	for i := range deployments.Items {
+		if !d.namespaceAllowed(deployments.Items[i].Namespace) {
+			continue
+		}
+
+		dep := &deployments.Items[i]
+		r := d.handleWorkload(&dep.ObjectMeta, "Deployment", &dep.Spec.Template.Spec, fmt.Sprintf("replicas: %d", util.Deref(dep.Spec.Replicas)), dep)
+
+		log.Infof("Adding deployment %+v", r.GetId())
+
+		list = append(list, r)
+		addImages(images, &dep.Spec.Template.Spec)
+	}
+
+	statefulSets, err := d.intf.AppsV1().StatefulSets("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list stateful sets: %w", err)
+	}
+
+	for i := range statefulSets.Items {
+		if !d.namespaceAllowed(statefulSets.Items[i].Namespace) {
+			continue
+		}
+
+		sts := &statefulSets.Items[i]
+		r := d.handleWorkload(&sts.ObjectMeta, "StatefulSet", &sts.Spec.Template.Spec, fmt.Sprintf("replicas: %d", util.Deref(sts.Spec.Replicas)), sts)
+
+		log.Infof("Adding stateful set %+v", r.GetId())
+
+		list = append(list, r)
+		addImages(images, &sts.Spec.Template.Spec)
+	}
+
+	daemonSets, err := d.intf.AppsV1().DaemonSets("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list daemon sets: %w", err)
+	}
+
+	for i := range daemonSets.Items {
+		if !d.namespaceAllowed(daemonSets.Items[i].Namespace) {
+			continue
+		}
+
+		ds := &daemonSets.Items[i]
+		r := d.handleWorkload(&ds.ObjectMeta, "DaemonSet", &ds.Spec.Template.Spec, "runs on every node", ds)
+
+		log.Infof("Adding daemon set %+v", r.GetId())
+
+		list = append(list, r)
+		addImages(images, &ds.Spec.Template.Spec)
+	}
+
+	cronJobs, err := d.intf.BatchV1().CronJobs("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list cron jobs: %w", err)
+	}
+
+	for i := range cronJobs.Items {
+		if !d.namespaceAllowed(cronJobs.Items[i].Namespace) {
+			continue
+		}
+
+		cj := &cronJobs.Items[i]
+		r := d.handleWorkload(&cj.ObjectMeta, "CronJob", &cj.Spec.JobTemplate.Spec.Template.Spec, fmt.Sprintf("schedule: %s", cj.Spec.Schedule), cj)
+
+		log.Infof("Adding cron job %+v", r.GetId())
+
+		list = append(list, r)
+		addImages(images, &cj.Spec.JobTemplate.Spec.Template.Spec)
+	}
+
+	// Bare pods are not part of any workload, but their images are relevant as well
+	pods, err := d.intf.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list pods: %w", err)
+	}
+
+	for i := range pods.Items {
+		if !d.namespaceAllowed(pods.Items[i].Namespace) {
+			continue
+		}
+
+		addImages(images, &pods.Items[i].Spec)
+	}
+
+	// Sort images to have a stable output
+	refs := make([]string, 0, len(images))
+	for ref := range images {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	for _, ref := range refs {
+		r := d.handleContainerImage(ref)
+
+		log.Infof("Adding container image %+v", r.GetId())
+
+		list = append(list, r)
+	}
+
+	// Secrets are listed without their data, so that their values are never transferred
+	if d.metadata == nil {
+		log.Warnf("Could not list secrets: no metadata client configured")
+		return d.withCluster(list), nil
+	}
+
+	secrets, err := d.metadata.Resource(corev1.SchemeGroupVersion.WithResource("secrets")).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list secrets: %w", err)
+	}
+
+	provider := d.encryptionProvider()
+
+	for i := range secrets.Items {
+		if !d.namespaceAllowed(secrets.Items[i].Namespace) {
+			continue
+		}
+
+		r := d.handleSecret(&secrets.Items[i], provider)
+
+		log.Infof("Adding secret %+v", r.GetId())
+
+		list = append(list, r)
+	}
+
+	return d.withCluster(list), nil
+}
+
+func (d *k8sWorkloadDiscovery) handleWorkload(meta *metav1.ObjectMeta, kind string, spec *corev1.PodSpec, details string, raw any) *ontology.Job {
+	props := &workloadProperties{
+		Images:         podImages(spec),
+		PinnedByDigest: true,
+	}
+
+	for _, image := range props.Images {
+		ref := parseImageReference(image)
+		props.PinnedByDigest = props.PinnedByDigest && ref.PinnedByDigest
+		props.UsesMutableTags = props.UsesMutableTags || ref.MutableTag
+	}
+
+	return &ontology.Job{
+		Id:           getWorkloadResourceID(kind, meta.Namespace, meta.Name),
+		Name:         meta.Name,
+		Description:  fmt.Sprintf("%s; %s; images: %s; pinned by digest: %t; uses mutable tags: %t", kind, details, strings.Join(props.Images, ","), props.PinnedByDigest, props.UsesMutableTags),
+		CreationTime: timestamppb.New(meta.CreationTimestamp.Time),
+		Labels:       withLabels(meta.Labels, props.labels()),
+		Raw:          discovery.Raw(raw, props),
+	}
+}
+
+func (d *k8sWorkloadDiscovery) handleContainerImage(image string) *ontology.ContainerImage {
+	ref := parseImageReference(image)
+
+	return &ontology.ContainerImage{
+		Id:          getContainerImageResourceID(image),
+		Name:        image,
+		Description: fmt.Sprintf("Container image %s; tag: %s; pinned by digest: %t; mutable tag: %t", ref.Repository, ref.Tag, ref.PinnedByDigest, ref.MutableTag),
+		Labels:      ref.labels(),
+		Raw:         discovery.Raw(ref),
+	}
+}
+
+func (d *k8sWorkloadDiscovery) handleSecret(secret *metav1.PartialObjectMetadata, provider string) *ontology.Secret {
+	props := &secretProperties{
+		EncryptionAtRestProvider: provider,
+	}
+
+	return &ontology.Secret{
+		Id:           getSecretResourceID(secret),
+		Name:         secret.Name,
+		Description:  fmt.Sprintf("Secret; encryption at rest provider: %s", props.EncryptionAtRestProvider),
+		CreationTime: timestamppb.New(secret.CreationTimestamp.Time),
+		Labels:       withLabels(secret.Labels, props.labels()),
+		Enabled:      true,
+		Raw:          discovery.Raw(redactSecret(secret), props),
+	}
+}
+
+// encryptionProvider determines the encryption at rest provider of secrets from the encryption configuration of the
+// API server. The API server is only visible as a static pod in self-managed clusters and its configuration can only
+// be read, if the discovery runs on the control plane node of the API server. Otherwise, e.g., if multiple clusters are
+// discovered from a workstation, the provider is unknown.
+func (d *k8sWorkloadDiscovery) encryptionProvider() string {
+	pods, err := d.intf.CoreV1().Pods(metav1.NamespaceSystem).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: "component=kube-apiserver",
+	})
+	if err != nil || len(pods.Items) == 0 {
+		return EncryptionProviderUnknown
+	}
+
+	pod := pods.Items[0]
+
+	for _, c := range pod.Spec.Containers {
+		args := append(slices.Clone(c.Command), c.Args...)
+		for i, arg := range args {
+			var path string
+
+			switch {
+			case strings.HasPrefix(arg, encryptionProviderConfigFlag+"="):
+				path = strings.TrimPrefix(arg, encryptionProviderConfigFlag+"=")
+			case arg == encryptionProviderConfigFlag && i+1 < len(args):
+				path = args[i+1]
+			default:
+				continue
+			}
+
+			// The path refers to the file system of the node the API server runs on
+			if !isLocalNode(pod.Spec.NodeName) {
+				log.Debugf("Could not read encryption configuration of the API server, since it runs on node '%s'", pod.Spec.NodeName)
+				return EncryptionProviderUnknown
+			}
+
+			provider, err := secretsEncryptionProvider(path)
+			if err != nil {
+				log.Debugf("Could not read encryption configuration of the API server: %v", err)
+				return EncryptionProviderUnknown
+			}
+
+			return provider
+		}
+	}
+
+	return EncryptionProviderIdentity
+}
+
+// isLocalNode returns true, if the node is the host the discovery runs on. Node names are usually the (lower case) host
+// names of the nodes, but might be either fully qualified or not.
+func isLocalNode(nodeName string) bool {
+	hostname, err := hostnameFunc()
+	if err != nil || nodeName == "" {
+		return false
+	}
+
+	short := func(name string) string {
+		name, _, _ = strings.Cut(name, ".")
+		return name
+	}
+
+	return strings.EqualFold(nodeName, hostname) || strings.EqualFold(short(nodeName), short(hostname))
+}
+
+// secretsEncryptionProvider reads the encryption configuration at path and returns whether the first provider that
+// applies to secrets encrypts them. Only the first provider is used to encrypt new data, the others are only used for
+// decryption.
+func secretsEncryptionProvider(path string) (string, error) {
+	var config encryptionConfiguration
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err = yaml.Unmarshal(b, &config); err != nil {
+		return "", fmt.Errorf("could not parse encryption configuration: %w", err)
+	}
+
+	for _, r := range config.Resources {
+		if !slices.ContainsFunc(r.Resources, func(resource string) bool {
+			return resource == "secrets" || resource == "*." || resource == "*.*"
+		}) {
+			continue
+		}
+
+		if len(r.Providers) == 0 {
+			break
+		}
+
+		if _, ok := r.Providers[0][EncryptionProviderIdentity]; ok {
+			return EncryptionProviderIdentity, nil
+		}
+
+		return EncryptionProviderConfigured, nil
+	}
+
+	// Resources that are not part of the configuration are not encrypted
+	return EncryptionProviderIdentity, nil
+}
+
+// redactSecret returns a copy of the secret metadata without the last applied configuration, which contains the
+// values if the secret was created with kubectl apply.
+func redactSecret(secret *metav1.PartialObjectMetadata) *metav1.PartialObjectMetadata {
+	s := secret.DeepCopy()
+	delete(s.Annotations, corev1.LastAppliedConfigAnnotation)
+
+	return s
+}
+
+// podImages returns the images of all (init) containers of a pod.
+func podImages(spec *corev1.PodSpec) (images []string) {
+	for _, c := range append(slices.Clone(spec.InitContainers), spec.Containers...) {
+		images = append(images, c.Image)
+	}
+
+	return
+}
+
+func addImages(images map[string]bool, spec *corev1.PodSpec) {
+	for _, image := range podImages(spec) {
+		images[image] = true
+	}
+}
+
+// parseImageReference splits an image reference into repository, tag and digest. Images without tag and digest
+// implicitly use the mutable "latest" tag.
+func parseImageReference(image string) *imageReference {
+	ref := &imageReference{Reference: image, Repository: image}
+
+	if repo, digest, ok := strings.Cut(ref.Repository, "@"); ok {
+		ref.Repository = repo
+		ref.Digest = digest
+	}
+
+	// A colon after the last slash separates the tag, other colons belong to the registry port
+	if i := strings.LastIndex(ref.Repository, ":"); i > strings.LastIndex(ref.Repository, "/") {
+		ref.Tag = ref.Repository[i+1:]
+		ref.Repository = ref.Repository[:i]
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+
+	ref.PinnedByDigest = ref.Digest != ""
+	ref.MutableTag = !ref.PinnedByDigest && ref.Tag == "latest"
+
+	return ref
+}
+
+// getWorkloadResourceID returns the resource ID of a workload of the given kind, e.g., a Deployment.
+func getWorkloadResourceID(kind string, namespace string, name string) string {
+	return fmt.Sprintf("/namespaces/%s/%ss/%s", namespace, strings.ToLower(kind), name)
+}
+
+func getContainerImageResourceID(image string) string {
+	return fmt.Sprintf("/images/%s", image)
+}
+
+func getSecretResourceID(secret *metav1.PartialObjectMetadata) string {
+	return fmt.Sprintf("/namespaces/%s/secrets/%s", secret.Namespace, secret.Name)
+}
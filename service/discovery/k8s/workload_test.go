@@ -0,0 +1,328 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package k8s
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/testing/protocmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+const mockDigest = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+func TestNewKubernetesWorkloadDiscovery(t *testing.T) {
+	type args struct {
+		intf                 kubernetes.Interface
+		TargetOfEvaluationID string
+	}
+	tests := []struct {
+		name string
+		args args
+		want discovery.Discoverer
+	}{
+		{
+			name: "Happy path",
+			args: args{
+				intf:                 &fake.Clientset{},
+				TargetOfEvaluationID: testdata.MockTargetOfEvaluationID1,
+			},
+			want: &k8sWorkloadDiscovery{
+				k8sDiscovery: k8sDiscovery{
+					intf: &fake.Clientset{},
+					ctID: testdata.MockTargetOfEvaluationID1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewKubernetesWorkloadDiscovery(tt.args.intf, tt.args.TargetOfEvaluationID)
+			assert.Equal(t, tt.want, got, assert.CompareAllUnexported())
+			assert.Equal(t, "Kubernetes Workload", got.Name())
+		})
+	}
+}
+
+func Test_k8sWorkloadDiscovery_List(t *testing.T) {
+	mockHostname(t, "control-plane")
+
+	encryptionConfig := writeEncryptionConfig(t, "aescbc")
+
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "my-namespace"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: util.Ref[int32](3),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "web", Image: "nginx"}},
+					},
+				},
+			},
+		},
+		&batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "my-namespace"},
+			Spec: batchv1.CronJobSpec{
+				Schedule: "0 * * * *",
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "backup", Image: "registry.io:5000/backup:1.0@" + mockDigest}},
+							},
+						},
+					},
+				},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{"component": "kube-apiserver"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "control-plane",
+				Containers: []corev1.Container{
+					{
+						Name:    "kube-apiserver",
+						Image:   "registry.k8s.io/kube-apiserver:v1.33.0",
+						Command: []string{"kube-apiserver", "--encryption-provider-config=" + encryptionConfig},
+					},
+				},
+			},
+		},
+	)
+
+	scheme := metadatafake.NewTestScheme()
+	assert.NoError(t, metav1.AddMetaToScheme(scheme))
+
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme,
+		&metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "credentials",
+				Namespace:   "my-namespace",
+				Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: `{"data":{"password":"c2VjcmV0"}}`},
+			},
+		},
+	)
+
+	d := NewKubernetesWorkloadDiscovery(client, testdata.MockTargetOfEvaluationID1, WithMetadataClient(metadataClient))
+
+	got, err := d.List()
+	assert.NoError(t, err)
+
+	want := []ontology.IsResource{
+		&ontology.Job{
+			Id:          "/namespaces/my-namespace/deployments/web",
+			Name:        "web",
+			Description: "Deployment; replicas: 3; images: nginx; pinned by digest: false; uses mutable tags: true",
+			Labels:      map[string]string{LabelPinnedByDigest: "false", LabelUsesMutableTags: "true"},
+		},
+		&ontology.Job{
+			Id:          "/namespaces/my-namespace/cronjobs/backup",
+			Name:        "backup",
+			Description: "CronJob; schedule: 0 * * * *; images: registry.io:5000/backup:1.0@" + mockDigest + "; pinned by digest: true; uses mutable tags: false",
+			Labels:      map[string]string{LabelPinnedByDigest: "true", LabelUsesMutableTags: "false"},
+		},
+		&ontology.ContainerImage{
+			Id:          "/images/nginx",
+			Name:        "nginx",
+			Description: "Container image nginx; tag: latest; pinned by digest: false; mutable tag: true",
+			Labels:      map[string]string{LabelPinnedByDigest: "false", LabelMutableTag: "true"},
+		},
+		&ontology.ContainerImage{
+			Id:          "/images/registry.io:5000/backup:1.0@" + mockDigest,
+			Name:        "registry.io:5000/backup:1.0@" + mockDigest,
+			Description: "Container image registry.io:5000/backup; tag: 1.0; pinned by digest: true; mutable tag: false",
+			Labels:      map[string]string{LabelPinnedByDigest: "true", LabelMutableTag: "false"},
+		},
+		&ontology.ContainerImage{
+			Id:          "/images/registry.k8s.io/kube-apiserver:v1.33.0",
+			Name:        "registry.k8s.io/kube-apiserver:v1.33.0",
+			Description: "Container image registry.k8s.io/kube-apiserver; tag: v1.33.0; pinned by digest: false; mutable tag: false",
+			Labels:      map[string]string{LabelPinnedByDigest: "false", LabelMutableTag: "false"},
+		},
+		&ontology.Secret{
+			Id:          "/namespaces/my-namespace/secrets/credentials",
+			Name:        "credentials",
+			Description: "Secret; encryption at rest provider: configured",
+			Labels:      map[string]string{LabelEncryptionAtRestProvider: EncryptionProviderConfigured},
+			Enabled:     true,
+		},
+	}
+
+	// We need to ignore creation_time and raw, because they contain the random creation time of the objects
+	assert.Equal(t, want, got,
+		protocmp.IgnoreFields(&ontology.Job{}, "creation_time", "raw"),
+		protocmp.IgnoreFields(&ontology.ContainerImage{}, "raw"),
+		protocmp.IgnoreFields(&ontology.Secret{}, "creation_time", "raw"))
+
+	// The secret value must never be part of the raw representation
+	secret := got[len(got)-1]
+	assert.NotEmpty(t, secret.GetRaw())
+	assert.False(t, strings.Contains(secret.GetRaw(), "c2VjcmV0"))
+	assert.False(t, strings.Contains(secret.GetRaw(), "password"))
+}
+
+func Test_k8sWorkloadDiscovery_encryptionProvider(t *testing.T) {
+	mockHostname(t, "control-plane.example.com")
+
+	apiServerOnNode := func(node string, args ...string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-apiserver",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{"component": "kube-apiserver"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:   node,
+				Containers: []corev1.Container{{Name: "kube-apiserver", Command: append([]string{"kube-apiserver"}, args...)}},
+			},
+		}
+	}
+	apiServer := func(args ...string) *corev1.Pod {
+		return apiServerOnNode("control-plane", args...)
+	}
+
+	tests := []struct {
+		name string
+		intf kubernetes.Interface
+		want string
+	}{
+		{
+			name: "API server not visible",
+			intf: fake.NewSimpleClientset(),
+			want: EncryptionProviderUnknown,
+		},
+		{
+			name: "no encryption configured",
+			intf: fake.NewSimpleClientset(apiServer()),
+			want: EncryptionProviderIdentity,
+		},
+		{
+			name: "API server runs on another node",
+			intf: fake.NewSimpleClientset(apiServerOnNode("other-cluster", "--encryption-provider-config="+writeEncryptionConfig(t, "aescbc"))),
+			want: EncryptionProviderUnknown,
+		},
+		{
+			name: "encryption configuration not readable",
+			intf: fake.NewSimpleClientset(apiServer("--encryption-provider-config=" + filepath.Join(t.TempDir(), "missing.yaml"))),
+			want: EncryptionProviderUnknown,
+		},
+		{
+			name: "identity is the first provider",
+			intf: fake.NewSimpleClientset(apiServer("--encryption-provider-config=" + writeEncryptionConfig(t, "identity", "aescbc"))),
+			want: EncryptionProviderIdentity,
+		},
+		{
+			name: "encrypting provider is the first provider",
+			intf: fake.NewSimpleClientset(apiServer("--encryption-provider-config", writeEncryptionConfig(t, "kms", "identity"))),
+			want: EncryptionProviderConfigured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &k8sWorkloadDiscovery{k8sDiscovery{intf: tt.intf}}
+			assert.Equal(t, tt.want, d.encryptionProvider())
+		})
+	}
+}
+
+// mockHostname replaces the host name of the discovery host for the duration of the test
+func mockHostname(t *testing.T, hostname string) {
+	old := hostnameFunc
+	hostnameFunc = func() (string, error) { return hostname, nil }
+
+	t.Cleanup(func() { hostnameFunc = old })
+}
+
+// writeEncryptionConfig writes an encryption configuration for secrets with the given providers and returns its path.
+func writeEncryptionConfig(t *testing.T, providers ...string) string {
+	config := "apiVersion: apiserver.config.k8s.io/v1\nkind: EncryptionConfiguration\nresources:\n  - resources:\n      - secrets\n    providers:\n"
+	for _, p := range providers {
+		config += fmt.Sprintf("      - %s: {}\n", p)
+	}
+
+	path := filepath.Join(t.TempDir(), "encryption.yaml")
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatalf("could not write encryption configuration: %v", err)
+	}
+
+	return path
+}
+
+func Test_parseImageReference(t *testing.T) {
+	tests := []struct {
+		name  string
+		image string
+		want  *imageReference
+	}{
+		{
+			name:  "implicit latest",
+			image: "nginx",
+			want:  &imageReference{Reference: "nginx", Repository: "nginx", Tag: "latest", MutableTag: true},
+		},
+		{
+			name:  "explicit latest",
+			image: "docker.io/library/nginx:latest",
+			want:  &imageReference{Reference: "docker.io/library/nginx:latest", Repository: "docker.io/library/nginx", Tag: "latest", MutableTag: true},
+		},
+		{
+			name:  "registry with port",
+			image: "localhost:5000/app:1.2",
+			want:  &imageReference{Reference: "localhost:5000/app:1.2", Repository: "localhost:5000/app", Tag: "1.2"},
+		},
+		{
+			name:  "digest only",
+			image: "localhost:5000/app@" + mockDigest,
+			want:  &imageReference{Reference: "localhost:5000/app@" + mockDigest, Repository: "localhost:5000/app", Digest: mockDigest, PinnedByDigest: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseImageReference(tt.image))
+		})
+	}
+}
@@ -30,8 +30,11 @@ import (
 
 	"clouditor.io/clouditor/v2/api/discovery"
 	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/users"
 )
 
 // discoverDomains discovers domains.
@@ -83,3 +86,35 @@ func (d *openstackDiscovery) discoverProjects() (list []ontology.IsResource, err
 
 	return list, nil
 }
+
+// discoverUsers discovers Keystone users.
+func (d *openstackDiscovery) discoverUsers() (list []ontology.IsResource, err error) {
+	var opts users.ListOptsBuilder = &users.ListOpts{}
+	list, err = genericList(d, d.identityClient, users.List, d.handleUser, users.ExtractUsers, opts)
+
+	// Listing users usually requires admin permissions, so we proceed without the user information
+	if err != nil {
+		log.Debugf("Could not discover users due to insufficient permissions, but we can proceed without user information: %v", err)
+		return nil, nil
+	}
+
+	return list, nil
+}
+
+// discoverRoleAssignments discovers the effective Keystone role assignments, i.e., role assignments of groups are
+// resolved to their members.
+func (d *openstackDiscovery) discoverRoleAssignments() (list []ontology.IsResource, err error) {
+	var opts roles.ListAssignmentsOptsBuilder = &roles.ListAssignmentsOpts{
+		Effective:    util.Ref(true),
+		IncludeNames: util.Ref(true),
+	}
+	list, err = genericList(d, d.identityClient, roles.ListAssignments, d.handleRoleAssignment, roles.ExtractRoleAssignments, opts)
+
+	// Listing role assignments usually requires admin permissions, so we proceed without the role assignment information
+	if err != nil {
+		log.Debugf("Could not discover role assignments due to insufficient permissions, but we can proceed without role assignment information: %v", err)
+		return nil, nil
+	}
+
+	return list, nil
+}
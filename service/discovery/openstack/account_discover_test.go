@@ -225,3 +225,191 @@ func Test_openstackDiscovery_discoverDomain(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_discoverUsers(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	openstacktest.HandleListUsersSuccessfully(t)
+
+	type fields struct {
+		ctID                 string
+		clients              clients
+		authOpts             *gophercloud.AuthOptions
+		domain               *domain
+		project              *project
+		privilegedIdentities map[string]bool
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "insufficient permissions: no error",
+			fields: fields{
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				domain:  &domain{},
+				project: &project{},
+				privilegedIdentities: map[string]bool{
+					"9fe1d3": true,
+				},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				got0, ok := got[0].(*ontology.Identity)
+				assert.True(t, ok)
+				assert.False(t, got0.GetPrivileged())
+				assert.False(t, got0.GetEnforceMfa())
+
+				want := &ontology.Identity{
+					Id:         "9fe1d3",
+					Name:       "jsmith",
+					Activated:  true,
+					EnforceMfa: true,
+					Privileged: true,
+					ParentId:   util.Ref("1789d1"),
+				}
+
+				got1, ok := got[1].(*ontology.Identity)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				return assert.Equal(t, want, got1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:                 tt.fields.ctID,
+				clients:              tt.fields.clients,
+				authOpts:             tt.fields.authOpts,
+				domain:               tt.fields.domain,
+				configuredProject:    tt.fields.project,
+				privilegedIdentities: tt.fields.privilegedIdentities,
+			}
+			gotList, err := d.discoverUsers()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_openstackDiscovery_discoverRoleAssignments(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	openstacktest.HandleListRoleAssignmentsSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		domain   *domain
+		project  *project
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "insufficient permissions: no error",
+			fields: fields{
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				want := &ontology.RoleAssignment{
+					Id:          "projects/456789/users/9fe1d3/roles/654321",
+					Name:        "admin",
+					Description: "Role admin assigned to user jsmith on project project",
+					Activated:   true,
+					ParentId:    util.Ref("9fe1d3"),
+				}
+
+				got1, ok := got[1].(*ontology.RoleAssignment)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				return assert.Equal(t, want, got1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:              tt.fields.ctID,
+				clients:           tt.fields.clients,
+				authOpts:          tt.fields.authOpts,
+				domain:            tt.fields.domain,
+				configuredProject: tt.fields.project,
+			}
+			gotList, err := d.discoverRoleAssignments()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+
+			// Only the actor of the admin role assignment is privileged
+			if gotList != nil {
+				assert.Equal(t, map[string]bool{"9fe1d3": true}, d.privilegedIdentities)
+			}
+		})
+	}
+}
@@ -34,6 +34,8 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/users"
 )
 
 // handleDomain returns a [ontology.Account] out of an existing [domains.Domain].
@@ -99,3 +101,62 @@ func (d *openstackDiscovery) addProjectIfMissing(projectID, projectName, domainI
 
 	return nil
 }
+
+// handleUser returns a [ontology.Identity] out of an existing [users.User].
+func (d *openstackDiscovery) handleUser(user *users.User) (ontology.IsResource, error) {
+	r := &ontology.Identity{
+		Id:           user.ID,
+		Name:         user.Name,
+		Description:  user.Description,
+		CreationTime: nil, // user does not have a creation date
+		Activated:    user.Enabled,
+		EnforceMfa:   isMFAEnabled(user.Options),
+		Privileged:   d.privilegedIdentities[user.ID],
+		Labels:       nil, // user does not have labels
+		ParentId:     util.Ref(user.DomainID),
+		Raw:          discovery.Raw(user),
+	}
+
+	log.Infof("Adding user '%s'", r.Name)
+
+	return r, nil
+}
+
+// handleRoleAssignment returns a [ontology.RoleAssignment] out of an existing [roles.RoleAssignment]. Users and groups
+// with a privileged role are remembered, so that the corresponding identities can be marked as privileged.
+func (d *openstackDiscovery) handleRoleAssignment(ra *roles.RoleAssignment) (ontology.IsResource, error) {
+	var (
+		actorType, actorID, actorName = roleAssignmentActor(ra)
+		scopeType, scopeID, scopeName = roleAssignmentScope(ra)
+		name                          = ra.Role.Name
+	)
+
+	if actorID == "" {
+		return nil, fmt.Errorf("role assignment of role '%s' has neither a user nor a group", ra.Role.ID)
+	}
+
+	if name == "" {
+		name = ra.Role.ID
+	}
+
+	if isPrivilegedRole(ra.Role.Name) {
+		if d.privilegedIdentities == nil {
+			d.privilegedIdentities = make(map[string]bool)
+		}
+		d.privilegedIdentities[actorID] = true
+	}
+
+	r := &ontology.RoleAssignment{
+		Id:           fmt.Sprintf("%ss/%s/%ss/%s/roles/%s", scopeType, scopeID, actorType, actorID, ra.Role.ID),
+		Name:         name,
+		Description:  fmt.Sprintf("Role %s assigned to %s %s on %s %s", name, actorType, actorName, scopeType, scopeName),
+		CreationTime: nil, // role assignment does not have a creation date
+		Activated:    true,
+		ParentId:     util.Ref(actorID),
+		Raw:          discovery.Raw(ra),
+	}
+
+	log.Infof("Adding role assignment '%s'", r.Id)
+
+	return r, nil
+}
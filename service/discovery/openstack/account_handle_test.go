@@ -36,6 +36,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
 )
 
 func Test_openstackDiscovery_handleProject(t *testing.T) {
@@ -277,3 +278,67 @@ func Test_openstackDiscovery_checkAndHandleManualCreatedProject(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_handleRoleAssignment(t *testing.T) {
+	type args struct {
+		ra *roles.RoleAssignment
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           assert.Want[ontology.IsResource]
+		wantPrivileged map[string]bool
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error: no actor",
+			args: args{
+				ra: &roles.RoleAssignment{
+					Role: roles.AssignedRole{ID: "123456"},
+				},
+			},
+			want: assert.Nil[ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "role assignment of role '123456' has neither a user nor a group")
+			},
+		},
+		{
+			name: "Happy path: group without names",
+			args: args{
+				ra: &roles.RoleAssignment{
+					Role:  roles.AssignedRole{ID: "654321", Name: "admin"},
+					Scope: roles.Scope{Domain: roles.Domain{ID: "161718"}},
+					Group: roles.Group{ID: "101112"},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource) bool {
+				want := &ontology.RoleAssignment{
+					Id:          "domains/161718/groups/101112/roles/654321",
+					Name:        "admin",
+					Description: "Role admin assigned to group 101112 on domain 161718",
+					Activated:   true,
+					ParentId:    util.Ref("101112"),
+				}
+
+				gotNew, ok := got.(*ontology.RoleAssignment)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantPrivileged: map[string]bool{"101112": true},
+			wantErr:        assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{}
+			got, err := d.handleRoleAssignment(tt.args.ra)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.wantPrivileged, d.privilegedIdentities)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+)
+
+// privilegedRoles contains the names of Keystone roles that grant administrative access.
+var privilegedRoles = []string{"admin"}
+
+// isPrivilegedRole returns true, if the role grants administrative access.
+func isPrivilegedRole(name string) bool {
+	return slices.Contains(privilegedRoles, name)
+}
+
+// isMFAEnabled returns true, if multi-factor authentication is enabled in the options of a Keystone user.
+func isMFAEnabled(options map[string]any) bool {
+	enabled, ok := options["multi_factor_auth_enabled"].(bool)
+
+	return ok && enabled
+}
+
+// roleAssignmentActor returns the type ("user" or "group"), ID and name of the actor of the role assignment. The name
+// falls back to the ID, if names are not included.
+func roleAssignmentActor(ra *roles.RoleAssignment) (typ string, id string, name string) {
+	if ra.User.ID != "" {
+		typ, id, name = "user", ra.User.ID, ra.User.Name
+	} else if ra.Group.ID != "" {
+		typ, id, name = "group", ra.Group.ID, ra.Group.Name
+	}
+
+	if name == "" {
+		name = id
+	}
+
+	return
+}
+
+// roleAssignmentScope returns the type ("project" or "domain"), ID and name of the scope of the role assignment. The
+// name falls back to the ID, if names are not included.
+func roleAssignmentScope(ra *roles.RoleAssignment) (typ string, id string, name string) {
+	if ra.Scope.Project.ID != "" {
+		typ, id, name = "project", ra.Scope.Project.ID, ra.Scope.Project.Name
+	} else {
+		typ, id, name = "domain", ra.Scope.Domain.ID, ra.Scope.Domain.Name
+	}
+
+	if name == "" {
+		name = id
+	}
+
+	return
+}
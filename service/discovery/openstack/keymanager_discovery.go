@@ -0,0 +1,50 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	secretcontainers "github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+)
+
+// discoverSecrets discovers Barbican secrets
+func (d *openstackDiscovery) discoverSecrets() (list []ontology.IsResource, err error) {
+	var opts secrets.ListOptsBuilder = &secrets.ListOpts{}
+	list, err = genericList(d, d.keyManagerClient, secrets.List, d.handleSecret, secrets.ExtractSecrets, opts)
+
+	return
+}
+
+// discoverSecretContainers discovers Barbican containers, e.g., the certificate containers used by TLS terminating
+// load balancer listeners
+func (d *openstackDiscovery) discoverSecretContainers() (list []ontology.IsResource, err error) {
+	var opts secretcontainers.ListOptsBuilder = &secretcontainers.ListOpts{}
+	list, err = genericList(d, d.keyManagerClient, secretcontainers.List, d.handleSecretContainer, secretcontainers.ExtractContainers, opts)
+
+	return
+}
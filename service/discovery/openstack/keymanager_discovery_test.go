@@ -0,0 +1,253 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/openstacktest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_openstackDiscovery_discoverSecrets(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	openstacktest.HandleListSecretsSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					keyManagerClient: client.ServiceClient(),
+				},
+				region: "test region",
+				domain: &domain{},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse("2006-01-02T15:04:05", "2018-06-21T02:49:48")
+				assert.NoError(t, err)
+
+				want := &ontology.Secret{
+					Id:           "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+					Name:         "mysecret",
+					Description:  "Barbican opaque secret",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					KeySize:   256,
+					Enabled:   true,
+					IsManaged: true,
+					Labels:    map[string]string{},
+					ParentId:  util.Ref(testdata.MockOpenstackProjectID1),
+				}
+
+				got0, ok := got[0].(*ontology.Secret)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, want, got0)
+
+				t2, err := time.Parse("2006-01-02T15:04:05", "2018-06-21T05:18:45")
+				assert.NoError(t, err)
+				t3, err := time.Parse("2006-01-02T15:04:05", "2028-06-21T05:18:45")
+				assert.NoError(t, err)
+
+				wantKey := &ontology.Key{
+					Id:             "http://barbican:9311/v1/secrets/1b12b69a-8822-442e-a303-da24ade648ac",
+					Name:           "anothersecret",
+					Description:    "Barbican symmetric key; mode: cbc",
+					CreationTime:   timestamppb.New(t2),
+					ExpirationDate: timestamppb.New(t3),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Algorithm: "AES",
+					KeySize:   256,
+					Enabled:   true,
+					IsManaged: true,
+					Labels:    map[string]string{},
+					ParentId:  util.Ref(testdata.MockOpenstackProjectID1),
+				}
+
+				got1, ok := got[1].(*ontology.Key)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				return assert.Equal(t, wantKey, got1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:              tt.fields.ctID,
+				clients:           tt.fields.clients,
+				authOpts:          tt.fields.authOpts,
+				region:            tt.fields.region,
+				domain:            tt.fields.domain,
+				configuredProject: tt.fields.project,
+			}
+			gotList, err := d.discoverSecrets()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_openstackDiscovery_discoverSecretContainers(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	openstacktest.HandleListContainersSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					keyManagerClient: client.ServiceClient(),
+				},
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse("2006-01-02T15:04:05", "2018-06-21T21:28:37")
+				assert.NoError(t, err)
+
+				want := &ontology.Secret{
+					Id:           "http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0",
+					Name:         "mycontainer",
+					Description:  "Barbican certificate container with the secrets: certificate",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Enabled:   true,
+					IsManaged: true,
+					Labels:    map[string]string{},
+				}
+
+				got0, ok := got[0].(*ontology.Secret)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				return assert.Equal(t, want, got0)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:              tt.fields.ctID,
+				clients:           tt.fields.clients,
+				authOpts:          tt.fields.authOpts,
+				region:            tt.fields.region,
+				domain:            tt.fields.domain,
+				configuredProject: tt.fields.project,
+			}
+			gotList, err := d.discoverSecretContainers()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
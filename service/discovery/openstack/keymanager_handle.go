@@ -0,0 +1,129 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	secretcontainers "github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// handleSecret creates a key resource for symmetric, public and private keys and a secret resource for all other
+// Barbican secrets, e.g., passphrases or certificates. The payload of the secret is never retrieved.
+func (d *openstackDiscovery) handleSecret(secret *secrets.Secret) (ontology.IsResource, error) {
+	var (
+		r        ontology.IsResource
+		name     = barbicanName(secret.Name, secret.SecretRef)
+		enabled  = isBarbicanResourceActive(secret.Status) && !isExpired(secret.Expiration)
+		parentId *string
+	)
+
+	// Barbican secrets belong to the project
+	if d.configuredProject.projectID != "" {
+		parentId = util.Ref(d.configuredProject.projectID)
+	}
+
+	if isKeySecretType(secret.SecretType) {
+		r = &ontology.Key{
+			Id:             secret.SecretRef,
+			Name:           name,
+			Description:    fmt.Sprintf("Barbican %s key; mode: %s", secret.SecretType, secret.Mode),
+			CreationTime:   timestamppb.New(secret.Created),
+			ExpirationDate: optionalTimestamp(secret.Expiration),
+			GeoLocation: &ontology.GeoLocation{
+				Region: d.region,
+			},
+			Algorithm: strings.ToUpper(secret.Algorithm),
+			KeySize:   int32(secret.BitLength),
+			Enabled:   enabled,
+			IsManaged: true,
+			Labels:    map[string]string{}, // Not available
+			ParentId:  parentId,
+			Raw:       discovery.Raw(secret),
+		}
+	} else {
+		r = &ontology.Secret{
+			Id:             secret.SecretRef,
+			Name:           name,
+			Description:    fmt.Sprintf("Barbican %s secret", secret.SecretType),
+			CreationTime:   timestamppb.New(secret.Created),
+			ExpirationDate: optionalTimestamp(secret.Expiration),
+			GeoLocation: &ontology.GeoLocation{
+				Region: d.region,
+			},
+			KeySize:   int32(secret.BitLength),
+			Enabled:   enabled,
+			IsManaged: true,
+			Labels:    map[string]string{}, // Not available
+			ParentId:  parentId,
+			Raw:       discovery.Raw(secret),
+		}
+	}
+
+	log.Infof("Adding secret '%s'", name)
+
+	return r, nil
+}
+
+// handleSecretContainer creates a secret resource based on the Clouditor Ontology for a Barbican container, which
+// groups secrets, e.g., a certificate with its private key and intermediates.
+func (d *openstackDiscovery) handleSecretContainer(container *secretcontainers.Container) (ontology.IsResource, error) {
+	var names []string
+
+	for _, ref := range container.SecretRefs {
+		names = append(names, ref.Name)
+	}
+
+	r := &ontology.Secret{
+		Id:           container.ContainerRef,
+		Name:         barbicanName(container.Name, container.ContainerRef),
+		Description:  fmt.Sprintf("Barbican %s container with the secrets: %s", container.Type, strings.Join(names, ", ")),
+		CreationTime: timestamppb.New(container.Created),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.region,
+		},
+		Enabled:   isBarbicanResourceActive(container.Status),
+		IsManaged: true,
+		Labels:    map[string]string{}, // Not available
+		Raw:       discovery.Raw(container),
+	}
+
+	// Barbican containers belong to the project
+	if d.configuredProject.projectID != "" {
+		r.ParentId = util.Ref(d.configuredProject.projectID)
+	}
+
+	log.Infof("Adding secret container '%s'", r.Name)
+
+	return r, nil
+}
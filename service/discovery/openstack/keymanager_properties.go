@@ -0,0 +1,71 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"path"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// keySecretTypes contains the Barbican secret types that contain cryptographic keys.
+var keySecretTypes = []string{"symmetric", "public", "private"}
+
+// isKeySecretType returns true, if the Barbican secret type contains a cryptographic key.
+func isKeySecretType(secretType string) bool {
+	return slices.Contains(keySecretTypes, secretType)
+}
+
+// isBarbicanResourceActive returns true, if the status of the Barbican secret or container is active.
+func isBarbicanResourceActive(status string) bool {
+	return status == "ACTIVE"
+}
+
+// isExpired returns true, if the expiration date is set and lies in the past.
+func isExpired(expiration time.Time) bool {
+	return !expiration.IsZero() && expiration.Before(time.Now())
+}
+
+// optionalTimestamp converts the time into a timestamp. Nil is returned if the time is not set.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// barbicanName returns the name of the Barbican secret or container. Since names are optional in Barbican, the UUID
+// of the reference is used if no name is given.
+func barbicanName(name string, ref string) string {
+	if name != "" {
+		return name
+	}
+
+	return path.Base(ref)
+}
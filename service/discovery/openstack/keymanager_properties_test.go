@@ -0,0 +1,102 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_isExpired(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		want       bool
+	}{
+		{
+			name:       "no expiration date",
+			expiration: time.Time{},
+			want:       false,
+		},
+		{
+			name:       "expired",
+			expiration: time.Now().Add(-time.Hour),
+			want:       true,
+		},
+		{
+			name:       "not expired",
+			expiration: time.Now().Add(time.Hour),
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isExpired(tt.expiration))
+		})
+	}
+}
+
+func Test_barbicanName(t *testing.T) {
+	type args struct {
+		name string
+		ref  string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "name given",
+			args: args{
+				name: "mysecret",
+				ref:  "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+			},
+			want: "mysecret",
+		},
+		{
+			name: "no name given",
+			args: args{
+				ref: "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+			},
+			want: "1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, barbicanName(tt.args.name, tt.args.ref))
+		})
+	}
+}
+
+func Test_isKeySecretType(t *testing.T) {
+	assert.True(t, isKeySecretType("symmetric"))
+	assert.True(t, isKeySecretType("private"))
+	assert.False(t, isKeySecretType("opaque"))
+	assert.False(t, isKeySecretType("certificate"))
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+)
+
+// discoverLoadBalancers discovers Octavia load balancers together with their listeners
+func (d *openstackDiscovery) discoverLoadBalancers() (list []ontology.IsResource, err error) {
+	lbListeners, err := d.discoverListeners()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover listeners: %w", err)
+	}
+
+	var opts loadbalancers.ListOptsBuilder = &loadbalancers.ListOpts{}
+	list, err = genericList(d, d.loadBalancerClient, loadbalancers.List, func(lb *loadbalancers.LoadBalancer) (ontology.IsResource, error) {
+		return d.handleLoadBalancer(lb, lbListeners[lb.ID])
+	}, loadbalancers.ExtractLoadBalancers, opts)
+
+	return
+}
+
+// discoverListeners discovers the listeners of all Octavia load balancers and returns them grouped by the load
+// balancer ID. The listeners are not part of the load balancer list response.
+func (d *openstackDiscovery) discoverListeners() (map[string][]listeners.Listener, error) {
+	client, err := d.loadBalancerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	pages, err := listeners.List(client, listeners.ListOpts{}).AllPages(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("could not list listeners: %w", err)
+	}
+
+	all, err := listeners.ExtractListeners(pages)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract listeners: %w", err)
+	}
+
+	m := make(map[string][]listeners.Listener)
+	for _, l := range all {
+		for _, lb := range l.Loadbalancers {
+			m[lb.ID] = append(m[lb.ID], l)
+		}
+	}
+
+	return m, nil
+}
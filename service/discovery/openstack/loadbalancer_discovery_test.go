@@ -0,0 +1,198 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/openstacktest"
+	"clouditor.io/clouditor/v2/internal/util"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_openstackDiscovery_discoverLoadBalancers(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	openstacktest.HandleLoadbalancerListSuccessfully(t)
+	openstacktest.HandleListenerListSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error: client not initialized",
+			fields: fields{
+				domain:   &domain{},
+				project:  &project{},
+				projects: map[string]ontology.IsResource{},
+			},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not discover listeners: failed to get client")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					loadBalancerClient: client.ServiceClient(),
+				},
+				region: "test region",
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				t1, err := time.Parse("2006-01-02T15:04:05", "2019-06-30T04:15:37")
+				assert.NoError(t, err)
+
+				tls := &ontology.TransportEncryption{
+					Enabled:         true,
+					Enforced:        true,
+					Protocol:        constants.TLS,
+					ProtocolVersion: 1.2,
+					CipherSuites: []*ontology.CipherSuite{
+						{
+							KeyExchangeAlgorithm:    "ECDHE",
+							AuthenticationMechanism: "ECDSA",
+							SessionCipher:           constants.AES_256_GCM,
+							MacAlgorithm:            constants.SHA_384,
+						},
+						{
+							KeyExchangeAlgorithm:    "ECDHE",
+							AuthenticationMechanism: "RSA",
+							SessionCipher:           "CHACHA20-POLY1305",
+						},
+					},
+					SecretId: util.Ref("http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0"),
+				}
+
+				want := &ontology.LoadBalancer{
+					Id:           "c331058c-6a40-4144-948e-b9fb1df9db4b",
+					Name:         "web_lb",
+					Description:  "lb config for the web tier",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels:                     map[string]string{"test": "", "stage": ""},
+					ParentId:                   util.Ref("54030507-44f7-473c-9342-b4d14a95f692"),
+					Ips:                        []string{"203.0.113.10"},
+					Ports:                      []uint32{80, 443},
+					InternetAccessibleEndpoint: true,
+					HttpEndpoints: []*ontology.HttpEndpoint{
+						{
+							Url: "http://203.0.113.10:80",
+						},
+						{
+							Url:                 "https://203.0.113.10:443",
+							TransportEncryption: tls,
+						},
+					},
+					TransportEncryption: &ontology.TransportEncryption{
+						Enabled:         true,
+						Enforced:        false,
+						Protocol:        constants.TLS,
+						ProtocolVersion: 1.2,
+						CipherSuites:    tls.CipherSuites,
+						SecretId:        tls.SecretId,
+					},
+				}
+
+				got0, ok := got[0].(*ontology.LoadBalancer)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				assert.Equal(t, want, got0)
+
+				got1, ok := got[1].(*ontology.LoadBalancer)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"10.30.176.48", "192.168.10.10"}, got1.GetIps())
+				assert.False(t, got1.GetInternetAccessibleEndpoint())
+				return assert.False(t, got1.GetTransportEncryption().GetEnabled())
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			gotList, err := d.discoverLoadBalancers()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
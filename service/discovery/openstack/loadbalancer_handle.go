@@ -0,0 +1,90 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// handleLoadBalancer creates a load balancer resource based on the Clouditor Ontology. The transport encryption is
+// derived from the given listeners of the load balancer.
+func (d *openstackDiscovery) handleLoadBalancer(lb *loadbalancers.LoadBalancer, lbListeners []listeners.Listener) (ontology.IsResource, error) {
+	var (
+		ips   []string
+		ports []uint32
+	)
+
+	// Get project/tenant ID
+	projectId, err := getProjectID(lb)
+	if err != nil {
+		return nil, fmt.Errorf("could not get project ID for load balancer '%s': %w", lb.Name, err)
+	}
+
+	ips = append(ips, lb.VipAddress)
+	for _, vip := range lb.AdditionalVips {
+		ips = append(ips, vip.IPAddress)
+	}
+
+	for _, l := range lbListeners {
+		ports = append(ports, uint32(l.ProtocolPort))
+	}
+
+	r := &ontology.LoadBalancer{
+		Id:           lb.ID,
+		Name:         lb.Name,
+		Description:  lb.Description,
+		CreationTime: timestamppb.New(lb.CreatedAt),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.region,
+		},
+		Labels:                     labels(util.Ref(lb.Tags)),
+		ParentId:                   util.Ref(projectId),
+		Ips:                        ips,
+		Ports:                      ports,
+		InternetAccessibleEndpoint: isPublicIP(lb.VipAddress),
+		HttpEndpoints:              httpEndpoints(lb.VipAddress, lbListeners),
+		TransportEncryption:        loadBalancerTransportEncryption(lbListeners),
+		Raw:                        discovery.Raw(lb, &lbListeners),
+	}
+
+	// Create project resource for the parentId if not available
+	err = d.addProjectIfMissing(projectId, projectId, d.domain.domainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not handle project for load balancer '%s': %w", lb.Name, err)
+	}
+
+	log.Infof("Adding load balancer '%s'", r.Name)
+
+	return r, nil
+}
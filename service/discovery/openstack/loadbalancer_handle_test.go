@@ -0,0 +1,139 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_openstackDiscovery_handleLoadBalancer(t *testing.T) {
+	testTime := time.Date(2000, 01, 20, 9, 20, 12, 123, time.UTC)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	type args struct {
+		lb          *loadbalancers.LoadBalancer
+		lbListeners []listeners.Listener
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[ontology.IsResource]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error getting projectID",
+			fields: fields{
+				region:   "test region",
+				domain:   &domain{},
+				projects: map[string]ontology.IsResource{},
+			},
+			args: args{
+				lb: &loadbalancers.LoadBalancer{},
+			},
+			want: assert.Nil[ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not get project ID for load balancer")
+			},
+		},
+		{
+			name: "Happy path: no listeners",
+			fields: fields{
+				region: "test region",
+				domain: &domain{
+					domainID:   testdata.MockOpenstackDomainID1,
+					domainName: testdata.MockOpenstackDomainName1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			args: args{
+				lb: &loadbalancers.LoadBalancer{
+					ID:         "c331058c-6a40-4144-948e-b9fb1df9db4b",
+					Name:       "web_lb",
+					ProjectID:  testdata.MockOpenstackServerTenantID,
+					VipAddress: "10.30.176.47",
+					CreatedAt:  testTime,
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource) bool {
+				want := &ontology.LoadBalancer{
+					Id:           "c331058c-6a40-4144-948e-b9fb1df9db4b",
+					Name:         "web_lb",
+					CreationTime: timestamppb.New(testTime),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels:   map[string]string{},
+					ParentId: util.Ref(testdata.MockOpenstackServerTenantID),
+					Ips:      []string{"10.30.176.47"},
+				}
+
+				gotNew, ok := got.(*ontology.LoadBalancer)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			got, err := d.handleLoadBalancer(tt.args.lb, tt.args.lbListeners)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
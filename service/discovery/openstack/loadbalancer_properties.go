@@ -0,0 +1,278 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+)
+
+const (
+	// ListenerProtocolHTTP is the protocol of a listener that accepts plain HTTP traffic.
+	ListenerProtocolHTTP = "HTTP"
+	// ListenerProtocolHTTPS is the protocol of a listener that passes TLS traffic through to the members.
+	ListenerProtocolHTTPS = "HTTPS"
+	// ListenerProtocolTerminatedHTTPS is the protocol of a listener that terminates TLS at the load balancer.
+	ListenerProtocolTerminatedHTTPS = "TERMINATED_HTTPS"
+)
+
+// isPublicIP returns true, if the address is a global unicast address that is not part of a private network.
+func isPublicIP(addr string) bool {
+	ip := net.ParseIP(addr)
+
+	return ip != nil && ip.IsGlobalUnicast() && !ip.IsPrivate()
+}
+
+// httpEndpoints returns an HTTP endpoint for each listener of the load balancer that handles HTTP(S) traffic.
+func httpEndpoints(vip string, lbListeners []listeners.Listener) (endpoints []*ontology.HttpEndpoint) {
+	for _, l := range lbListeners {
+		var scheme string
+
+		switch l.Protocol {
+		case ListenerProtocolHTTP:
+			scheme = "http"
+		case ListenerProtocolHTTPS, ListenerProtocolTerminatedHTTPS:
+			scheme = "https"
+		default:
+			continue
+		}
+
+		endpoints = append(endpoints, &ontology.HttpEndpoint{
+			Url:                 fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(vip, strconv.Itoa(l.ProtocolPort))),
+			TransportEncryption: listenerTransportEncryption(&l),
+		})
+	}
+
+	return
+}
+
+// listenerTransportEncryption returns the transport encryption of a listener. For listeners terminating TLS, the TLS
+// versions, ciphers and the Barbican container of the certificate are known. For TLS passthrough listeners, only the
+// usage of TLS is known. All other listeners do not encrypt the traffic and nil is returned.
+func listenerTransportEncryption(l *listeners.Listener) *ontology.TransportEncryption {
+	switch l.Protocol {
+	case ListenerProtocolTerminatedHTTPS:
+		te := &ontology.TransportEncryption{
+			Enabled:         true,
+			Enforced:        true,
+			Protocol:        constants.TLS,
+			ProtocolVersion: minTLSVersion(l.TLSVersions),
+			CipherSuites:    opensslCipherSuites(l.TLSCiphers),
+		}
+
+		if l.DefaultTlsContainerRef != "" {
+			te.SecretId = util.Ref(l.DefaultTlsContainerRef)
+		}
+
+		return te
+	case ListenerProtocolHTTPS:
+		return &ontology.TransportEncryption{
+			Enabled:  true,
+			Enforced: true,
+			Protocol: constants.TLS,
+		}
+	default:
+		return nil
+	}
+}
+
+// loadBalancerTransportEncryption combines the transport encryption of all listeners of a load balancer. The transport
+// encryption is only enforced if all listeners use TLS. The protocol version is the lowest TLS version allowed by any
+// TLS terminating listener and the cipher suites are the ones allowed by any TLS terminating listener.
+func loadBalancerTransportEncryption(lbListeners []listeners.Listener) *ontology.TransportEncryption {
+	var (
+		ciphers    []string
+		terminated bool
+	)
+
+	if len(lbListeners) == 0 {
+		return nil
+	}
+
+	te := &ontology.TransportEncryption{
+		Enforced: true,
+	}
+
+	for _, l := range lbListeners {
+		lte := listenerTransportEncryption(&l)
+		if lte == nil {
+			te.Enforced = false
+			continue
+		}
+
+		te.Enabled = true
+		te.Protocol = lte.Protocol
+
+		if l.Protocol != ListenerProtocolTerminatedHTTPS {
+			continue
+		}
+
+		if !terminated || lte.ProtocolVersion < te.ProtocolVersion {
+			te.ProtocolVersion = lte.ProtocolVersion
+		}
+
+		if te.SecretId == nil {
+			te.SecretId = lte.SecretId
+		}
+
+		for _, c := range strings.Split(l.TLSCiphers, ":") {
+			if c != "" && !slices.Contains(ciphers, c) {
+				ciphers = append(ciphers, c)
+			}
+		}
+
+		terminated = true
+	}
+
+	if !te.Enabled {
+		te.Enforced = false
+	}
+
+	te.CipherSuites = opensslCipherSuites(strings.Join(ciphers, ":"))
+
+	return te
+}
+
+// minTLSVersion returns the lowest of the given Octavia TLS versions, e.g., "TLSv1.2". If no versions are given or
+// one of the versions is not a TLS version, e.g., "SSLv3", 0 is returned.
+func minTLSVersion(versions []string) (lowest float32) {
+	for i, v := range versions {
+		version := tlsVersion(v)
+		if version == 0 {
+			return 0
+		}
+
+		if i == 0 || version < lowest {
+			lowest = version
+		}
+	}
+
+	return
+}
+
+// tlsVersion converts an Octavia TLS version, e.g., "TLSv1.2", into the version number. 0 is returned for all other
+// versions.
+func tlsVersion(v string) float32 {
+	switch v {
+	case "TLSv1":
+		return 1.0
+	case "TLSv1.1":
+		return 1.1
+	case "TLSv1.2":
+		return 1.2
+	case "TLSv1.3":
+		return 1.3
+	default:
+		return 0
+	}
+}
+
+// opensslCipherSuites parses a colon-separated list of ciphers in the OpenSSL format, e.g.,
+// "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-CHACHA20-POLY1305".
+func opensslCipherSuites(ciphers string) (suites []*ontology.CipherSuite) {
+	for _, c := range strings.Split(ciphers, ":") {
+		cs := opensslCipherSuite(c)
+		if cs != nil {
+			suites = append(suites, cs)
+		}
+	}
+
+	return
+}
+
+// opensslCipherSuite builds an [ontology.CipherSuite] out of a cipher name in the OpenSSL format, e.g.,
+// ECDHE-RSA-AES128-GCM-SHA256 or AES256-SHA. The IANA names of TLS 1.3, e.g., TLS_AES_128_GCM_SHA256, are also
+// supported.
+func opensslCipherSuite(name string) *ontology.CipherSuite {
+	var (
+		parts []string
+		cs    ontology.CipherSuite
+	)
+
+	if rest, ok := strings.CutPrefix(name, "TLS_"); ok {
+		parts = strings.Split(rest, "_")
+	} else {
+		parts = strings.Split(name, "-")
+	}
+
+	// Key exchange, which is omitted for RSA key exchange
+	switch parts[0] {
+	case "ECDHE", "DHE":
+		cs.KeyExchangeAlgorithm = parts[0]
+		parts = parts[1:]
+	case "EDH":
+		cs.KeyExchangeAlgorithm = "DHE"
+		parts = parts[1:]
+	}
+
+	// Authentication mechanism
+	if len(parts) > 0 && slices.Contains([]string{"RSA", "ECDSA", "DSS", "PSK"}, parts[0]) {
+		cs.AuthenticationMechanism = parts[0]
+		parts = parts[1:]
+	}
+
+	// MAC algorithm, which is omitted for some AEAD ciphers, e.g., CHACHA20-POLY1305
+	if len(parts) > 1 {
+		switch parts[len(parts)-1] {
+		case "SHA":
+			cs.MacAlgorithm = constants.SHA_1
+		case "SHA256":
+			cs.MacAlgorithm = constants.SHA_256
+		case "SHA384":
+			cs.MacAlgorithm = constants.SHA_384
+		}
+
+		if cs.MacAlgorithm != "" {
+			parts = parts[:len(parts)-1]
+		}
+	}
+
+	if len(parts) == 0 || parts[0] == "" {
+		return nil
+	}
+
+	// Session cipher, e.g., AES256-GCM is converted into AES-256-GCM
+	if bits, ok := strings.CutPrefix(parts[0], constants.AES); ok && bits != "" {
+		parts = append([]string{constants.AES, bits}, parts[1:]...)
+	}
+	cs.SessionCipher = strings.Join(parts, "-")
+
+	// AES without a mode uses CBC
+	if parts[0] == constants.AES && len(parts) == 2 {
+		cs.SessionCipher += "-CBC"
+	}
+
+	return &cs
+}
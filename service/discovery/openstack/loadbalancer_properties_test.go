@@ -0,0 +1,225 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+)
+
+func Test_isPublicIP(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want bool
+	}{
+		{
+			name: "invalid address",
+			addr: "example.com",
+			want: false,
+		},
+		{
+			name: "private IPv4 address",
+			addr: "10.30.176.47",
+			want: false,
+		},
+		{
+			name: "loopback address",
+			addr: "127.0.0.1",
+			want: false,
+		},
+		{
+			name: "public IPv4 address",
+			addr: "203.0.113.10",
+			want: true,
+		},
+		{
+			name: "public IPv6 address",
+			addr: "2001:db8::1",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isPublicIP(tt.addr))
+		})
+	}
+}
+
+func Test_minTLSVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		want     float32
+	}{
+		{
+			name: "no versions",
+			want: 0,
+		},
+		{
+			name:     "TLS versions",
+			versions: []string{"TLSv1.3", "TLSv1.2"},
+			want:     1.2,
+		},
+		{
+			name:     "SSL version",
+			versions: []string{"SSLv3", "TLSv1.2"},
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, minTLSVersion(tt.versions))
+		})
+	}
+}
+
+func Test_opensslCipherSuite(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want *ontology.CipherSuite
+	}{
+		{
+			name: "empty",
+			arg:  "",
+			want: nil,
+		},
+		{
+			name: "ECDHE with AES GCM",
+			arg:  "ECDHE-RSA-AES128-GCM-SHA256",
+			want: &ontology.CipherSuite{
+				KeyExchangeAlgorithm:    "ECDHE",
+				AuthenticationMechanism: "RSA",
+				SessionCipher:           constants.AES_128_GCM,
+				MacAlgorithm:            constants.SHA_256,
+			},
+		},
+		{
+			name: "DHE with ChaCha20",
+			arg:  "DHE-RSA-CHACHA20-POLY1305",
+			want: &ontology.CipherSuite{
+				KeyExchangeAlgorithm:    "DHE",
+				AuthenticationMechanism: "RSA",
+				SessionCipher:           "CHACHA20-POLY1305",
+			},
+		},
+		{
+			name: "RSA key exchange with AES CBC",
+			arg:  "AES256-SHA",
+			want: &ontology.CipherSuite{
+				SessionCipher: "AES-256-CBC",
+				MacAlgorithm:  constants.SHA_1,
+			},
+		},
+		{
+			name: "weak cipher",
+			arg:  "DES-CBC3-SHA",
+			want: &ontology.CipherSuite{
+				SessionCipher: "DES-CBC3",
+				MacAlgorithm:  constants.SHA_1,
+			},
+		},
+		{
+			name: "TLS 1.3",
+			arg:  "TLS_AES_256_GCM_SHA384",
+			want: &ontology.CipherSuite{
+				SessionCipher: constants.AES_256_GCM,
+				MacAlgorithm:  constants.SHA_384,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, opensslCipherSuite(tt.arg))
+		})
+	}
+}
+
+func Test_loadBalancerTransportEncryption(t *testing.T) {
+	tests := []struct {
+		name        string
+		lbListeners []listeners.Listener
+		want        *ontology.TransportEncryption
+	}{
+		{
+			name: "no listeners",
+			want: nil,
+		},
+		{
+			name: "no TLS listeners",
+			lbListeners: []listeners.Listener{
+				{Protocol: "TCP", ProtocolPort: 3306},
+			},
+			want: &ontology.TransportEncryption{},
+		},
+		{
+			name: "TLS passthrough",
+			lbListeners: []listeners.Listener{
+				{Protocol: ListenerProtocolHTTPS, ProtocolPort: 443},
+			},
+			want: &ontology.TransportEncryption{
+				Enabled:  true,
+				Enforced: true,
+				Protocol: constants.TLS,
+			},
+		},
+		{
+			name: "multiple TLS terminating listeners",
+			lbListeners: []listeners.Listener{
+				{Protocol: ListenerProtocolTerminatedHTTPS, ProtocolPort: 443, TLSVersions: []string{"TLSv1.3"}, TLSCiphers: "ECDHE-RSA-AES128-GCM-SHA256"},
+				{Protocol: ListenerProtocolTerminatedHTTPS, ProtocolPort: 8443, TLSVersions: []string{"TLSv1.2"}, TLSCiphers: "ECDHE-RSA-AES128-GCM-SHA256:AES256-SHA"},
+			},
+			want: &ontology.TransportEncryption{
+				Enabled:         true,
+				Enforced:        true,
+				Protocol:        constants.TLS,
+				ProtocolVersion: 1.2,
+				CipherSuites: []*ontology.CipherSuite{
+					{
+						KeyExchangeAlgorithm:    "ECDHE",
+						AuthenticationMechanism: "RSA",
+						SessionCipher:           constants.AES_128_GCM,
+						MacAlgorithm:            constants.SHA_256,
+					},
+					{
+						SessionCipher: "AES-256-CBC",
+						MacAlgorithm:  constants.SHA_1,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, loadBalancerTransportEncryption(tt.lbListeners))
+		})
+	}
+}
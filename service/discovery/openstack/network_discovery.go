@@ -28,6 +28,7 @@ package openstack
 import (
 	"clouditor.io/clouditor/v2/api/ontology"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 )
 
@@ -38,3 +39,11 @@ func (d *openstackDiscovery) discoverNetworkInterfaces() (list []ontology.IsReso
 
 	return
 }
+
+// discoverSecurityGroups discovers security groups including their rules
+func (d *openstackDiscovery) discoverSecurityGroups() (list []ontology.IsResource, err error) {
+	var opts = groups.ListOpts{}
+	list, err = genericList(d, d.networkClient, groups.List, d.handleSecurityGroup, groups.ExtractGroups, opts)
+
+	return
+}
@@ -134,3 +134,100 @@ func Test_openstackDiscovery_discoverNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_discoverSecurityGroups(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	openstacktest.HandleSecurityGroupListSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					networkClient: client.ServiceClient(),
+				},
+				region: "test region",
+				domain: &domain{
+					domainID:   testdata.MockOpenstackDomainID1,
+					domainName: testdata.MockOpenstackDomainName1,
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 1, len(got))
+
+				t1, err := time.Parse(time.RFC3339, "2019-06-30T04:15:37Z")
+				assert.NoError(t, err)
+
+				want := &ontology.NetworkSecurityGroup{
+					Id:           "85cc3048-abc3-43cc-89b3-377341426ac5",
+					Name:         "default",
+					Description:  "default",
+					CreationTime: timestamppb.New(t1),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					InternetAccessibleEndpoint: true,
+					Labels:                     map[string]string{},
+					ParentId:                   util.Ref("e4f50856753b4dc6afee5fa6b9b6c550"),
+				}
+
+				got0, ok := got[0].(*ontology.NetworkSecurityGroup)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got0.GetRaw())
+				got0.Raw = ""
+				return assert.Equal(t, want, got0)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			gotList, err := d.discoverSecurityGroups()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/util"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -67,3 +68,36 @@ func (d *openstackDiscovery) handleNetworkInterfaces(network *networks.Network)
 
 	return r, nil
 }
+
+// handleSecurityGroup creates a network security group resource based on the Clouditor Ontology
+func (d *openstackDiscovery) handleSecurityGroup(group *groups.SecGroup) (ontology.IsResource, error) {
+	// Get project/tenant ID
+	projectId, err := getProjectID(group)
+	if err != nil {
+		return nil, fmt.Errorf("could not get project ID for security group '%s': %w", group.Name, err)
+	}
+
+	r := &ontology.NetworkSecurityGroup{
+		Id:           group.ID,
+		Name:         group.Name,
+		Description:  group.Description,
+		CreationTime: timestamppb.New(group.CreatedAt),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.region,
+		},
+		InternetAccessibleEndpoint: securityGroupAllowsInboundInternet(group),
+		Labels:                     labels(util.Ref(group.Tags)),
+		ParentId:                   util.Ref(projectId),
+		Raw:                        discovery.Raw(group),
+	}
+
+	// Create project resource for the parentId if not available
+	err = d.addProjectIfMissing(projectId, projectId, d.domain.domainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not handle project for security group '%s': %w", group.Name, err)
+	}
+
+	log.Infof("Adding security group '%s'", r.Name)
+
+	return r, nil
+}
@@ -34,6 +34,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"clouditor.io/clouditor/v2/internal/util"
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -170,3 +171,102 @@ func Test_openstackDiscovery_handleNetworkInterfaces(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_handleSecurityGroup(t *testing.T) {
+	testTime := time.Date(2000, 01, 20, 9, 20, 12, 123, time.UTC)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	type args struct {
+		group *groups.SecGroup
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[ontology.IsResource]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error getting projectID",
+			fields: fields{
+				region:   "test region",
+				domain:   &domain{},
+				projects: map[string]ontology.IsResource{},
+			},
+			args: args{
+				group: &groups.SecGroup{},
+			},
+			want: assert.Nil[ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not get project ID for security group")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				region: "test region",
+				domain: &domain{
+					domainID:   testdata.MockOpenstackDomainID1,
+					domainName: testdata.MockOpenstackDomainName1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			args: args{
+				group: &groups.SecGroup{
+					ID:          "85cc3048-abc3-43cc-89b3-377341426ac5",
+					Name:        "web",
+					Description: "web servers",
+					ProjectID:   testdata.MockOpenstackServerTenantID,
+					CreatedAt:   testTime,
+					Tags:        []string{"test"},
+				},
+			},
+			want: func(t *testing.T, got ontology.IsResource) bool {
+				want := &ontology.NetworkSecurityGroup{
+					Id:           "85cc3048-abc3-43cc-89b3-377341426ac5",
+					Name:         "web",
+					Description:  "web servers",
+					CreationTime: timestamppb.New(testTime),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels:   map[string]string{"test": ""},
+					ParentId: util.Ref(testdata.MockOpenstackServerTenantID),
+				}
+
+				gotNew, ok := got.(*ontology.NetworkSecurityGroup)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			got, err := d.handleSecurityGroup(tt.args.group)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -24,3 +24,24 @@
 // This file is part of Clouditor Community Edition.
 
 package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+)
+
+// securityGroupAllowsInboundInternet returns true, if the security group contains an ingress rule that allows traffic
+// from any address. Rules without a remote IP prefix and remote group match all addresses.
+func securityGroupAllowsInboundInternet(group *groups.SecGroup) bool {
+	for _, rule := range group.Rules {
+		if rule.Direction != string(rules.DirIngress) {
+			continue
+		}
+
+		if rule.RemoteGroupID == "" && (rule.RemoteIPPrefix == "" || rule.RemoteIPPrefix == "0.0.0.0/0" || rule.RemoteIPPrefix == "::/0") {
+			return true
+		}
+	}
+
+	return false
+}
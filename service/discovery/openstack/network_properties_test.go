@@ -0,0 +1,116 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package openstack
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+)
+
+func Test_securityGroupAllowsInboundInternet(t *testing.T) {
+	type args struct {
+		group *groups.SecGroup
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no rules",
+			args: args{
+				group: &groups.SecGroup{},
+			},
+			want: false,
+		},
+		{
+			name: "egress rule to any address",
+			args: args{
+				group: &groups.SecGroup{
+					Rules: []rules.SecGroupRule{
+						{Direction: "egress"},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "ingress rule from remote group",
+			args: args{
+				group: &groups.SecGroup{
+					Rules: []rules.SecGroupRule{
+						{Direction: "ingress", RemoteGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5"},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "ingress rule from private network",
+			args: args{
+				group: &groups.SecGroup{
+					Rules: []rules.SecGroupRule{
+						{Direction: "ingress", RemoteIPPrefix: "10.0.0.0/8"},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "ingress rule from any IPv6 address",
+			args: args{
+				group: &groups.SecGroup{
+					Rules: []rules.SecGroupRule{
+						{Direction: "ingress", RemoteIPPrefix: "10.0.0.0/8"},
+						{Direction: "ingress", RemoteIPPrefix: "::/0"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ingress rule without remote",
+			args: args{
+				group: &groups.SecGroup{
+					Rules: []rules.SecGroupRule{
+						{Direction: "ingress"},
+					},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := securityGroupAllowsInboundInternet(tt.args.group)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
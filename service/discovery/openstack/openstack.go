@@ -66,6 +66,8 @@ type openstackDiscovery struct {
 	configuredProject *project
 	// discoveredProjects is used to store the discovered discoveredProjects/tenants. If it is not possible to get the discoveredProjects from the OpenStack API, the project resources are created while discovering the other resources (e.g., servers, networks, etc.) and added to this map.
 	discoveredProjects map[string]ontology.IsResource
+	// privilegedIdentities contains the IDs of users and groups that have a privileged role assigned. It is filled while discovering the role assignments.
+	privilegedIdentities map[string]bool
 }
 
 type domain struct {
@@ -80,12 +82,15 @@ type project struct {
 }
 
 type clients struct {
-	provider       *gophercloud.ProviderClient
-	identityClient *gophercloud.ServiceClient
-	computeClient  *gophercloud.ServiceClient
-	networkClient  *gophercloud.ServiceClient
-	storageClient  *gophercloud.ServiceClient
-	clusterClient  *gophercloud.ServiceClient
+	provider            *gophercloud.ProviderClient
+	identityClient      *gophercloud.ServiceClient
+	computeClient       *gophercloud.ServiceClient
+	networkClient       *gophercloud.ServiceClient
+	storageClient       *gophercloud.ServiceClient
+	objectStorageClient *gophercloud.ServiceClient
+	clusterClient       *gophercloud.ServiceClient
+	loadBalancerClient  *gophercloud.ServiceClient
+	keyManagerClient    *gophercloud.ServiceClient
 }
 
 func (*openstackDiscovery) Name() string {
@@ -133,8 +138,9 @@ func NewOpenstackDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
 			domainName: os.Getenv(DomainName),
 		},
 		// Currently, the project ID cannot be specified as an environment variable in conjunction with application credentials.
-		configuredProject:  &project{},
-		discoveredProjects: make(map[string]ontology.IsResource),
+		configuredProject:    &project{},
+		discoveredProjects:   make(map[string]ontology.IsResource),
+		privilegedIdentities: make(map[string]bool),
 	}
 
 	// Apply options
@@ -154,7 +160,11 @@ func NewOpenstackDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
 // * compute client
 // * network client
 // * block storage client
+// * object storage client, if the service is deployed
 // * identity client
+// * cluster client
+// * load balancer client, if the service is deployed
+// * key manager client, if the service is deployed
 func (d *openstackDiscovery) authorize() (err error) {
 	if d.clients.provider == nil {
 		d.clients.provider, err = openstack.AuthenticatedClient(context.Background(), util.Deref(d.authOpts))
@@ -193,6 +203,14 @@ func (d *openstackDiscovery) authorize() (err error) {
 		}
 	}
 
+	// Object storage client. Swift is an optional service, so its resources are skipped if it is not deployed.
+	if d.clients.objectStorageClient == nil {
+		d.clients.objectStorageClient, err = d.optionalClient("object storage", openstack.NewObjectStorageV1)
+		if err != nil {
+			return fmt.Errorf("could not create object storage client: %w", err)
+		}
+	}
+
 	// Identity client
 	if d.clients.identityClient == nil {
 		d.clients.identityClient, err = openstack.NewIdentityV3(d.clients.provider, gophercloud.EndpointOpts{
@@ -213,9 +231,43 @@ func (d *openstackDiscovery) authorize() (err error) {
 		}
 	}
 
+	// Load balancer client. Octavia is an optional service, so its resources are skipped if it is not deployed.
+	if d.clients.loadBalancerClient == nil {
+		d.clients.loadBalancerClient, err = d.optionalClient("load balancer", openstack.NewLoadBalancerV2)
+		if err != nil {
+			return fmt.Errorf("could not create load balancer client: %w", err)
+		}
+	}
+
+	// Key manager client. Barbican is an optional service, so its resources are skipped if it is not deployed.
+	if d.clients.keyManagerClient == nil {
+		d.clients.keyManagerClient, err = d.optionalClient("key manager", openstack.NewKeyManagerV1)
+		if err != nil {
+			return fmt.Errorf("could not create key manager client: %w", err)
+		}
+	}
+
 	return
 }
 
+// optionalClient creates the client of an optional OpenStack service. If the service has no endpoint in the service
+// catalog, i.e., it is not deployed, nil is returned without an error.
+func (d *openstackDiscovery) optionalClient(service string, newClient func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error)) (*gophercloud.ServiceClient, error) {
+	var notFound *gophercloud.ErrEndpointNotFound
+
+	client, err := newClient(d.clients.provider, gophercloud.EndpointOpts{
+		Region: d.region,
+	})
+	if errors.As(err, &notFound) {
+		log.Infof("The %s service is not deployed, its resources are not discovered", service)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
 func NewAuthorizer() (gophercloud.AuthOptions, error) {
 	ao, err := openstack.AuthOptionsFromEnv()
 	if err != nil {
@@ -230,17 +282,28 @@ func NewAuthorizer() (gophercloud.AuthOptions, error) {
 // List discovers the following OpenStack resource types and translates them into the Clouditor ontology:
 // * Servers
 // * Network interfaces
+// * Security groups
 // * Block storages
+// * Object storages (Swift containers), if Swift is deployed
+// * Clusters
+// * Load balancers (including their listeners), if Octavia is deployed
+// * Secrets and keys (Barbican secrets and containers), if Barbican is deployed
+// * Role assignments
+// * Users
 // * Domains
 // * Projects
 func (d *openstackDiscovery) List() (list []ontology.IsResource, err error) {
 	var (
-		servers  []ontology.IsResource
-		networks []ontology.IsResource
-		storages []ontology.IsResource
-		projects []ontology.IsResource
-		domains  []ontology.IsResource
-		clusters []ontology.IsResource
+		servers        []ontology.IsResource
+		networks       []ontology.IsResource
+		securityGroups []ontology.IsResource
+		storages       []ontology.IsResource
+		objectStorages []ontology.IsResource
+		assignments    []ontology.IsResource
+		users          []ontology.IsResource
+		projects       []ontology.IsResource
+		domains        []ontology.IsResource
+		clusters       []ontology.IsResource
 	)
 
 	if err = d.authorize(); err != nil {
@@ -263,6 +326,13 @@ func (d *openstackDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, networks...)
 
+	// Discover security groups
+	securityGroups, err = d.discoverSecurityGroups()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover security groups: %v", err)
+	}
+	list = append(list, securityGroups...)
+
 	// Discover block storage
 	storages, err = d.discoverBlockStorage()
 	if err != nil {
@@ -270,6 +340,15 @@ func (d *openstackDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, storages...)
 
+	// Discover object storage, if Swift is deployed
+	if d.clients.objectStorageClient != nil {
+		objectStorages, err = d.discoverObjectStorage()
+		if err != nil {
+			return nil, fmt.Errorf("could not discover object storage: %v", err)
+		}
+		list = append(list, objectStorages...)
+	}
+
 	// Discover clusters
 	clusters, err = d.discoverCluster()
 	if err != nil {
@@ -277,6 +356,32 @@ func (d *openstackDiscovery) List() (list []ontology.IsResource, err error) {
 	}
 	list = append(list, clusters...)
 
+	// Discover load balancers, if Octavia is deployed. Load balancers are optional, so we proceed without them if they
+	// cannot be discovered.
+	if d.clients.loadBalancerClient != nil {
+		list = append(list, d.discoverOptional("load balancers", d.discoverLoadBalancers)...)
+	}
+
+	// Discover secrets and secret containers, if Barbican is deployed. They are optional as well.
+	if d.clients.keyManagerClient != nil {
+		list = append(list, d.discoverOptional("secrets", d.discoverSecrets)...)
+		list = append(list, d.discoverOptional("secret containers", d.discoverSecretContainers)...)
+	}
+
+	// Discover role assignments before users, so that we know which users are privileged
+	assignments, err = d.discoverRoleAssignments()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover role assignments: %v", err)
+	}
+	list = append(list, assignments...)
+
+	// Discover users
+	users, err = d.discoverUsers()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover users: %v", err)
+	}
+	list = append(list, users...)
+
 	// Discover project resources
 	projects, err = d.discoverProjects()
 	if err != nil {
@@ -303,6 +408,18 @@ func (d *openstackDiscovery) List() (list []ontology.IsResource, err error) {
 	return
 }
 
+// discoverOptional discovers resources of an optional service. If the discovery fails, e.g., because of missing
+// permissions, the error is logged and no resources are returned.
+func (d *openstackDiscovery) discoverOptional(name string, discover func() ([]ontology.IsResource, error)) []ontology.IsResource {
+	list, err := discover()
+	if err != nil {
+		log.Warnf("Could not discover %s, but we can proceed without them: %v", name, err)
+		return nil
+	}
+
+	return list
+}
+
 type ClientFunc func() (*gophercloud.ServiceClient, error)
 type ListFunc[O any] func(client *gophercloud.ServiceClient, opts O) pagination.Pager
 type HandlerFunc[T any, R ontology.IsResource] func(in *T) (r R, err error)
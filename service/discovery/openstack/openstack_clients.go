@@ -63,6 +63,14 @@ func (d *openstackDiscovery) storageClient() (client *gophercloud.ServiceClient,
 	return d.clients.storageClient, nil
 }
 
+// objectStorageClient returns the object storage client if initialized
+func (d *openstackDiscovery) objectStorageClient() (client *gophercloud.ServiceClient, err error) {
+	if d.clients.objectStorageClient == nil {
+		return nil, fmt.Errorf("object storage client not initialized")
+	}
+	return d.clients.objectStorageClient, nil
+}
+
 // clusterClient returns the cluster client if initialized
 func (d *openstackDiscovery) clusterClient() (client *gophercloud.ServiceClient, err error) {
 	if d.clients.clusterClient == nil {
@@ -70,3 +78,19 @@ func (d *openstackDiscovery) clusterClient() (client *gophercloud.ServiceClient,
 	}
 	return d.clients.clusterClient, nil
 }
+
+// loadBalancerClient returns the load balancer client if initialized
+func (d *openstackDiscovery) loadBalancerClient() (client *gophercloud.ServiceClient, err error) {
+	if d.clients.loadBalancerClient == nil {
+		return nil, fmt.Errorf("load balancer client not initialized")
+	}
+	return d.clients.loadBalancerClient, nil
+}
+
+// keyManagerClient returns the key manager client if initialized
+func (d *openstackDiscovery) keyManagerClient() (client *gophercloud.ServiceClient, err error) {
+	if d.clients.keyManagerClient == nil {
+		return nil, fmt.Errorf("key manager client not initialized")
+	}
+	return d.clients.keyManagerClient, nil
+}
@@ -220,6 +220,53 @@ func Test_openstackDiscovery_storageClient(t *testing.T) {
 	}
 }
 
+func Test_openstackDiscovery_objectStorageClient(t *testing.T) {
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantClient assert.Want[*gophercloud.ServiceClient]
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "objectStorageClient not initialized",
+			wantClient: assert.Nil[*gophercloud.ServiceClient],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "object storage client not initialized")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				clients: clients{
+					objectStorageClient: &gophercloud.ServiceClient{},
+				},
+			},
+			wantClient: func(t *testing.T, got *gophercloud.ServiceClient) bool {
+				return assert.NotNil(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:     tt.fields.ctID,
+				clients:  tt.fields.clients,
+				authOpts: tt.fields.authOpts,
+			}
+			gotClient, err := d.objectStorageClient()
+
+			tt.wantClient(t, gotClient)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
 func Test_openstackDiscovery_clusterClient(t *testing.T) {
 	type fields struct {
 		ctID     string
@@ -266,3 +313,97 @@ func Test_openstackDiscovery_clusterClient(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_loadBalancerClient(t *testing.T) {
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantClient assert.Want[*gophercloud.ServiceClient]
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "loadBalancerClient not initialized",
+			wantClient: assert.Nil[*gophercloud.ServiceClient],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "load balancer client not initialized")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				clients: clients{
+					loadBalancerClient: &gophercloud.ServiceClient{},
+				},
+			},
+			wantClient: func(t *testing.T, got *gophercloud.ServiceClient) bool {
+				return assert.NotNil(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:     tt.fields.ctID,
+				clients:  tt.fields.clients,
+				authOpts: tt.fields.authOpts,
+			}
+			gotClient, err := d.loadBalancerClient()
+
+			tt.wantClient(t, gotClient)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_openstackDiscovery_keyManagerClient(t *testing.T) {
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantClient assert.Want[*gophercloud.ServiceClient]
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "keyManagerClient not initialized",
+			wantClient: assert.Nil[*gophercloud.ServiceClient],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "key manager client not initialized")
+			},
+		},
+		{
+			name: "Happy path",
+			fields: fields{
+				clients: clients{
+					keyManagerClient: &gophercloud.ServiceClient{},
+				},
+			},
+			wantClient: func(t *testing.T, got *gophercloud.ServiceClient) bool {
+				return assert.NotNil(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:     tt.fields.ctID,
+				clients:  tt.fields.clients,
+				authOpts: tt.fields.authOpts,
+			}
+			gotClient, err := d.keyManagerClient()
+
+			tt.wantClient(t, gotClient)
+			tt.wantErr(t, err)
+		})
+	}
+}
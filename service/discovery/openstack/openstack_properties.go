@@ -35,7 +35,14 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/users"
+	secretcontainers "github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
@@ -99,12 +106,21 @@ func (d *openstackDiscovery) setProjectInfo(x interface{}) error {
 	case []volumes.Volume:
 		projectID, err = getProjectID(resource[0])
 		projectName = projectID // it is not possible to extract the project name
+	case []groups.SecGroup:
+		projectID, err = getProjectID(resource[0])
+		projectName = projectID // it is not possible to extract the project name
+	case []loadbalancers.LoadBalancer:
+		projectID, err = getProjectID(resource[0])
+		projectName = projectID // it is not possible to extract the project name
 	case []projects.Project:
 		projectID = resource[0].ID
 		projectName = resource[0].Name
 	case []domains.Domain:
 		// Domain does not have a project ID or name, so we skip this
 		return nil
+	case []containers.Container, []users.User, []roles.RoleAssignment, []secrets.Secret, []secretcontainers.Container:
+		// Containers, users, role assignments and secrets do not have a project ID, so we skip this
+		return nil
 	default:
 		return fmt.Errorf("unknown resource type: %T", resource)
 	}
@@ -121,7 +137,9 @@ func (d *openstackDiscovery) setProjectInfo(x interface{}) error {
 type resourceTypes interface {
 	servers.Server | *servers.Server |
 		networks.Network | *networks.Network |
-		volumes.Volume | *volumes.Volume
+		volumes.Volume | *volumes.Volume |
+		groups.SecGroup | *groups.SecGroup |
+		loadbalancers.LoadBalancer | *loadbalancers.LoadBalancer
 }
 
 // getProjectID returns the project/tenant ID from the given ionoscloud resouce object
@@ -161,6 +179,30 @@ func getProjectID[T resourceTypes](r T) (string, error) {
 				return v.ProjectID, nil
 			}
 		}
+	case groups.SecGroup:
+		if v.TenantID != "" {
+			return v.TenantID, nil
+		}
+		if v.ProjectID != "" {
+			return v.ProjectID, nil
+		}
+	case *groups.SecGroup:
+		if v != nil {
+			if v.TenantID != "" {
+				return v.TenantID, nil
+			}
+			if v.ProjectID != "" {
+				return v.ProjectID, nil
+			}
+		}
+	case loadbalancers.LoadBalancer:
+		if v.ProjectID != "" {
+			return v.ProjectID, nil
+		}
+	case *loadbalancers.LoadBalancer:
+		if v != nil && v.ProjectID != "" {
+			return v.ProjectID, nil
+		}
 	default:
 		return "", fmt.Errorf("unknown resource type: %T", r)
 	}
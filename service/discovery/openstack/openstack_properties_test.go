@@ -36,6 +36,8 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/domains"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/testhelper"
 	"github.com/gophercloud/gophercloud/v2/testhelper/client"
@@ -329,6 +331,37 @@ func Test_openstackDiscovery_setProjectInfo(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Happy path: load balancers",
+			fields: fields{
+				project: &project{},
+			},
+			args: args{
+				x: []loadbalancers.LoadBalancer{
+					{
+						ProjectID: testdata.MockOpenstackProjectID1,
+					},
+				},
+			},
+			want: func(t *testing.T, d *openstackDiscovery) bool {
+				assert.Equal(t, testdata.MockOpenstackProjectID1, d.configuredProject.projectID)
+				return assert.Equal(t, testdata.MockOpenstackProjectID1, d.configuredProject.projectName)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: secrets",
+			fields: fields{
+				project: &project{},
+			},
+			args: args{
+				x: []secrets.Secret{{}},
+			},
+			want: func(t *testing.T, d *openstackDiscovery) bool {
+				return assert.Empty(t, d.configuredProject.projectID)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -469,6 +502,34 @@ func Test_getProjectID(t *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Happy path: load balancer",
+			args: args{
+				call: func() (string, error) {
+					return getProjectID(loadbalancers.LoadBalancer{
+						ProjectID: testdata.MockOpenstackProjectID1,
+					})
+				},
+			},
+			want: func(t *testing.T, got string) bool {
+				return assert.Equal(t, testdata.MockOpenstackProjectID1, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: *load balancer",
+			args: args{
+				call: func() (string, error) {
+					return getProjectID(&loadbalancers.LoadBalancer{
+						ProjectID: testdata.MockOpenstackProjectID1,
+					})
+				},
+			},
+			want: func(t *testing.T, got string) bool {
+				return assert.Equal(t, testdata.MockOpenstackProjectID1, got)
+			},
+			wantErr: assert.NoError,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
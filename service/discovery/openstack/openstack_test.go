@@ -28,6 +28,7 @@ package openstack
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 
 	"clouditor.io/clouditor/v2/api/discovery"
@@ -132,6 +133,7 @@ func Test_openstackDiscovery_authorize(t *testing.T) {
 	tests := []struct {
 		name    string
 		fields  fields
+		want    assert.Want[clients]
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
@@ -238,6 +240,83 @@ func Test_openstackDiscovery_authorize(t *testing.T) {
 				return assert.ErrorContains(t, err, "could not create cluster client:")
 			},
 		},
+		{
+			name: "object storage client error",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							if eo.Type == "object-store" {
+								return "", errors.New("this is a test error")
+							}
+							return testhelper.Endpoint(), nil
+						},
+					},
+				},
+			},
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not create object storage client:")
+			},
+		},
+		{
+			name: "Happy path: object storage not deployed",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							if eo.Type == "object-store" {
+								return "", &gophercloud.ErrEndpointNotFound{}
+							}
+							return testhelper.Endpoint(), nil
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got clients) bool {
+				return assert.Nil(t, got.objectStorageClient) && assert.NotNil(t, got.computeClient)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: load balancer and key manager not deployed",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							if eo.Type == "load-balancer" || eo.Type == "key-manager" {
+								return "", &gophercloud.ErrEndpointNotFound{}
+							}
+							return testhelper.Endpoint(), nil
+						},
+					},
+				},
+			},
+			want: func(t *testing.T, got clients) bool {
+				return assert.Nil(t, got.loadBalancerClient) && assert.Nil(t, got.keyManagerClient) && assert.NotNil(t, got.objectStorageClient)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "Happy path",
 			fields: fields{
@@ -256,6 +335,9 @@ func Test_openstackDiscovery_authorize(t *testing.T) {
 					},
 				},
 			},
+			want: func(t *testing.T, got clients) bool {
+				return assert.NotNil(t, got.objectStorageClient)
+			},
 			wantErr: assert.NoError,
 		},
 	}
@@ -270,6 +352,10 @@ func Test_openstackDiscovery_authorize(t *testing.T) {
 			err := d.authorize()
 
 			tt.wantErr(t, err)
+
+			if tt.want != nil {
+				tt.want(t, d.clients)
+			}
 		})
 	}
 }
@@ -446,6 +532,39 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				return assert.ErrorContains(t, err, "could not discover network interfaces:")
 			},
 		},
+		{
+			name: "error discover security groups",
+			fields: fields{
+				testhelper: "securitygroups",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			want: assert.Nil[[]ontology.IsResource],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not discover security groups:")
+			},
+		},
 		{
 			name: "error discover block storage",
 			fields: fields{
@@ -479,6 +598,39 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				return assert.ErrorContains(t, err, "could not discover block storage:")
 			},
 		},
+		{
+			name: "error discover object storage",
+			fields: fields{
+				testhelper: "objectstorage",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			want: assert.Nil[[]ontology.IsResource],
+			wantErr: func(tt assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not discover object storage:")
+			},
+		},
 		{
 			name: "error discover clusters",
 			fields: fields{
@@ -512,6 +664,111 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				return assert.ErrorContains(t, err, "could not discover clusters:")
 			},
 		},
+		{
+			name: "Happy path: error discovering load balancers is skipped",
+			fields: fields{
+				testhelper: "loadbalancers",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			want: func(t *testing.T, got []ontology.IsResource) bool {
+				// The other resources are still discovered
+				return assert.NotEmpty(t, got) && assert.False(t, slices.ContainsFunc(got, func(r ontology.IsResource) bool {
+					_, ok := r.(*ontology.LoadBalancer)
+					return ok
+				}))
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: error discovering secrets is skipped",
+			fields: fields{
+				testhelper: "secrets",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			want: func(t *testing.T, got []ontology.IsResource) bool {
+				// The other resources are still discovered
+				return assert.NotEmpty(t, got)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy path: error discovering secret containers is skipped",
+			fields: fields{
+				testhelper: "secretcontainers",
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					identityClient: client.ServiceClient(),
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			want: func(t *testing.T, got []ontology.IsResource) bool {
+				// The other resources are still discovered
+				return assert.NotEmpty(t, got)
+			},
+			wantErr: assert.NoError,
+		},
 		// {
 		// name: "error discover projects",
 		// Not possible to test. The method discoverServer() is called before discoverProjects() and adds the project ID which is the only possibility to get an error.
@@ -542,13 +799,17 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				projects: map[string]ontology.IsResource{},
 			},
 			want: func(t *testing.T, got []ontology.IsResource) bool {
-				_, ok := got[10].(*ontology.ResourceGroup)
+				_, ok := got[19].(*ontology.ResourceGroup)
 				assert.True(t, ok)
-				_, ok = got[11].(*ontology.ResourceGroup)
+				_, ok = got[20].(*ontology.ResourceGroup)
 				assert.True(t, ok)
-				_, ok = got[12].(*ontology.ResourceGroup)
+				_, ok = got[21].(*ontology.ResourceGroup)
 				assert.True(t, ok)
-				_, ok = got[13].(*ontology.ResourceGroup)
+				_, ok = got[22].(*ontology.ResourceGroup)
+				assert.True(t, ok)
+				_, ok = got[23].(*ontology.ResourceGroup)
+				assert.True(t, ok)
+				_, ok = got[24].(*ontology.ResourceGroup)
 				return assert.True(t, ok)
 			},
 			wantErr: assert.NoError,
@@ -588,7 +849,7 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				},
 			},
 			want: func(t *testing.T, got []ontology.IsResource) bool {
-				return assert.Equal(t, 14, len(got))
+				return assert.Equal(t, 25, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -618,7 +879,7 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				projects: map[string]ontology.IsResource{},
 			},
 			want: func(t *testing.T, got []ontology.IsResource) bool {
-				return assert.Equal(t, 14, len(got))
+				return assert.Equal(t, 25, len(got))
 			},
 			wantErr: assert.NoError,
 		},
@@ -649,6 +910,12 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				openstacktest.HandleNetworkListSuccessfully(t)
 				openstacktest.MockStorageListResponse(t)
 				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+				openstacktest.HandleLoadbalancerListSuccessfully(t)
+				openstacktest.HandleListenerListSuccessfully(t)
+				openstacktest.HandleListSecretsSuccessfully(t)
+				openstacktest.HandleListContainersSuccessfully(t)
 			case "domain":
 				fmt.Println("Setting up handlers to get an error for domain resources")
 				const ConsoleOutputBody = `{
@@ -661,6 +928,12 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				openstacktest.HandleNetworkListSuccessfully(t)
 				openstacktest.MockStorageListResponse(t)
 				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+				openstacktest.HandleLoadbalancerListSuccessfully(t)
+				openstacktest.HandleListenerListSuccessfully(t)
+				openstacktest.HandleListSecretsSuccessfully(t)
+				openstacktest.HandleListContainersSuccessfully(t)
 			case "project":
 				fmt.Println("Setting up handlers to get an error for project resources")
 				const ConsoleOutputBody = `{
@@ -673,6 +946,59 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				openstacktest.HandleNetworkListSuccessfully(t)
 				openstacktest.MockStorageListResponse(t)
 				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+				openstacktest.HandleLoadbalancerListSuccessfully(t)
+				openstacktest.HandleListenerListSuccessfully(t)
+				openstacktest.HandleListSecretsSuccessfully(t)
+				openstacktest.HandleListContainersSuccessfully(t)
+			case "secretcontainers":
+				fmt.Println("Setting up handlers to get an error for secret container resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
+				openstacktest.HandleServerListSuccessfully(t)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
+				openstacktest.HandleInterfaceListSuccessfully(t)
+				openstacktest.HandleNetworkListSuccessfully(t)
+				openstacktest.MockStorageListResponse(t)
+				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+				openstacktest.HandleLoadbalancerListSuccessfully(t)
+				openstacktest.HandleListenerListSuccessfully(t)
+				openstacktest.HandleListSecretsSuccessfully(t)
+			case "secrets":
+				fmt.Println("Setting up handlers to get an error for secret resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
+				openstacktest.HandleServerListSuccessfully(t)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
+				openstacktest.HandleInterfaceListSuccessfully(t)
+				openstacktest.HandleNetworkListSuccessfully(t)
+				openstacktest.MockStorageListResponse(t)
+				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+				openstacktest.HandleLoadbalancerListSuccessfully(t)
+				openstacktest.HandleListenerListSuccessfully(t)
+			case "loadbalancers":
+				fmt.Println("Setting up handlers to get an error for load balancer resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
+				openstacktest.HandleServerListSuccessfully(t)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
+				openstacktest.HandleInterfaceListSuccessfully(t)
+				openstacktest.HandleNetworkListSuccessfully(t)
+				openstacktest.MockStorageListResponse(t)
+				openstacktest.HandleListClusterSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
 			case "clusters":
 				fmt.Println("Setting up handlers to get an error for storage resources")
 				const ConsoleOutputBody = `{
@@ -684,12 +1010,37 @@ func Test_openstackDiscovery_List(t *testing.T) {
 				openstacktest.HandleInterfaceListSuccessfully(t)
 				openstacktest.HandleNetworkListSuccessfully(t)
 				openstacktest.MockStorageListResponse(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+				openstacktest.HandleContainerListSuccessfully(t)
+			case "objectstorage":
+				fmt.Println("Setting up handlers to get an error for object storage resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
+				openstacktest.HandleServerListSuccessfully(t)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
+				openstacktest.HandleInterfaceListSuccessfully(t)
+				openstacktest.HandleNetworkListSuccessfully(t)
+				openstacktest.MockStorageListResponse(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
 			case "storage":
 				fmt.Println("Setting up handlers to get an error for storage resources")
 				const ConsoleOutputBody = `{
 					"output": "output test"
 				}`
 
+				openstacktest.HandleServerListSuccessfully(t)
+				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
+				openstacktest.HandleInterfaceListSuccessfully(t)
+				openstacktest.HandleNetworkListSuccessfully(t)
+				openstacktest.HandleSecurityGroupListSuccessfully(t)
+			case "securitygroups":
+				fmt.Println("Setting up handlers to get an error for security group resources")
+				const ConsoleOutputBody = `{
+					"output": "output test"
+				}`
+
 				openstacktest.HandleServerListSuccessfully(t)
 				openstacktest.HandleShowConsoleOutputSuccessfully(t, ConsoleOutputBody)
 				openstacktest.HandleInterfaceListSuccessfully(t)
@@ -29,6 +29,7 @@ import (
 	"clouditor.io/clouditor/v2/api/ontology"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
 )
 
 // discoverBlockStorage discovers block storages
@@ -38,3 +39,11 @@ func (d *openstackDiscovery) discoverBlockStorage() (list []ontology.IsResource,
 
 	return
 }
+
+// discoverObjectStorage discovers object storages (Swift containers)
+func (d *openstackDiscovery) discoverObjectStorage() (list []ontology.IsResource, err error) {
+	var opts containers.ListOptsBuilder = &containers.ListOpts{}
+	list, err = genericList(d, d.objectStorageClient, containers.List, d.handleObjectStorage, containers.ExtractInfo, opts)
+
+	return
+}
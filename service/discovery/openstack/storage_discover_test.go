@@ -135,3 +135,102 @@ func Test_openstackDiscovery_discoverBlockStorage(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_discoverObjectStorage(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	openstacktest.HandleContainerListSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Happy path",
+			fields: fields{
+				authOpts: &gophercloud.AuthOptions{
+					IdentityEndpoint: testdata.MockOpenstackIdentityEndpoint,
+					Username:         testdata.MockOpenstackUsername,
+					Password:         testdata.MockOpenstackPassword,
+					TenantName:       testdata.MockOpenstackTenantName,
+				},
+				clients: clients{
+					provider: &gophercloud.ProviderClient{
+						TokenID: client.TokenID,
+						EndpointLocator: func(eo gophercloud.EndpointOpts) (string, error) {
+							return testhelper.Endpoint(), nil
+						},
+					},
+					objectStorageClient: client.ServiceClient(),
+				},
+				region: "test region",
+				domain: &domain{
+					domainID: testdata.MockOpenstackDomainID1,
+				},
+				project: &project{
+					projectID:   testdata.MockOpenstackProjectID1,
+					projectName: testdata.MockOpenstackProjectName1,
+				},
+				projects: map[string]ontology.IsResource{},
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				assert.Equal(t, 2, len(got))
+
+				want := &ontology.ObjectStorage{
+					Id:           testhelper.Endpoint() + "marktwain",
+					Name:         "marktwain",
+					Description:  "Swift container with 1 objects (14 bytes); storage policy: test_policy; versioning enabled: true",
+					CreationTime: swiftTimestamp(1471298837.95721),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					PublicAccess:               true,
+					InternetAccessibleEndpoint: true,
+					Labels:                     map[string]string{},
+					ParentId:                   util.Ref(testdata.MockOpenstackProjectID1),
+				}
+
+				got0, ok := got[0].(*ontology.ObjectStorage)
+				assert.True(t, ok)
+				assert.False(t, got0.GetPublicAccess())
+
+				got1, ok := got[1].(*ontology.ObjectStorage)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, got1.GetRaw())
+				got1.Raw = ""
+				return assert.Equal(t, want, got1)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			gotList, err := d.discoverObjectStorage()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
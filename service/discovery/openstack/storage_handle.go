@@ -26,6 +26,7 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
 
 	"clouditor.io/clouditor/v2/api/discovery"
@@ -33,6 +34,7 @@ import (
 	"clouditor.io/clouditor/v2/internal/util"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -67,3 +69,40 @@ func (d *openstackDiscovery) handleBlockStorage(volume *volumes.Volume) (ontolog
 
 	return r, nil
 }
+
+// handleObjectStorage creates an object storage resource based on the Clouditor Ontology. The container metadata,
+// e.g., the access control lists, is only available by retrieving each container.
+func (d *openstackDiscovery) handleObjectStorage(container *containers.Container) (ontology.IsResource, error) {
+	client, err := d.objectStorageClient()
+	if err != nil {
+		return nil, fmt.Errorf("could not get object storage client: %w", err)
+	}
+
+	header, err := containers.Get(context.Background(), client, container.Name, nil).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("could not get container '%s': %w", container.Name, err)
+	}
+
+	r := &ontology.ObjectStorage{
+		Id:           client.ServiceURL(container.Name),
+		Name:         container.Name,
+		Description:  objectStorageDescription(container, header),
+		CreationTime: swiftTimestamp(header.Timestamp),
+		GeoLocation: &ontology.GeoLocation{
+			Region: d.region,
+		},
+		PublicAccess:               isPublicReadACL(header.Read),
+		InternetAccessibleEndpoint: isPublicReadACL(header.Read),
+		Labels:                     map[string]string{}, // Not available
+		Raw:                        discovery.Raw(container, header),
+	}
+
+	// The Swift account belongs to the project
+	if d.configuredProject.projectID != "" {
+		r.ParentId = util.Ref(d.configuredProject.projectID)
+	}
+
+	log.Infof("Adding object storage '%s'", r.Name)
+
+	return r, nil
+}
@@ -32,9 +32,13 @@ import (
 	"clouditor.io/clouditor/v2/api/ontology"
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/openstacktest"
 	"clouditor.io/clouditor/v2/internal/util"
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -172,3 +176,115 @@ func Test_openstackDiscovery_handleBlockStorage(t *testing.T) {
 		})
 	}
 }
+
+func Test_openstackDiscovery_handleObjectStorage(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	openstacktest.HandleContainerListSuccessfully(t)
+
+	type fields struct {
+		ctID     string
+		clients  clients
+		authOpts *gophercloud.AuthOptions
+		region   string
+		domain   *domain
+		project  *project
+		projects map[string]ontology.IsResource
+	}
+	type args struct {
+		container *containers.Container
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    assert.Want[ontology.IsResource]
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error getting object storage client",
+			fields: fields{
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			args: args{
+				container: &containers.Container{Name: "janeausten"},
+			},
+			want: assert.Nil[ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not get object storage client")
+			},
+		},
+		{
+			name: "error getting container",
+			fields: fields{
+				clients: clients{
+					objectStorageClient: client.ServiceClient(),
+				},
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			args: args{
+				container: &containers.Container{Name: "unknown"},
+			},
+			want: assert.Nil[ontology.IsResource],
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorContains(t, err, "could not get container 'unknown'")
+			},
+		},
+		{
+			name: "Happy path: no project configured",
+			fields: fields{
+				clients: clients{
+					objectStorageClient: client.ServiceClient(),
+				},
+				region:  "test region",
+				domain:  &domain{},
+				project: &project{},
+			},
+			args: args{
+				container: &containers.Container{Name: "janeausten"},
+			},
+			want: func(t *testing.T, got ontology.IsResource) bool {
+				want := &ontology.ObjectStorage{
+					Id:           testhelper.Endpoint() + "janeausten",
+					Name:         "janeausten",
+					Description:  "Swift container with 0 objects (0 bytes); storage policy: test_policy; versioning enabled: false",
+					CreationTime: swiftTimestamp(1471298837.95721),
+					GeoLocation: &ontology.GeoLocation{
+						Region: "test region",
+					},
+					Labels: map[string]string{},
+				}
+
+				gotNew, ok := got.(*ontology.ObjectStorage)
+				assert.True(t, ok)
+
+				assert.NotEmpty(t, gotNew.GetRaw())
+				gotNew.Raw = ""
+				return assert.Equal(t, want, gotNew)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &openstackDiscovery{
+				ctID:               tt.fields.ctID,
+				clients:            tt.fields.clients,
+				authOpts:           tt.fields.authOpts,
+				region:             tt.fields.region,
+				domain:             tt.fields.domain,
+				configuredProject:  tt.fields.project,
+				discoveredProjects: tt.fields.projects,
+			}
+			got, err := d.handleObjectStorage(tt.args.container)
+
+			tt.want(t, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
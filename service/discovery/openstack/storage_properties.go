@@ -26,7 +26,14 @@
 package openstack
 
 import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // getParentID returns the parent ID of a volume.
@@ -41,3 +48,25 @@ func getParentID(volume *volumes.Volume) string {
 	// If no attachment is available, we attach it to the project ID
 	return volume.TenantID
 }
+
+// isPublicReadACL returns true, if the read ACL of a Swift container allows anonymous access from any referrer.
+func isPublicReadACL(acl []string) bool {
+	return slices.Contains(acl, ".r:*")
+}
+
+// swiftTimestamp converts the X-Timestamp header of Swift, which contains the creation time in seconds since the
+// epoch, into a timestamp.
+func swiftTimestamp(ts float64) *timestamppb.Timestamp {
+	if ts == 0 {
+		return nil
+	}
+
+	sec, frac := math.Modf(ts)
+	return timestamppb.New(time.Unix(int64(sec), int64(frac*1e9)).UTC())
+}
+
+// objectStorageDescription returns a description of the Swift container, since Swift containers do not have one.
+func objectStorageDescription(container *containers.Container, header *containers.GetHeader) string {
+	return fmt.Sprintf("Swift container with %d objects (%d bytes); storage policy: %s; versioning enabled: %t",
+		container.Count, container.Bytes, header.StoragePolicy, header.VersionsEnabled || header.VersionsLocation != "" || header.HistoryLocation != "")
+}
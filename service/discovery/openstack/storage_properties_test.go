@@ -27,10 +27,12 @@ package openstack
 
 import (
 	"testing"
+	"time"
 
 	"clouditor.io/clouditor/v2/internal/testdata"
 	"clouditor.io/clouditor/v2/internal/testutil/assert"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_getParentID(t *testing.T) {
@@ -78,3 +80,79 @@ func Test_getParentID(t *testing.T) {
 		})
 	}
 }
+
+func Test_isPublicReadACL(t *testing.T) {
+	type args struct {
+		acl []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "no ACL",
+			args: args{},
+			want: false,
+		},
+		{
+			name: "project ACL",
+			args: args{
+				acl: []string{"test:*"},
+			},
+			want: false,
+		},
+		{
+			name: "listing only",
+			args: args{
+				acl: []string{".rlistings"},
+			},
+			want: false,
+		},
+		{
+			name: "any referrer",
+			args: args{
+				acl: []string{".r:*", ".rlistings"},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isPublicReadACL(tt.args.acl)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_swiftTimestamp(t *testing.T) {
+	type args struct {
+		ts float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want *timestamppb.Timestamp
+	}{
+		{
+			name: "no timestamp",
+			args: args{},
+			want: nil,
+		},
+		{
+			name: "Happy path",
+			args: args{
+				ts: 1471298837.5,
+			},
+			want: timestamppb.New(time.Date(2016, 8, 15, 22, 7, 17, 500000000, time.UTC)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := swiftTimestamp(tt.args.ts)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,512 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// handleAWSInstance returns a [ontology.VirtualMachine] out of an aws_instance
+func (c *converter) handleAWSInstance(r *resource) []ontology.IsResource {
+	var (
+		arn       = r.Values.str("arn")
+		volumeIDs []string
+		nicIDs    []string
+	)
+
+	for _, device := range slices.Concat(r.Values.blocks("root_block_device"), r.Values.blocks("ebs_block_device")) {
+		if id := awsRelatedARN(arn, "volume", device.str("volume_id")); id != "" {
+			volumeIDs = append(volumeIDs, id)
+		}
+	}
+
+	if id := awsRelatedARN(arn, "network-interface", r.Values.str("primary_network_interface_id")); id != "" {
+		nicIDs = append(nicIDs, id)
+	}
+
+	return []ontology.IsResource{
+		&ontology.VirtualMachine{
+			Id:                  awsID(r),
+			Name:                awsName(r),
+			GeoLocation:         awsLocation(r),
+			Labels:              awsLabels(r),
+			ParentId:            awsRelatedARNPointer(arn, "subnet", r.Values.str("subnet_id")),
+			NetworkInterfaceIds: nicIDs,
+			BlockStorageIds:     volumeIDs,
+			Raw:                 discovery.Raw(r),
+		},
+	}
+}
+
+// handleAWSEBSVolume returns a [ontology.BlockStorage] out of an aws_ebs_volume
+func (c *converter) handleAWSEBSVolume(r *resource) []ontology.IsResource {
+	atRest := &ontology.ManagedKeyEncryption{
+		Enabled: r.Values.boolean("encrypted"),
+	}
+
+	// AWS uses a fixed algorithm, if enabled
+	if atRest.Enabled {
+		atRest.Algorithm = "AES-256"
+	}
+
+	return []ontology.IsResource{
+		&ontology.BlockStorage{
+			Id:          awsID(r),
+			Name:        awsName(r),
+			GeoLocation: awsLocation(r),
+			Labels:      awsLabels(r),
+			AtRestEncryption: &ontology.AtRestEncryption{
+				Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+					ManagedKeyEncryption: atRest,
+				},
+			},
+			Raw: discovery.Raw(r),
+		},
+	}
+}
+
+// handleAWSS3Bucket returns a [ontology.ObjectStorage] and a [ontology.ObjectStorageService] out of an aws_s3_bucket.
+// The encryption and the bucket policy are configured by the resources
+// aws_s3_bucket_server_side_encryption_configuration and aws_s3_bucket_policy.
+func (c *converter) handleAWSS3Bucket(r *resource) []ontology.IsResource {
+	var (
+		name   = r.Values.str("bucket")
+		region = awsRegion(r)
+		te     = &ontology.TransportEncryption{
+			Enforced:        c.awsBucketEnforcesTLS(name),
+			Enabled:         true,
+			Protocol:        "TLS",
+			ProtocolVersion: 1.2,
+		}
+	)
+
+	return []ontology.IsResource{
+		&ontology.ObjectStorage{
+			Id:               awsID(r),
+			Name:             name,
+			GeoLocation:      awsLocation(r),
+			Labels:           awsLabels(r),
+			AtRestEncryption: c.awsBucketEncryption(r),
+			Raw:              discovery.Raw(r),
+		},
+		&ontology.ObjectStorageService{
+			Id:                  awsID(r),
+			Name:                name,
+			GeoLocation:         awsLocation(r),
+			Labels:              awsLabels(r),
+			TransportEncryption: te,
+			HttpEndpoint: &ontology.HttpEndpoint{
+				Url:                 "https://" + name + ".s3." + region + ".amazonaws.com",
+				TransportEncryption: te,
+			},
+			Raw: discovery.Raw(r),
+		},
+	}
+}
+
+// awsBucketEncryption returns the default encryption of the bucket. It is either configured by a separate
+// aws_s3_bucket_server_side_encryption_configuration (AWS provider v4 and later) or inline. Since January 2023, S3
+// encrypts all new objects with S3-managed keys, if no encryption is configured.
+func (c *converter) awsBucketEncryption(bucket *resource) *ontology.AtRestEncryption {
+	sse := bucket.Values.block("server_side_encryption_configuration")
+
+	config := c.find("aws_s3_bucket_server_side_encryption_configuration", func(r *resource) bool {
+		return r.Values.str("bucket") == bucket.Values.str("bucket")
+	})
+	if config != nil {
+		sse = config.Values
+	}
+
+	def := sse.block("rule").block("apply_server_side_encryption_by_default")
+
+	switch alg := def.str("sse_algorithm"); alg {
+	case "", "AES256":
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Algorithm: "AES256",
+					Enabled:   true,
+				},
+			},
+		}
+	default:
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+					Enabled: true,
+					KeyUrl:  def.str("kms_master_key_id"),
+				},
+			},
+		}
+	}
+}
+
+// awsBucketEnforcesTLS returns true, if the policy of the bucket denies all requests that do not use TLS
+func (c *converter) awsBucketEnforcesTLS(bucket string) bool {
+	var policy struct {
+		Statement []struct {
+			Effect    string
+			Action    any
+			Condition map[string]map[string]any
+		}
+	}
+
+	r := c.find("aws_s3_bucket_policy", func(r *resource) bool {
+		return r.Values.str("bucket") == bucket
+	})
+	if r == nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(r.Values.str("policy")), &policy); err != nil {
+		log.Warnf("Could not parse policy of bucket '%s': %v", bucket, err)
+		return false
+	}
+
+	for _, statement := range policy.Statement {
+		if statement.Effect != "Deny" || !containsAction(statement.Action, "s3:*") {
+			continue
+		}
+
+		if fmt.Sprint(statement.Condition["Bool"]["aws:SecureTransport"]) == "false" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleAWSVPC returns a [ontology.VirtualNetwork] out of an aws_vpc
+func (c *converter) handleAWSVPC(r *resource) []ontology.IsResource {
+	return []ontology.IsResource{
+		&ontology.VirtualNetwork{
+			Id:          awsID(r),
+			Name:        awsName(r),
+			GeoLocation: awsLocation(r),
+			Labels:      awsLabels(r),
+			Raw:         discovery.Raw(r),
+		},
+	}
+}
+
+// handleAWSSubnet returns a [ontology.VirtualSubNetwork] out of an aws_subnet. A subnet is internet accessible, if
+// instances launched in it get a public IP address.
+func (c *converter) handleAWSSubnet(r *resource) []ontology.IsResource {
+	return []ontology.IsResource{
+		&ontology.VirtualSubNetwork{
+			Id:                         awsID(r),
+			Name:                       awsName(r),
+			GeoLocation:                awsLocation(r),
+			Labels:                     awsLabels(r),
+			InternetAccessibleEndpoint: r.Values.boolean("map_public_ip_on_launch"),
+			ParentId:                   awsRelatedARNPointer(r.Values.str("arn"), "vpc", r.Values.str("vpc_id")),
+			Raw:                        discovery.Raw(r),
+		},
+	}
+}
+
+// handleAWSSecurityGroup returns a [ontology.NetworkSecurityGroup] out of an aws_security_group. A security group is
+// internet accessible, if one of its ingress rules allows traffic from any address. The ingress rules are either
+// inline or separate aws_vpc_security_group_ingress_rule resources.
+func (c *converter) handleAWSSecurityGroup(r *resource) []ontology.IsResource {
+	var open bool
+
+	for _, ingress := range r.Values.blocks("ingress") {
+		if slices.Contains(ingress.strs("cidr_blocks"), "0.0.0.0/0") || slices.Contains(ingress.strs("ipv6_cidr_blocks"), "::/0") {
+			open = true
+		}
+	}
+
+	rules := c.filter("aws_vpc_security_group_ingress_rule", func(rule *resource) bool {
+		return rule.Values.str("security_group_id") != "" && rule.Values.str("security_group_id") == r.Values.str("id")
+	})
+	for _, rule := range rules {
+		if rule.Values.str("cidr_ipv4") == "0.0.0.0/0" || rule.Values.str("cidr_ipv6") == "::/0" {
+			open = true
+		}
+	}
+
+	return []ontology.IsResource{
+		&ontology.NetworkSecurityGroup{
+			Id:                         awsID(r),
+			Name:                       r.Values.str("name"),
+			Description:                r.Values.str("description"),
+			GeoLocation:                awsLocation(r),
+			Labels:                     awsLabels(r),
+			InternetAccessibleEndpoint: open,
+			ParentId:                   awsRelatedARNPointer(r.Values.str("arn"), "vpc", r.Values.str("vpc_id")),
+			Raw:                        discovery.Raw(r),
+		},
+	}
+}
+
+// handleAWSDBInstance returns a [ontology.RelationalDatabaseService] and its [ontology.DatabaseStorage] out of an
+// aws_db_instance. Members of an Aurora cluster use the cluster volume as their storage, therefore no storage is
+// returned for them.
+func (c *converter) handleAWSDBInstance(r *resource) []ontology.IsResource {
+	var (
+		id      = awsID(r)
+		multiAZ = r.Values.boolean("multi_az")
+	)
+
+	service := &ontology.RelationalDatabaseService{
+		Id:                         id,
+		Name:                       r.Values.str("identifier"),
+		GeoLocation:                awsLocation(r),
+		Labels:                     awsLabels(r),
+		InternetAccessibleEndpoint: r.Values.boolean("publicly_accessible"),
+		TransportEncryption: &ontology.TransportEncryption{
+			Enabled:  true,
+			Enforced: c.awsParameterGroupForcesSSL(r.Values.str("parameter_group_name")),
+			Protocol: constants.TLS,
+		},
+		Redundancies: awsRedundancies(r, multiAZ),
+		Raw:          discovery.Raw(r),
+	}
+
+	if port := r.Values.number("port"); port > 0 {
+		service.Ports = []uint32{uint32(port)}
+	}
+
+	if cluster := r.Values.str("cluster_identifier"); cluster != "" {
+		clusterARN := awsRelatedRDSARN(r.Values.str("arn"), "cluster", cluster)
+		if clusterARN != "" {
+			service.ParentId = &clusterARN
+			service.StorageIds = []string{clusterARN + "/storage"}
+		}
+
+		return []ontology.IsResource{service}
+	}
+
+	storage := &ontology.DatabaseStorage{
+		Id:               id + "/storage",
+		Name:             r.Values.str("identifier"),
+		GeoLocation:      awsLocation(r),
+		Labels:           awsLabels(r),
+		ParentId:         &id,
+		AtRestEncryption: awsDBEncryption(r),
+		Backups: []*ontology.Backup{
+			{
+				Enabled:         r.Values.number("backup_retention_period") > 0,
+				Interval:        durationpb.New(24 * time.Hour),
+				RetentionPeriod: durationpb.New(time.Duration(r.Values.number("backup_retention_period")) * 24 * time.Hour),
+			},
+		},
+		Redundancies: awsRedundancies(r, multiAZ),
+		Raw:          discovery.Raw(r),
+	}
+
+	service.StorageIds = []string{storage.Id}
+
+	return []ontology.IsResource{service, storage}
+}
+
+// awsParameterGroupForcesSSL checks the engine-specific parameters of the DB parameter group that enforce SSL/TLS
+// connections, i.e., 'rds.force_ssl' for PostgreSQL and SQL Server and 'require_secure_transport' for MySQL and MariaDB
+func (c *converter) awsParameterGroupForcesSSL(name string) bool {
+	group := c.find("aws_db_parameter_group", func(r *resource) bool {
+		return name != "" && r.Values.str("name") == name
+	})
+	if group == nil {
+		return false
+	}
+
+	for _, p := range group.Values.blocks("parameter") {
+		switch p.str("name") {
+		case "rds.force_ssl", "require_secure_transport":
+			switch strings.ToLower(p.str("value")) {
+			case "1", "on", "true":
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// awsDBEncryption returns the at-rest encryption of a database storage. RDS always uses KMS for storage encryption.
+func awsDBEncryption(r *resource) *ontology.AtRestEncryption {
+	if !r.Values.boolean("storage_encrypted") {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Enabled: false,
+				},
+			},
+		}
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+				Enabled:   true,
+				Algorithm: constants.AES256,
+				KeyUrl:    r.Values.str("kms_key_id"),
+			},
+		},
+	}
+}
+
+// awsRedundancies returns a zone redundancy for Multi-AZ deployments
+func awsRedundancies(r *resource, multiAZ bool) []*ontology.Redundancy {
+	if !multiAZ {
+		return nil
+	}
+
+	return []*ontology.Redundancy{
+		{
+			Type: &ontology.Redundancy_ZoneRedundancy{
+				ZoneRedundancy: &ontology.ZoneRedundancy{
+					GeoLocations: []*ontology.GeoLocation{awsLocation(r)},
+				},
+			},
+		},
+	}
+}
+
+// handleAWSLambdaFunction returns a [ontology.Function] out of an aws_lambda_function
+func (c *converter) handleAWSLambdaFunction(r *resource) []ontology.IsResource {
+	return []ontology.IsResource{
+		&ontology.Function{
+			Id:          awsID(r),
+			Name:        r.Values.str("function_name"),
+			GeoLocation: awsLocation(r),
+			Labels:      awsLabels(r),
+			Raw:         discovery.Raw(r),
+		},
+	}
+}
+
+// awsID returns the ARN of the resource. The ARN of a resource that is created by a plan is only known after apply, in
+// this case the address of the resource is used instead.
+func awsID(r *resource) string {
+	if arn := r.Values.str("arn"); arn != "" {
+		return arn
+	}
+
+	return r.Address
+}
+
+// awsName returns the value of the "Name" tag or the ID of the resource, like the AWS console does. If both are
+// unknown, the name of the resource in the Terraform configuration is used.
+func awsName(r *resource) string {
+	if name := r.Values.labels("tags")["Name"]; name != "" {
+		return name
+	}
+
+	if id := r.Values.str("id"); id != "" {
+		return id
+	}
+
+	return r.Name
+}
+
+// awsLabels returns the tags of the resource including the default tags of the provider, if they are known
+func awsLabels(r *resource) map[string]string {
+	if tags := r.Values.labels("tags_all"); tags != nil {
+		return tags
+	}
+
+	return r.Values.labels("tags")
+}
+
+// awsRegion returns the region of the resource. It is taken from the region attribute (AWS provider v6 and S3
+// buckets), the ARN or the availability zone.
+func awsRegion(r *resource) string {
+	if region := r.Values.str("region"); region != "" {
+		return region
+	}
+
+	if parts := strings.Split(r.Values.str("arn"), ":"); len(parts) >= 6 && parts[3] != "" {
+		return parts[3]
+	}
+
+	if az := r.Values.str("availability_zone"); len(az) > 1 {
+		return az[:len(az)-1]
+	}
+
+	return ""
+}
+
+func awsLocation(r *resource) *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: awsRegion(r),
+	}
+}
+
+// awsRelatedARN returns the ARN of a related EC2 resource, e.g., the subnet of an instance, which resides in the same
+// account and region as the resource with the given ARN. An empty string is returned, if the ARN or the ID is unknown.
+func awsRelatedARN(arn string, typ string, id string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 || id == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s/%s", parts[1], parts[2], parts[3], parts[4], typ, id)
+}
+
+func awsRelatedARNPointer(arn string, typ string, id string) *string {
+	if related := awsRelatedARN(arn, typ, id); related != "" {
+		return util.Ref(related)
+	}
+
+	return nil
+}
+
+// awsRelatedRDSARN returns the ARN of a related RDS resource. In contrast to EC2, RDS separates the resource type and
+// name by a colon (arn:aws:rds:<region>:<account>:cluster:<name>).
+func awsRelatedRDSARN(arn string, typ string, name string) string {
+	parts := strings.Split(arn, ":")
+	if len(parts) < 6 || name == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s:%s", parts[1], parts[2], parts[3], parts[4], typ, name)
+}
+
+// containsAction returns true, if the action of a policy statement, which is either a string or a list of strings,
+// contains the given action
+func containsAction(action any, want string) bool {
+	switch a := action.(type) {
+	case string:
+		return a == want
+	case []any:
+		return slices.Contains(a, any(want))
+	default:
+		return false
+	}
+}
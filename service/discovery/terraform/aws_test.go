@@ -0,0 +1,180 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+)
+
+func Test_converter_handleAWS(t *testing.T) {
+	resources, err := parseFile("testdata/aws_state.json")
+	assert.NoError(t, err)
+
+	list := newConverter(resources).convert()
+	assert.Equal(t, 10, len(list))
+
+	vm, ok := list[0].(*ontology.VirtualMachine)
+	assert.True(t, ok)
+	assert.NotEmpty(t, vm.GetRaw())
+	vm.Raw = ""
+	assert.Equal(t, &ontology.VirtualMachine{
+		Id:   "arn:aws:ec2:eu-central-1:123456789012:instance/i-0a1b2c3d4e5f60001",
+		Name: "web",
+		GeoLocation: &ontology.GeoLocation{
+			Region: "eu-central-1",
+		},
+		Labels:              map[string]string{"Name": "web", "env": "prod"},
+		ParentId:            util.Ref("arn:aws:ec2:eu-central-1:123456789012:subnet/subnet-0a1b2c3d4e5f60001"),
+		NetworkInterfaceIds: []string{"arn:aws:ec2:eu-central-1:123456789012:network-interface/eni-0a1b2c3d4e5f60001"},
+		BlockStorageIds:     []string{"arn:aws:ec2:eu-central-1:123456789012:volume/vol-0a1b2c3d4e5f60001"},
+	}, vm)
+
+	volume, ok := list[1].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.False(t, volume.GetAtRestEncryption().GetManagedKeyEncryption().GetEnabled())
+
+	// The encryption is configured by aws_s3_bucket_server_side_encryption_configuration, which takes precedence over
+	// the computed inline configuration
+	bucket, ok := list[2].(*ontology.ObjectStorage)
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:kms:eu-central-1:123456789012:key/0a1b2c3d-0000-0000-0000-000000000001", bucket.GetAtRestEncryption().GetCustomerKeyEncryption().GetKeyUrl())
+
+	// TLS is enforced by the bucket policy
+	service, ok := list[3].(*ontology.ObjectStorageService)
+	assert.True(t, ok)
+	assert.True(t, service.GetTransportEncryption().GetEnforced())
+	assert.Equal(t, "https://clouditor-logs.s3.eu-central-1.amazonaws.com", service.GetHttpEndpoint().GetUrl())
+
+	// SSL is forced by the parameter group
+	db, ok := list[4].(*ontology.RelationalDatabaseService)
+	assert.True(t, ok)
+	assert.True(t, db.GetTransportEncryption().GetEnforced())
+	assert.Equal(t, []string{"arn:aws:rds:eu-central-1:123456789012:db:orders/storage"}, db.GetStorageIds())
+
+	storage, ok := list[5].(*ontology.DatabaseStorage)
+	assert.True(t, ok)
+	assert.True(t, storage.GetAtRestEncryption().GetCustomerKeyEncryption().GetEnabled())
+	assert.Equal(t, int64(7*24*60*60), storage.GetBackups()[0].GetRetentionPeriod().GetSeconds())
+
+	subnet, ok := list[8].(*ontology.VirtualSubNetwork)
+	assert.True(t, ok)
+	assert.True(t, subnet.GetInternetAccessibleEndpoint())
+
+	// The inline rule only allows traffic from the VPC, but the separate ingress rule allows SSH from the internet
+	sg, ok := list[9].(*ontology.NetworkSecurityGroup)
+	assert.True(t, ok)
+	assert.True(t, sg.GetInternetAccessibleEndpoint())
+	assert.Equal(t, "arn:aws:ec2:eu-central-1:123456789012:vpc/vpc-0a1b2c3d4e5f60001", sg.GetParentId())
+}
+
+func Test_converter_awsBucketEnforcesTLS(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		want   bool
+	}{
+		{
+			name:   "invalid policy",
+			policy: `{`,
+			want:   false,
+		},
+		{
+			name:   "policy allows insecure transport",
+			policy: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+			want:   false,
+		},
+		{
+			name:   "policy denies insecure transport",
+			policy: `{"Statement":[{"Effect":"Deny","Action":["s3:GetObject","s3:*"],"Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newConverter([]*resource{
+				{
+					Type:   "aws_s3_bucket_policy",
+					Values: values{"bucket": "bucket", "policy": tt.policy},
+				},
+			})
+
+			assert.Equal(t, tt.want, c.awsBucketEnforcesTLS("bucket"))
+			assert.False(t, c.awsBucketEnforcesTLS("other-bucket"))
+		})
+	}
+}
+
+func Test_awsID(t *testing.T) {
+	// The ARN is unknown in a plan before the resource is created
+	r := &resource{Address: "aws_vpc.main", Name: "main", Values: values{}}
+	assert.Equal(t, "aws_vpc.main", awsID(r))
+	assert.Equal(t, "main", awsName(r))
+	assert.Nil(t, awsRelatedARNPointer(r.Values.str("arn"), "vpc", "vpc-1"))
+}
+
+func Test_awsRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		values values
+		want   string
+	}{
+		{
+			name:   "region",
+			values: values{"region": "eu-west-1", "arn": "arn:aws:ec2:eu-central-1:123456789012:vpc/vpc-1"},
+			want:   "eu-west-1",
+		},
+		{
+			name:   "ARN",
+			values: values{"arn": "arn:aws:ec2:eu-central-1:123456789012:vpc/vpc-1", "availability_zone": "eu-west-1a"},
+			want:   "eu-central-1",
+		},
+		{
+			name:   "global ARN and availability zone",
+			values: values{"arn": "arn:aws:s3:::bucket", "availability_zone": "eu-west-1a"},
+			want:   "eu-west-1",
+		},
+		{
+			name:   "unknown",
+			values: values{},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, awsRegion(&resource{Values: tt.values}))
+		})
+	}
+}
+
+func Test_awsRelatedRDSARN(t *testing.T) {
+	assert.Equal(t, "arn:aws:rds:eu-central-1:123456789012:cluster:orders",
+		awsRelatedRDSARN("arn:aws:rds:eu-central-1:123456789012:db:orders-1", "cluster", "orders"))
+	assert.Empty(t, awsRelatedRDSARN("aws_db_instance.orders", "cluster", "orders"))
+}
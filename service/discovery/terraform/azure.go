@@ -0,0 +1,351 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// AzureActivityLogRetention is the retention period of the Azure activity log, which is always enabled
+	AzureActivityLogRetention = 90 * 24 * time.Hour
+	// AzureAutomaticUpdatesInterval is the interval in which automatic updates are installed
+	AzureAutomaticUpdatesInterval = 30 * 24 * time.Hour
+)
+
+// azureInternetAddressPrefixes are the source address prefixes of network security rules that match the internet
+var azureInternetAddressPrefixes = []string{"*", "Internet", "0.0.0.0/0", "::/0"}
+
+// handleAzureVirtualMachine returns a [ontology.VirtualMachine] out of an azurerm_linux_virtual_machine or
+// azurerm_windows_virtual_machine. The data disks are attached by azurerm_virtual_machine_data_disk_attachment
+// resources.
+func (c *converter) handleAzureVirtualMachine(r *resource) []ontology.IsResource {
+	var (
+		id      = azureID(r)
+		diskIDs = []string{}
+		nicIDs  = []string{}
+	)
+
+	for _, nic := range r.Values.strs("network_interface_ids") {
+		nicIDs = append(nicIDs, strings.ToLower(nic))
+	}
+
+	attachments := c.filter("azurerm_virtual_machine_data_disk_attachment", func(a *resource) bool {
+		return strings.EqualFold(a.Values.str("virtual_machine_id"), id)
+	})
+	for _, a := range attachments {
+		diskIDs = append(diskIDs, strings.ToLower(a.Values.str("managed_disk_id")))
+	}
+
+	return []ontology.IsResource{
+		&ontology.VirtualMachine{
+			Id:                  id,
+			Name:                r.Values.str("name"),
+			GeoLocation:         azureLocation(r),
+			Labels:              r.Values.labels("tags"),
+			ParentId:            azureResourceGroupID(id),
+			NetworkInterfaceIds: nicIDs,
+			BlockStorageIds:     diskIDs,
+			BootLogging: &ontology.BootLogging{
+				Enabled:         len(r.Values.blocks("boot_diagnostics")) > 0,
+				RetentionPeriod: durationpb.New(0),
+			},
+			ActivityLogging: &ontology.ActivityLogging{
+				Enabled:         true, // is always enabled
+				RetentionPeriod: durationpb.New(AzureActivityLogRetention),
+			},
+			AutomaticUpdates: azureAutomaticUpdates(r),
+			Raw:              discovery.Raw(r),
+		},
+	}
+}
+
+// azureAutomaticUpdates returns whether the guest OS of the VM is patched automatically. Linux VMs are patched by the
+// platform, Windows VMs either by the platform or by Windows Update.
+func azureAutomaticUpdates(r *resource) *ontology.AutomaticUpdates {
+	var enabled bool
+
+	switch r.Values.str("patch_mode") {
+	case "AutomaticByPlatform":
+		enabled = true
+	case "AutomaticByOS":
+		// The attribute was renamed in version 4 of the provider
+		enabled = r.Values.boolOr("automatic_updates_enabled", r.Values.boolOr("enable_automatic_updates", true))
+	}
+
+	if !enabled {
+		return &ontology.AutomaticUpdates{}
+	}
+
+	return &ontology.AutomaticUpdates{
+		Enabled:  true,
+		Interval: durationpb.New(AzureAutomaticUpdatesInterval),
+	}
+}
+
+// handleAzureManagedDisk returns a [ontology.BlockStorage] out of an azurerm_managed_disk. Disks are encrypted with a
+// platform-managed key, unless a disk encryption set with a customer-managed key is configured.
+func (c *converter) handleAzureManagedDisk(r *resource) []ontology.IsResource {
+	var enc *ontology.AtRestEncryption
+
+	if desID := r.Values.str("disk_encryption_set_id"); desID != "" {
+		var keyURL string
+
+		des := c.find("azurerm_disk_encryption_set", func(des *resource) bool {
+			return strings.EqualFold(des.Values.str("id"), desID)
+		})
+		if des != nil {
+			keyURL = des.Values.str("key_vault_key_id")
+		}
+
+		enc = &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+				CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+					Enabled: true,
+					KeyUrl:  keyURL,
+				},
+			},
+		}
+	} else {
+		enc = &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Algorithm: "AES256",
+					Enabled:   true,
+				},
+			},
+		}
+	}
+
+	return []ontology.IsResource{
+		&ontology.BlockStorage{
+			Id:               azureID(r),
+			Name:             r.Values.str("name"),
+			GeoLocation:      azureLocation(r),
+			Labels:           r.Values.labels("tags"),
+			ParentId:         azureResourceGroupID(azureID(r)),
+			AtRestEncryption: enc,
+			Raw:              discovery.Raw(r),
+		},
+	}
+}
+
+// handleAzureStorageAccount returns a [ontology.ObjectStorageService] out of an azurerm_storage_account
+func (c *converter) handleAzureStorageAccount(r *resource) []ontology.IsResource {
+	te := &ontology.TransportEncryption{
+		// The attribute was renamed in version 4 of the provider
+		Enforced:        r.Values.boolOr("https_traffic_only_enabled", r.Values.boolOr("enable_https_traffic_only", true)),
+		Enabled:         true, // cannot be disabled
+		Protocol:        constants.TLS,
+		ProtocolVersion: azureTLSVersion(r.Values.str("min_tls_version")),
+	}
+
+	return []ontology.IsResource{
+		&ontology.ObjectStorageService{
+			Id:                  azureID(r),
+			Name:                r.Values.str("name"),
+			GeoLocation:         azureLocation(r),
+			Labels:              r.Values.labels("tags"),
+			ParentId:            azureResourceGroupID(azureID(r)),
+			TransportEncryption: te,
+			HttpEndpoint: &ontology.HttpEndpoint{
+				Url:                 azureGeneralizeURL(r.Values.str("primary_blob_endpoint")),
+				TransportEncryption: te,
+			},
+			Raw: discovery.Raw(r),
+		},
+	}
+}
+
+// handleAzureVirtualNetwork returns a [ontology.VirtualNetwork] out of an azurerm_virtual_network
+func (c *converter) handleAzureVirtualNetwork(r *resource) []ontology.IsResource {
+	return []ontology.IsResource{
+		&ontology.VirtualNetwork{
+			Id:          azureID(r),
+			Name:        r.Values.str("name"),
+			GeoLocation: azureLocation(r),
+			Labels:      r.Values.labels("tags"),
+			ParentId:    azureResourceGroupID(azureID(r)),
+			Raw:         discovery.Raw(r),
+		},
+	}
+}
+
+// handleAzureSubnet returns a [ontology.VirtualSubNetwork] out of an azurerm_subnet. Subnets have neither a location
+// nor tags, therefore the ones of the virtual network are used.
+func (c *converter) handleAzureSubnet(r *resource) []ontology.IsResource {
+	var (
+		parentID *string
+		geo      *ontology.GeoLocation
+		labels   map[string]string
+	)
+
+	vnet := c.find("azurerm_virtual_network", func(vnet *resource) bool {
+		return vnet.Values.str("name") == r.Values.str("virtual_network_name") &&
+			vnet.Values.str("resource_group_name") == r.Values.str("resource_group_name")
+	})
+	if vnet != nil {
+		parentID = util.Ref(azureID(vnet))
+		geo = azureLocation(vnet)
+		labels = vnet.Values.labels("tags")
+	}
+
+	return []ontology.IsResource{
+		&ontology.VirtualSubNetwork{
+			Id:          azureID(r),
+			Name:        r.Values.str("name"),
+			GeoLocation: geo,
+			Labels:      labels,
+			ParentId:    parentID,
+			Raw:         discovery.Raw(r),
+		},
+	}
+}
+
+// handleAzureNetworkSecurityGroup returns a [ontology.NetworkSecurityGroup] out of an azurerm_network_security_group.
+// The security rules are either inline or separate azurerm_network_security_rule resources.
+func (c *converter) handleAzureNetworkSecurityGroup(r *resource) []ontology.IsResource {
+	rules := r.Values.blocks("security_rule")
+
+	for _, rule := range c.filter("azurerm_network_security_rule", func(rule *resource) bool {
+		return rule.Values.str("network_security_group_name") == r.Values.str("name") &&
+			rule.Values.str("resource_group_name") == r.Values.str("resource_group_name")
+	}) {
+		rules = append(rules, rule.Values)
+	}
+
+	return []ontology.IsResource{
+		&ontology.NetworkSecurityGroup{
+			Id:                         azureID(r),
+			Name:                       r.Values.str("name"),
+			GeoLocation:                azureLocation(r),
+			Labels:                     r.Values.labels("tags"),
+			ParentId:                   azureResourceGroupID(azureID(r)),
+			InternetAccessibleEndpoint: azureRulesAllowInboundInternet(rules),
+			Raw:                        discovery.Raw(r),
+		},
+	}
+}
+
+// azureRulesAllowInboundInternet returns true, if the inbound rules, evaluated in the order of their priority, allow
+// traffic from the internet to at least one port. A rule that denies traffic from the internet only prevents this, if
+// it applies to all ports. The default rules of Azure do not allow traffic from the internet, so they can be ignored.
+func azureRulesAllowInboundInternet(rules []values) bool {
+	rules = slices.Clone(rules)
+	slices.SortStableFunc(rules, func(a, b values) int {
+		return cmp.Compare(a.number("priority"), b.number("priority"))
+	})
+
+	for _, rule := range rules {
+		if rule.str("direction") != "Inbound" {
+			continue
+		}
+
+		sources := append([]string{rule.str("source_address_prefix")}, rule.strs("source_address_prefixes")...)
+		if !slices.ContainsFunc(sources, func(prefix string) bool {
+			return slices.Contains(azureInternetAddressPrefixes, prefix)
+		}) {
+			continue
+		}
+
+		switch rule.str("access") {
+		case "Allow":
+			return true
+		case "Deny":
+			if rule.str("destination_port_range") == "*" {
+				return false
+			}
+		}
+	}
+
+	return false
+}
+
+// azureID returns the lower-case ID of the resource, like the Azure discoverer does. The ID of a resource that is
+// created by a plan is only known after apply, in this case the address of the resource is used instead.
+func azureID(r *resource) string {
+	if id := r.Values.str("id"); id != "" {
+		return strings.ToLower(id)
+	}
+
+	return r.Address
+}
+
+// azureResourceGroupID returns the ID of the resource group of a resource ID
+// (/subscriptions/<subscription>/resourceGroups/<group>/...)
+func azureResourceGroupID(id string) *string {
+	s := strings.Split(id, "/")
+	if len(s) < 5 {
+		return nil
+	}
+
+	return util.Ref(strings.ToLower(strings.Join(s[:5], "/")))
+}
+
+func azureLocation(r *resource) *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: r.Values.str("location"),
+	}
+}
+
+// azureTLSVersion returns the TLS version of the min_tls_version attribute, e.g., TLS1_2
+func azureTLSVersion(version string) float32 {
+	switch version {
+	case "TLS1_0":
+		return 1.0
+	case "TLS1_1":
+		return 1.1
+	case "TLS1_2":
+		return 1.2
+	case "TLS1_3":
+		return 1.3
+	default:
+		return 0
+	}
+}
+
+// azureGeneralizeURL replaces the service of a storage endpoint, e.g., https://account.blob.core.windows.net/, with
+// "[file,blob]", like the Azure discoverer does
+func azureGeneralizeURL(url string) string {
+	parts := strings.Split(url, ".")
+	if len(parts) < 2 {
+		return url
+	}
+
+	parts[1] = "[file,blob]"
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func Test_converter_handleAzure_plan(t *testing.T) {
+	resources, err := parseFile("testdata/azure_plan.json")
+	assert.NoError(t, err)
+
+	// The IDs are only known after apply, so the addresses are used instead
+	list := newConverter(resources).convert()
+	assert.Equal(t, []string{
+		"azurerm_virtual_network.main",
+		"azurerm_subnet.internal",
+		"azurerm_network_security_group.web",
+		"azurerm_linux_virtual_machine.web",
+		"azurerm_managed_disk.data",
+		"azurerm_storage_account.backup",
+	}, ontology.ResourceIDs(list))
+
+	// The subnet inherits the location and tags of its virtual network
+	subnet, ok := list[1].(*ontology.VirtualSubNetwork)
+	assert.True(t, ok)
+	assert.Equal(t, "azurerm_virtual_network.main", subnet.GetParentId())
+	assert.Equal(t, "westeurope", subnet.GetGeoLocation().GetRegion())
+	assert.Equal(t, map[string]string{"env": "dev"}, subnet.GetLabels())
+
+	// The inline rule only denies RDP, the separate rule allows SSH from the internet
+	nsg, ok := list[2].(*ontology.NetworkSecurityGroup)
+	assert.True(t, ok)
+	assert.True(t, nsg.GetInternetAccessibleEndpoint())
+
+	vm, ok := list[3].(*ontology.VirtualMachine)
+	assert.True(t, ok)
+	assert.NotEmpty(t, vm.GetRaw())
+	vm.Raw = ""
+	assert.Equal(t, &ontology.VirtualMachine{
+		Id:   "azurerm_linux_virtual_machine.web",
+		Name: "web",
+		GeoLocation: &ontology.GeoLocation{
+			Region: "westeurope",
+		},
+		Labels:              map[string]string{"env": "dev"},
+		NetworkInterfaceIds: []string{},
+		BlockStorageIds:     []string{},
+		BootLogging: &ontology.BootLogging{
+			Enabled:         true,
+			RetentionPeriod: durationpb.New(0),
+		},
+		ActivityLogging: &ontology.ActivityLogging{
+			Enabled:         true,
+			RetentionPeriod: durationpb.New(AzureActivityLogRetention),
+		},
+		AutomaticUpdates: &ontology.AutomaticUpdates{
+			Enabled:  true,
+			Interval: durationpb.New(AzureAutomaticUpdatesInterval),
+		},
+	}, vm)
+
+	disk, ok := list[4].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.Equal(t, constants.AES256, disk.GetAtRestEncryption().GetManagedKeyEncryption().GetAlgorithm())
+
+	storage, ok := list[5].(*ontology.ObjectStorageService)
+	assert.True(t, ok)
+	assert.False(t, storage.GetTransportEncryption().GetEnforced())
+	assert.Equal(t, float32(1.0), storage.GetTransportEncryption().GetProtocolVersion())
+}
+
+func Test_converter_handleAzure_state(t *testing.T) {
+	resources, err := parseFile("testdata/terraform.tfstate")
+	assert.NoError(t, err)
+
+	list := newConverter(resources).convert()
+	assert.Equal(t, 3, len(list))
+
+	// The key of the disk encryption set is used
+	disk, ok := list[0].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/clouditor/providers/microsoft.compute/disks/data-0", disk.GetId())
+	assert.Equal(t, util.Ref("/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/clouditor"), disk.ParentId)
+	assert.Equal(t, "https://clouditor.vault.azure.net/keys/disks/0123456789abcdef", disk.GetAtRestEncryption().GetCustomerKeyEncryption().GetKeyUrl())
+
+	disk, ok = list[1].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.True(t, disk.GetAtRestEncryption().GetManagedKeyEncryption().GetEnabled())
+
+	// The data disk is attached by a separate resource, boot diagnostics are disabled and the patches are installed by
+	// the OS
+	vm, ok := list[2].(*ontology.VirtualMachine)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/clouditor/providers/microsoft.compute/disks/data-0"}, vm.GetBlockStorageIds())
+	assert.Equal(t, []string{"/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/clouditor/providers/microsoft.network/networkinterfaces/app-blue"}, vm.GetNetworkInterfaceIds())
+	assert.False(t, vm.GetBootLogging().GetEnabled())
+	assert.True(t, vm.GetAutomaticUpdates().GetEnabled())
+}
+
+func Test_azureRulesAllowInboundInternet(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []values
+		want  bool
+	}{
+		{
+			name: "no rules",
+			want: false,
+		},
+		{
+			name: "outbound and internal rules only",
+			rules: []values{
+				{"direction": "Outbound", "access": "Allow", "source_address_prefix": "*"},
+				{"direction": "Inbound", "access": "Allow", "source_address_prefix": "VirtualNetwork"},
+			},
+			want: false,
+		},
+		{
+			name: "deny all ports with a higher priority",
+			rules: []values{
+				{"direction": "Inbound", "access": "Allow", "source_address_prefix": "Internet", "priority": float64(200)},
+				{"direction": "Inbound", "access": "Deny", "source_address_prefix": "*", "destination_port_range": "*", "priority": float64(100)},
+			},
+			want: false,
+		},
+		{
+			name: "allow from the internet with a source address prefix list",
+			rules: []values{
+				{"direction": "Inbound", "access": "Deny", "source_address_prefix": "*", "destination_port_range": "3389", "priority": float64(100)},
+				{"direction": "Inbound", "access": "Allow", "source_address_prefixes": []any{"10.0.0.0/8", "0.0.0.0/0"}, "priority": float64(200)},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, azureRulesAllowInboundInternet(tt.rules))
+		})
+	}
+}
+
+func Test_azureTLSVersion(t *testing.T) {
+	assert.Equal(t, float32(1.2), azureTLSVersion("TLS1_2"))
+	assert.Equal(t, float32(0), azureTLSVersion(""))
+}
+
+func Test_azureGeneralizeURL(t *testing.T) {
+	assert.Equal(t, "https://account.[file,blob].core.windows.net/", azureGeneralizeURL("https://account.blob.core.windows.net/"))
+	assert.Equal(t, "", azureGeneralizeURL(""))
+}
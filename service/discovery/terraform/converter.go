@@ -0,0 +1,114 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"clouditor.io/clouditor/v2/api/ontology"
+)
+
+// mapper maps a Terraform resource onto ontology resources. The converter is used to look up related resources, e.g.,
+// the encryption configuration of an S3 bucket, which is a resource of its own.
+type mapper func(c *converter, r *resource) []ontology.IsResource
+
+// mappers contains the mappers of the supported resource types
+var mappers = map[string]mapper{
+	"aws_instance":                    (*converter).handleAWSInstance,
+	"aws_ebs_volume":                  (*converter).handleAWSEBSVolume,
+	"aws_s3_bucket":                   (*converter).handleAWSS3Bucket,
+	"aws_vpc":                         (*converter).handleAWSVPC,
+	"aws_subnet":                      (*converter).handleAWSSubnet,
+	"aws_security_group":              (*converter).handleAWSSecurityGroup,
+	"aws_db_instance":                 (*converter).handleAWSDBInstance,
+	"aws_lambda_function":             (*converter).handleAWSLambdaFunction,
+	"azurerm_linux_virtual_machine":   (*converter).handleAzureVirtualMachine,
+	"azurerm_windows_virtual_machine": (*converter).handleAzureVirtualMachine,
+	"azurerm_managed_disk":            (*converter).handleAzureManagedDisk,
+	"azurerm_storage_account":         (*converter).handleAzureStorageAccount,
+	"azurerm_virtual_network":         (*converter).handleAzureVirtualNetwork,
+	"azurerm_subnet":                  (*converter).handleAzureSubnet,
+	"azurerm_network_security_group":  (*converter).handleAzureNetworkSecurityGroup,
+}
+
+// converter converts the resources of a single Terraform state or plan
+type converter struct {
+	resources []*resource
+	// byType contains the resources grouped by their type
+	byType map[string][]*resource
+}
+
+func newConverter(resources []*resource) *converter {
+	c := &converter{
+		resources: resources,
+		byType:    make(map[string][]*resource),
+	}
+
+	for _, r := range resources {
+		c.byType[r.Type] = append(c.byType[r.Type], r)
+	}
+
+	return c
+}
+
+// convert maps all resources of supported types onto ontology resources
+func (c *converter) convert() (list []ontology.IsResource) {
+	for _, r := range c.resources {
+		m, ok := mappers[r.Type]
+		if !ok {
+			log.Debugf("Skipping resource '%s' of unsupported type '%s'", r.Address, r.Type)
+			continue
+		}
+
+		for _, o := range m(c, r) {
+			log.Infof("Adding %s '%s'", ontology.ResourceTypes(o)[0], o.GetName())
+
+			list = append(list, o)
+		}
+	}
+
+	return
+}
+
+// find returns the first resource of the given type that matches the function
+func (c *converter) find(typ string, match func(r *resource) bool) *resource {
+	for _, r := range c.byType[typ] {
+		if match(r) {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// filter returns all resources of the given type that match the function
+func (c *converter) filter(typ string, match func(r *resource) bool) (list []*resource) {
+	for _, r := range c.byType[typ] {
+		if match(r) {
+			list = append(list, r)
+		}
+	}
+
+	return
+}
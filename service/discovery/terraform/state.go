@@ -0,0 +1,178 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ModeManaged is the mode of resources that are managed by Terraform. Data sources have the mode "data".
+const ModeManaged = "managed"
+
+// ErrUnknownFormat is returned, if a file is neither a Terraform state nor the JSON output of `terraform show -json`.
+var ErrUnknownFormat = errors.New("unknown file format")
+
+// resource is a resource of a Terraform state or plan, as contained in the JSON output of `terraform show -json`
+type resource struct {
+	Address string `json:"address"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	// Values contains the attributes of the resource. Attributes that are only known after apply are missing in plans.
+	Values values `json:"values"`
+}
+
+// document contains the fields of the supported formats:
+//   - the JSON output of `terraform show -json` for a state, which contains the resources in "values"
+//   - the JSON output of `terraform show -json` for a plan, which contains the resources after apply in
+//     "planned_values"
+//   - a state file (e.g., terraform.tfstate), which contains the resources and their instances in "resources"
+type document struct {
+	FormatVersion string        `json:"format_version"`
+	Values        *moduleValues `json:"values"`
+	PlannedValues *moduleValues `json:"planned_values"`
+
+	Version   int              `json:"version"`
+	Resources []*stateResource `json:"resources"`
+}
+
+type moduleValues struct {
+	RootModule module `json:"root_module"`
+}
+
+type module struct {
+	Resources    []*resource `json:"resources"`
+	ChildModules []module    `json:"child_modules"`
+}
+
+// stateResource is a resource of a state file. Resources with count or for_each have multiple instances.
+type stateResource struct {
+	Module    string           `json:"module"`
+	Mode      string           `json:"mode"`
+	Type      string           `json:"type"`
+	Name      string           `json:"name"`
+	Instances []*stateInstance `json:"instances"`
+}
+
+type stateInstance struct {
+	IndexKey   any    `json:"index_key"`
+	Attributes values `json:"attributes"`
+}
+
+// parseFile returns the managed resources of a Terraform state or plan file
+func parseFile(path string) (resources []*resource, err error) {
+	var doc document
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(b, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse JSON: %w", err)
+	}
+
+	switch {
+	case doc.PlannedValues != nil:
+		resources = doc.PlannedValues.RootModule.allResources()
+	case doc.Values != nil:
+		resources = doc.Values.RootModule.allResources()
+	case doc.FormatVersion != "":
+		// The output of `terraform show -json` for an empty state does not contain any values
+		return nil, nil
+	case doc.Version > 0:
+		resources = doc.stateResources()
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	// Data sources are not part of the infrastructure
+	resources = slices.DeleteFunc(resources, func(r *resource) bool {
+		return r.Mode != ModeManaged
+	})
+
+	return
+}
+
+// allResources returns the resources of the module and all of its child modules
+func (m *module) allResources() (resources []*resource) {
+	resources = append(resources, m.Resources...)
+
+	for _, child := range m.ChildModules {
+		resources = append(resources, child.allResources()...)
+	}
+
+	return
+}
+
+// stateResources returns a resource for each instance of the resources of a state file
+func (doc *document) stateResources() (resources []*resource) {
+	for _, r := range doc.Resources {
+		for _, instance := range r.Instances {
+			resources = append(resources, &resource{
+				Address: r.address(instance.IndexKey),
+				Mode:    r.Mode,
+				Type:    r.Type,
+				Name:    r.Name,
+				Values:  instance.Attributes,
+			})
+		}
+	}
+
+	return
+}
+
+// address returns the address of a resource instance, e.g., module.network.aws_subnet.private["a"], in the same form as
+// `terraform show -json` does
+func (r *stateResource) address(indexKey any) string {
+	var parts []string
+
+	if r.Module != "" {
+		parts = append(parts, r.Module)
+	}
+
+	if r.Mode != ModeManaged {
+		parts = append(parts, r.Mode)
+	}
+
+	parts = append(parts, r.Type, r.Name)
+	address := strings.Join(parts, ".")
+
+	switch key := indexKey.(type) {
+	case float64:
+		address += fmt.Sprintf("[%d]", int(key))
+	case string:
+		address += fmt.Sprintf("[%q]", key)
+	}
+
+	return address
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func Test_parseFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		wantResources assert.Want[[]*resource]
+		wantErr       assert.WantErr
+	}{
+		{
+			name:          "invalid JSON",
+			path:          writeFile(t, "invalid.json", `{`),
+			wantResources: assert.Nil[[]*resource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not parse JSON")
+			},
+		},
+		{
+			name:          "unknown format",
+			path:          writeFile(t, "package.json", `{"name": "clouditor"}`),
+			wantResources: assert.Nil[[]*resource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrUnknownFormat)
+			},
+		},
+		{
+			name:          "Happy path: empty state",
+			path:          writeFile(t, "empty.json", `{"format_version": "1.0"}`),
+			wantResources: assert.Nil[[]*resource],
+			wantErr:       assert.Nil[error],
+		},
+		{
+			name: "Happy path: state with child modules",
+			path: "testdata/aws_state.json",
+			wantResources: func(t *testing.T, got []*resource) bool {
+				// The data source is skipped, the resources of the child module come after the ones of the root module
+				assert.Equal(t, 13, len(got))
+				assert.Equal(t, "aws_instance.web", got[0].Address)
+				assert.Equal(t, "module.network.aws_vpc.main", got[9].Address)
+				return assert.Equal(t, "vpc-0a1b2c3d4e5f60001", got[9].Values.str("id"))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: plan",
+			path: "testdata/azure_plan.json",
+			wantResources: func(t *testing.T, got []*resource) bool {
+				assert.Equal(t, 8, len(got))
+				return assert.Equal(t, "azurerm_resource_group.main", got[0].Address)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: state file",
+			path: "testdata/terraform.tfstate",
+			wantResources: func(t *testing.T, got []*resource) bool {
+				// Each instance of a resource with count or for_each is a resource of its own
+				assert.Equal(t, 5, len(got))
+				assert.Equal(t, "azurerm_disk_encryption_set.cmk", got[0].Address)
+				assert.Equal(t, "module.compute.azurerm_managed_disk.data[0]", got[1].Address)
+				assert.Equal(t, "module.compute.azurerm_managed_disk.data[1]", got[2].Address)
+				assert.Equal(t, "azurerm_managed_disk", got[2].Type)
+				return assert.Equal(t, `module.compute.azurerm_windows_virtual_machine.app["blue"]`, got[3].Address)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResources, err := parseFile(tt.path)
+			tt.wantErr(t, err)
+			tt.wantResources(t, gotResources)
+		})
+	}
+}
+
+func Test_stateResource_address(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        *stateResource
+		indexKey any
+		want     string
+	}{
+		{
+			name: "root module",
+			r:    &stateResource{Mode: ModeManaged, Type: "aws_vpc", Name: "main"},
+			want: "aws_vpc.main",
+		},
+		{
+			name:     "child module with count",
+			r:        &stateResource{Module: "module.network", Mode: ModeManaged, Type: "aws_subnet", Name: "private"},
+			indexKey: float64(1),
+			want:     "module.network.aws_subnet.private[1]",
+		},
+		{
+			name:     "data source with for_each",
+			r:        &stateResource{Mode: "data", Type: "aws_ami", Name: "ubuntu"},
+			indexKey: "jammy",
+			want:     `data.aws_ami.ubuntu["jammy"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.address(tt.indexKey))
+		})
+	}
+}
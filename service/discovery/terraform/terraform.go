@@ -0,0 +1,161 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package terraform contains a discoverer that reads Terraform state files and plans. The resources are mapped onto the
+// same ontology resources that the live AWS and Azure discoverers produce, so that the existing metrics can already be
+// evaluated in a pull request, before the infrastructure is applied.
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log *logrus.Entry
+
+	ErrMissingPaths = errors.New("no Terraform state or plan files configured")
+)
+
+type terraformDiscovery struct {
+	ctID string
+	// paths are the state or plan files to read. A directory stands for all files with the extension .tfstate or .json
+	// in it.
+	paths []string
+}
+
+type DiscoveryOption func(d *terraformDiscovery)
+
+func WithTargetOfEvaluationID(ctID string) DiscoveryOption {
+	return func(d *terraformDiscovery) {
+		d.ctID = ctID
+	}
+}
+
+// WithPaths is an option to set the Terraform state or plan files that are discovered
+func WithPaths(paths []string) DiscoveryOption {
+	return func(d *terraformDiscovery) {
+		d.paths = paths
+	}
+}
+
+func init() {
+	log = logrus.WithField("component", "terraform-discovery")
+}
+
+func NewTerraformDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
+	d := &terraformDiscovery{
+		ctID: config.DefaultTargetOfEvaluationID,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (*terraformDiscovery) Name() string {
+	return "Terraform"
+}
+
+func (*terraformDiscovery) Description() string {
+	return "Discovery of resources in Terraform state and plan files."
+}
+
+func (d *terraformDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// List reads the configured Terraform state and plan files and maps the supported resource types onto the ontology.
+// Resource types that are not supported are skipped.
+func (d *terraformDiscovery) List() (list []ontology.IsResource, err error) {
+	var files []string
+
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if len(d.paths) == 0 {
+		return nil, ErrMissingPaths
+	}
+
+	for _, path := range d.paths {
+		f, err := expandPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read path '%s': %w", path, err)
+		}
+
+		files = append(files, f...)
+	}
+
+	for _, file := range files {
+		resources, err := parseFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse Terraform file '%s': %w", file, err)
+		}
+
+		list = append(list, newConverter(resources).convert()...)
+	}
+
+	return
+}
+
+// expandPath returns the path itself, if it is a file. If it is a directory, the files with the extension .tfstate or
+// .json in it are returned.
+func expandPath(path string) (files []string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".tfstate" && ext != ".json") || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+
+	return
+}
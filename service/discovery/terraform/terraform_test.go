@@ -0,0 +1,220 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+)
+
+func TestNewTerraformDiscovery(t *testing.T) {
+	type args struct {
+		opts []DiscoveryOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[discovery.Discoverer]
+	}{
+		{
+			name: "Happy path: default values",
+			args: args{},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*terraformDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "Terraform", d.Name())
+				assert.Empty(t, d.paths)
+				return assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+			},
+		},
+		{
+			name: "Happy path: with options",
+			args: args{
+				opts: []DiscoveryOption{
+					WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1),
+					WithPaths([]string{"testdata"}),
+				},
+			},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*terraformDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, []string{"testdata"}, d.paths)
+				return assert.Equal(t, testdata.MockTargetOfEvaluationID1, d.TargetOfEvaluationID())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTerraformDiscovery(tt.args.opts...)
+			tt.want(t, got)
+		})
+	}
+}
+
+func Test_terraformDiscovery_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    []string
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name:     "paths not set",
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingPaths)
+			},
+		},
+		{
+			name:     "path does not exist",
+			paths:    []string{"testdata/does-not-exist"},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				assert.ErrorIs(t, err, os.ErrNotExist)
+				return assert.ErrorContains(t, err, "could not read path 'testdata/does-not-exist'")
+			},
+		},
+		{
+			name:     "invalid file",
+			paths:    []string{writeFile(t, "invalid.json", `{"format_version": "1.0"`)},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not parse Terraform file")
+			},
+		},
+		{
+			name:  "Happy path: state",
+			paths: []string{"testdata/aws_state.json"},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// Data sources and unsupported resource types are skipped
+				return assert.Equal(t, []string{
+					"arn:aws:ec2:eu-central-1:123456789012:instance/i-0a1b2c3d4e5f60001",
+					"arn:aws:ec2:eu-central-1:123456789012:volume/vol-0a1b2c3d4e5f60002",
+					"arn:aws:s3:::clouditor-logs",
+					"arn:aws:s3:::clouditor-logs",
+					"arn:aws:rds:eu-central-1:123456789012:db:orders",
+					"arn:aws:rds:eu-central-1:123456789012:db:orders/storage",
+					"arn:aws:lambda:eu-central-1:123456789012:function:export",
+					"arn:aws:ec2:eu-central-1:123456789012:vpc/vpc-0a1b2c3d4e5f60001",
+					"arn:aws:ec2:eu-central-1:123456789012:subnet/subnet-0a1b2c3d4e5f60001",
+					"arn:aws:ec2:eu-central-1:123456789012:security-group/sg-0a1b2c3d4e5f60001",
+				}, ontology.ResourceIDs(got))
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name:  "Happy path: directory",
+			paths: []string{"testdata"},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// The files are read in lexical order
+				ids := ontology.ResourceIDs(got)
+				assert.Equal(t, 19, len(ids))
+				assert.Equal(t, "arn:aws:ec2:eu-central-1:123456789012:instance/i-0a1b2c3d4e5f60001", ids[0])
+				assert.Equal(t, "azurerm_virtual_network.main", ids[10])
+				return assert.Equal(t, "/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/clouditor/providers/microsoft.compute/disks/data-0", ids[16])
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &terraformDiscovery{
+				ctID:  testdata.MockTargetOfEvaluationID1,
+				paths: tt.paths,
+			}
+
+			gotList, err := d.List()
+			tt.wantErr(t, err)
+			tt.wantList(t, gotList)
+		})
+	}
+}
+
+func Test_expandPath(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"terraform.tfstate", "plan.json", "main.tf", ".terraform.tfstate.lock.json"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0600))
+	}
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "modules.json"), 0700))
+
+	tests := []struct {
+		name      string
+		path      string
+		wantFiles assert.Want[[]string]
+		wantErr   assert.WantErr
+	}{
+		{
+			name:      "path does not exist",
+			path:      filepath.Join(dir, "does-not-exist"),
+			wantFiles: assert.Nil[[]string],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, os.ErrNotExist)
+			},
+		},
+		{
+			name: "Happy path: file",
+			path: filepath.Join(dir, "main.tf"),
+			wantFiles: func(t *testing.T, got []string) bool {
+				return assert.Equal(t, []string{filepath.Join(dir, "main.tf")}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+		{
+			name: "Happy path: directory",
+			path: dir,
+			wantFiles: func(t *testing.T, got []string) bool {
+				// Hidden files, directories and files with other extensions are skipped
+				return assert.Equal(t, []string{
+					filepath.Join(dir, "plan.json"),
+					filepath.Join(dir, "terraform.tfstate"),
+				}, got)
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFiles, err := expandPath(tt.path)
+			tt.wantErr(t, err)
+			tt.wantFiles(t, gotFiles)
+		})
+	}
+}
+
+// writeFile writes the content to a file in a temporary directory and returns its path
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
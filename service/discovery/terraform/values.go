@@ -0,0 +1,112 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package terraform
+
+// values contains the attributes of a Terraform resource or of a nested block. Nested blocks are represented as lists
+// of objects.
+type values map[string]any
+
+// str returns the string attribute or an empty string, if it is not set
+func (v values) str(key string) string {
+	s, _ := v[key].(string)
+	return s
+}
+
+// boolean returns the boolean attribute or false, if it is not set
+func (v values) boolean(key string) bool {
+	b, _ := v[key].(bool)
+	return b
+}
+
+// boolOr returns the boolean attribute or the default value, if it is not set. This is needed for attributes that
+// default to true.
+func (v values) boolOr(key string, def bool) bool {
+	b, ok := v[key].(bool)
+	if !ok {
+		return def
+	}
+
+	return b
+}
+
+// number returns the number attribute or 0, if it is not set
+func (v values) number(key string) float64 {
+	n, _ := v[key].(float64)
+	return n
+}
+
+// strs returns the strings of a list or set attribute
+func (v values) strs(key string) (list []string) {
+	items, _ := v[key].([]any)
+
+	for _, item := range items {
+		if s, ok := item.(string); ok && s != "" {
+			list = append(list, s)
+		}
+	}
+
+	return
+}
+
+// blocks returns the nested blocks with the given name
+func (v values) blocks(key string) (list []values) {
+	items, _ := v[key].([]any)
+
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			list = append(list, m)
+		}
+	}
+
+	return
+}
+
+// block returns the first nested block with the given name. An empty block is returned, if it is not set.
+func (v values) block(key string) values {
+	list := v.blocks(key)
+	if len(list) == 0 {
+		return values{}
+	}
+
+	return list[0]
+}
+
+// labels returns the string map attribute, e.g., the tags of a resource
+func (v values) labels(key string) map[string]string {
+	m, ok := v[key].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	l := make(map[string]string)
+	for k, value := range m {
+		if s, ok := value.(string); ok {
+			l[k] = s
+		}
+	}
+
+	return l
+}
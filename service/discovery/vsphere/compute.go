@@ -0,0 +1,248 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	// LabelSecureBootEnabled is the label that specifies whether UEFI secure boot is enabled for a VM.
+	LabelSecureBootEnabled = "vsphere:secure-boot-enabled"
+
+	// LabelVirtualTPM is the label that specifies whether a virtual TPM is attached to a VM. A vTPM allows the guest to
+	// measure its boot process, but it does not mean that the VM is actually attested remotely.
+	LabelVirtualTPM = "vsphere:virtual-tpm"
+
+	// LabelVMEncryptionEnabled is the label that specifies whether the files of a VM (e.g., its configuration and swap
+	// file) are encrypted with vSphere VM encryption. The encryption of the virtual disks is part of their at-rest
+	// encryption.
+	LabelVMEncryptionEnabled = "vsphere:vm-encryption-enabled"
+)
+
+// discoverHosts discovers the ESXi hosts of the datacenter
+func (d *vsphereDiscovery) discoverHosts(dc *datacenter) (list []ontology.IsResource, err error) {
+	var hosts []mo.HostSystem
+
+	err = dc.view.Retrieve(context.Background(), []string{"HostSystem"}, []string{"name", "summary.config"}, &hosts)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve hosts: %w", err)
+	}
+
+	for _, host := range hosts {
+		list = append(list, d.handleHost(dc, &host))
+	}
+
+	return
+}
+
+// handleHost returns a [ontology.ResourceGroup] out of an existing [mo.HostSystem]. The host groups the virtual
+// machines running on it.
+func (d *vsphereDiscovery) handleHost(dc *datacenter, host *mo.HostSystem) ontology.IsResource {
+	r := &ontology.ResourceGroup{
+		Id:          d.resourceID(host.Reference()),
+		Name:        host.Name,
+		Description: host.Summary.Config.Product.FullName,
+		GeoLocation: dc.location(),
+		Raw:         discovery.Raw(host),
+	}
+
+	log.Infof("Adding host '%s'", r.Name)
+
+	return r
+}
+
+// discoverVirtualMachines discovers the virtual machines of the datacenter together with their virtual disks. Templates
+// are skipped, since they cannot be powered on.
+func (d *vsphereDiscovery) discoverVirtualMachines(dc *datacenter) (list []ontology.IsResource, err error) {
+	var vms []mo.VirtualMachine
+
+	err = dc.view.Retrieve(context.Background(), []string{"VirtualMachine"}, []string{"name", "config", "runtime.host"}, &vms)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve virtual machines: %w", err)
+	}
+
+	for _, vm := range vms {
+		// The configuration is not available for VMs that are currently being created or are inaccessible
+		if vm.Config == nil || vm.Config.Template {
+			continue
+		}
+
+		disks := d.handleVirtualDisks(dc, &vm)
+
+		list = append(list, d.handleVirtualMachine(dc, &vm, disks))
+		list = append(list, disks...)
+	}
+
+	return
+}
+
+// handleVirtualMachine returns a [ontology.VirtualMachine] out of an existing [mo.VirtualMachine]
+func (d *vsphereDiscovery) handleVirtualMachine(dc *datacenter, vm *mo.VirtualMachine, disks []ontology.IsResource) ontology.IsResource {
+	var (
+		diskIDs  []string
+		parentID *string
+	)
+
+	for _, disk := range disks {
+		diskIDs = append(diskIDs, disk.GetId())
+	}
+
+	if vm.Runtime.Host != nil {
+		parentID = util.Ref(d.resourceID(*vm.Runtime.Host))
+	}
+
+	r := &ontology.VirtualMachine{
+		Id:              d.resourceID(vm.Reference()),
+		Name:            vm.Name,
+		Description:     vmDescription(vm.Config),
+		CreationTime:    creationTime(vm.Config.CreateDate),
+		GeoLocation:     dc.location(),
+		Labels:          vmLabels(vm.Config),
+		BlockStorageIds: diskIDs,
+		EncryptionInUse: &ontology.EncryptionInUse{
+			Enabled: hasConfidentialComputing(vm.Config),
+		},
+		ParentId: parentID,
+		Raw:      discovery.Raw(vm),
+	}
+
+	log.Infof("Adding virtual machine '%s'", r.Name)
+
+	return r
+}
+
+// handleVirtualDisks returns a [ontology.BlockStorage] for each virtual disk of the VM
+func (d *vsphereDiscovery) handleVirtualDisks(dc *datacenter, vm *mo.VirtualMachine) (list []ontology.IsResource) {
+	for _, device := range vm.Config.Hardware.Device {
+		disk, ok := device.(*types.VirtualDisk)
+		if !ok {
+			continue
+		}
+
+		var (
+			keyID    *types.CryptoKeyId
+			parentID *string
+		)
+
+		if backing, ok := disk.Backing.(types.BaseVirtualDeviceFileBackingInfo); ok {
+			if ds := backing.GetVirtualDeviceFileBackingInfo().Datastore; ds != nil {
+				parentID = util.Ref(d.resourceID(*ds))
+			}
+		}
+
+		switch backing := disk.Backing.(type) {
+		case *types.VirtualDiskFlatVer2BackingInfo:
+			keyID = backing.KeyId
+		case *types.VirtualDiskSeSparseBackingInfo:
+			keyID = backing.KeyId
+		case *types.VirtualDiskSparseVer2BackingInfo:
+			keyID = backing.KeyId
+		}
+
+		r := &ontology.BlockStorage{
+			Id:               fmt.Sprintf("%s/disks/%d", d.resourceID(vm.Reference()), disk.Key),
+			Name:             fmt.Sprintf("%s/%s", vm.Name, deviceLabel(&disk.VirtualDevice)),
+			CreationTime:     creationTime(vm.Config.CreateDate),
+			GeoLocation:      dc.location(),
+			AtRestEncryption: atRestEncryption(keyID),
+			ParentId:         parentID,
+			Raw:              discovery.Raw(disk),
+		}
+
+		log.Infof("Adding block storage '%s'", r.Name)
+
+		list = append(list, r)
+	}
+
+	return
+}
+
+// vmDescription returns a description of the VM containing its annotation and guest OS
+func vmDescription(config *types.VirtualMachineConfigInfo) string {
+	var parts []string
+
+	if config.Annotation != "" {
+		parts = append(parts, config.Annotation)
+	}
+
+	if config.GuestFullName != "" {
+		parts = append(parts, fmt.Sprintf("Guest OS: %s", config.GuestFullName))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// vmLabels returns the labels of the VM containing the security features, which cannot be expressed in the ontology
+// directly
+func vmLabels(config *types.VirtualMachineConfigInfo) map[string]string {
+	return map[string]string{
+		LabelSecureBootEnabled:   strconv.FormatBool(hasSecureBoot(config)),
+		LabelVirtualTPM:          strconv.FormatBool(hasVirtualTPM(config)),
+		LabelVMEncryptionEnabled: strconv.FormatBool(config.KeyId != nil),
+	}
+}
+
+// hasSecureBoot returns true, if UEFI secure boot is enabled for the VM
+func hasSecureBoot(config *types.VirtualMachineConfigInfo) bool {
+	return config.BootOptions != nil && util.Deref(config.BootOptions.EfiSecureBootEnabled)
+}
+
+// hasVirtualTPM returns true, if a virtual TPM is attached to the VM
+func hasVirtualTPM(config *types.VirtualMachineConfigInfo) bool {
+	for _, device := range config.Hardware.Device {
+		if _, ok := device.(*types.VirtualTPM); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasConfidentialComputing returns true, if the memory of the VM is encrypted while in use by AMD SEV(-SNP) or Intel
+// TDX
+func hasConfidentialComputing(config *types.VirtualMachineConfigInfo) bool {
+	return util.Deref(config.SevEnabled) || util.Deref(config.SevSnpEnabled) || util.Deref(config.TdxEnabled)
+}
+
+// deviceLabel returns the label of a virtual device (e.g., "Hard disk 1") or its key, if no label is set
+func deviceLabel(device *types.VirtualDevice) string {
+	if info := device.DeviceInfo; info != nil && info.GetDescription().Label != "" {
+		return info.GetDescription().Label
+	}
+
+	return fmt.Sprintf("%d", device.Key)
+}
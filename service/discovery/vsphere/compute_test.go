@@ -0,0 +1,234 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/vspheretest"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func Test_vsphereDiscovery_discoverHosts(t *testing.T) {
+	srv := vspheretest.NewServer(t)
+	d, dc := newTestDiscovery(t, srv)
+
+	got, err := d.discoverHosts(dc)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(got))
+
+	host, ok := got[0].(*ontology.ResourceGroup)
+	assert.True(t, ok)
+	assert.NotEmpty(t, host.Raw)
+	assert.Equal(t, "DC0_H0", host.GetName())
+	assert.Equal(t, srv.URL().Host+"/HostSystem/host-21", host.GetId())
+	assert.Equal(t, "VMware ESXi 8.0.2 build-21997540", host.GetDescription())
+}
+
+func Test_vsphereDiscovery_discoverVirtualMachines(t *testing.T) {
+	srv := vspheretest.NewServer(t)
+	srv.HardenVirtualMachine(t)
+	d, dc := newTestDiscovery(t, srv)
+
+	got, err := d.discoverVirtualMachines(dc)
+	assert.NoError(t, err)
+	// 4 VMs with one disk each
+	assert.Equal(t, 8, len(got))
+
+	vm, ok := got[0].(*ontology.VirtualMachine)
+	assert.True(t, ok)
+	assert.Equal(t, vspheretest.HardenedVM, vm.GetName())
+	assert.Equal(t, "Guest OS: otherGuest", vm.GetDescription())
+	assert.Equal(t, map[string]string{
+		LabelSecureBootEnabled:   "true",
+		LabelVirtualTPM:          "true",
+		LabelVMEncryptionEnabled: "true",
+	}, vm.GetLabels())
+	// A vTPM does not mean that the VM is attested remotely
+	assert.Nil(t, vm.GetRemoteAttestation())
+	assert.False(t, vm.GetEncryptionInUse().GetEnabled())
+	assert.NotNil(t, vm.GetCreationTime())
+
+	disk, ok := got[1].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.Equal(t, vm.GetId()+"/disks/204", disk.GetId())
+	assert.Equal(t, vspheretest.HardenedVM+"/disk-202-0", disk.GetName())
+
+	vm, ok = got[2].(*ontology.VirtualMachine)
+	assert.True(t, ok)
+	assert.Equal(t, "false", vm.GetLabels()[LabelSecureBootEnabled])
+	assert.Equal(t, "false", vm.GetLabels()[LabelVirtualTPM])
+	assert.Equal(t, "false", vm.GetLabels()[LabelVMEncryptionEnabled])
+}
+
+func Test_vsphereDiscovery_handleVirtualDisks(t *testing.T) {
+	datastore := types.ManagedObjectReference{Type: "Datastore", Value: "datastore-1"}
+	keyID := &types.CryptoKeyId{
+		KeyId:      vspheretest.MockKeyID,
+		ProviderId: &types.KeyProviderId{Id: vspheretest.MockKeyProvider},
+	}
+
+	d := NewVSphereDiscovery(WithAuthorizer(vspheretest.NewServer(t).URL())).(*vsphereDiscovery)
+	dc := &datacenter{name: "DC0"}
+
+	vm := &mo.VirtualMachine{
+		ManagedEntity: mo.ManagedEntity{
+			ExtensibleManagedObject: mo.ExtensibleManagedObject{
+				Self: types.ManagedObjectReference{Type: "VirtualMachine", Value: "vm-1"},
+			},
+			Name: "vm",
+		},
+		Config: &types.VirtualMachineConfigInfo{
+			Hardware: types.VirtualHardware{
+				Device: []types.BaseVirtualDevice{
+					&types.VirtualTPM{},
+					&types.VirtualDisk{
+						VirtualDevice: types.VirtualDevice{
+							Key:        2000,
+							DeviceInfo: &types.Description{Label: "Hard disk 1"},
+							Backing: &types.VirtualDiskFlatVer2BackingInfo{
+								VirtualDeviceFileBackingInfo: types.VirtualDeviceFileBackingInfo{
+									FileName:  "[datastore1] vm/vm.vmdk",
+									Datastore: &datastore,
+								},
+								KeyId: keyID,
+							},
+						},
+					},
+					&types.VirtualDisk{
+						VirtualDevice: types.VirtualDevice{
+							Key:     2001,
+							Backing: &types.VirtualDiskRawDiskMappingVer1BackingInfo{},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := d.handleVirtualDisks(dc, vm)
+	assert.Equal(t, 2, len(got))
+
+	disk, ok := got[0].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.Equal(t, d.url.Host+"/VirtualMachine/vm-1/disks/2000", disk.GetId())
+	assert.Equal(t, "vm/Hard disk 1", disk.GetName())
+	assert.Equal(t, d.url.Host+"/Datastore/datastore-1", disk.GetParentId())
+	assert.Equal(t, &ontology.CustomerKeyEncryption{
+		Algorithm: constants.AES256,
+		Enabled:   true,
+		KeyUrl:    vspheretest.MockKeyProvider + "/" + vspheretest.MockKeyID,
+	}, disk.GetAtRestEncryption().GetCustomerKeyEncryption())
+
+	disk, ok = got[1].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.Equal(t, "vm/2001", disk.GetName())
+	assert.Nil(t, disk.ParentId)
+	assert.False(t, disk.GetAtRestEncryption().GetManagedKeyEncryption().GetEnabled())
+}
+
+func Test_hasConfidentialComputing(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *types.VirtualMachineConfigInfo
+		want   bool
+	}{
+		{
+			name:   "not enabled",
+			config: &types.VirtualMachineConfigInfo{},
+			want:   false,
+		},
+		{
+			name:   "SEV",
+			config: &types.VirtualMachineConfigInfo{SevEnabled: types.NewBool(true)},
+			want:   true,
+		},
+		{
+			name:   "SEV-SNP",
+			config: &types.VirtualMachineConfigInfo{SevSnpEnabled: types.NewBool(true)},
+			want:   true,
+		},
+		{
+			name:   "TDX",
+			config: &types.VirtualMachineConfigInfo{TdxEnabled: types.NewBool(true)},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hasConfidentialComputing(tt.config)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_vmDescription(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *types.VirtualMachineConfigInfo
+		want   string
+	}{
+		{
+			name:   "nothing configured",
+			config: &types.VirtualMachineConfigInfo{},
+			want:   "",
+		},
+		{
+			name: "Happy path",
+			config: &types.VirtualMachineConfigInfo{
+				Annotation:    "web server",
+				GuestFullName: "Ubuntu Linux (64-bit)",
+			},
+			want: "web server; Guest OS: Ubuntu Linux (64-bit)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := vmDescription(tt.config)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_vmLabels(t *testing.T) {
+	got := vmLabels(&types.VirtualMachineConfigInfo{
+		BootOptions: &types.VirtualMachineBootOptions{
+			EfiSecureBootEnabled: types.NewBool(true),
+		},
+		KeyId: &types.CryptoKeyId{KeyId: vspheretest.MockKeyID},
+	})
+
+	assert.Equal(t, map[string]string{
+		LabelSecureBootEnabled:   "true",
+		LabelVirtualTPM:          "false",
+		LabelVMEncryptionEnabled: "true",
+	}, got)
+}
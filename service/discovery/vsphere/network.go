@@ -0,0 +1,104 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/util"
+
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+// discoverPortGroups discovers the standard port groups and the distributed port groups of the datacenter. Uplink port
+// groups of distributed switches are skipped, since VMs cannot be connected to them.
+func (d *vsphereDiscovery) discoverPortGroups(dc *datacenter) (list []ontology.IsResource, err error) {
+	var (
+		networks []mo.Network
+		dvpgs    []mo.DistributedVirtualPortgroup
+	)
+
+	err = dc.view.Retrieve(context.Background(), []string{"Network"}, []string{"name"}, &networks)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve networks: %w", err)
+	}
+
+	for _, network := range networks {
+		// The view also contains the distributed port groups, which are a subtype of network
+		if network.Reference().Type != "Network" {
+			continue
+		}
+
+		list = append(list, d.handleNetwork(dc, &network))
+	}
+
+	err = dc.view.Retrieve(context.Background(), []string{"DistributedVirtualPortgroup"}, []string{"name", "config"}, &dvpgs)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve distributed port groups: %w", err)
+	}
+
+	for _, dvpg := range dvpgs {
+		if util.Deref(dvpg.Config.Uplink) {
+			continue
+		}
+
+		list = append(list, d.handleDistributedPortGroup(dc, &dvpg))
+	}
+
+	return
+}
+
+// handleNetwork returns a [ontology.VirtualNetwork] out of an existing standard port group
+func (d *vsphereDiscovery) handleNetwork(dc *datacenter, network *mo.Network) ontology.IsResource {
+	r := &ontology.VirtualNetwork{
+		Id:          d.resourceID(network.Reference()),
+		Name:        network.Name,
+		GeoLocation: dc.location(),
+		Raw:         discovery.Raw(network),
+	}
+
+	log.Infof("Adding virtual network '%s'", r.Name)
+
+	return r
+}
+
+// handleDistributedPortGroup returns a [ontology.VirtualNetwork] out of an existing [mo.DistributedVirtualPortgroup]
+func (d *vsphereDiscovery) handleDistributedPortGroup(dc *datacenter, dvpg *mo.DistributedVirtualPortgroup) ontology.IsResource {
+	r := &ontology.VirtualNetwork{
+		Id:          d.resourceID(dvpg.Reference()),
+		Name:        dvpg.Name,
+		Description: dvpg.Config.Description,
+		GeoLocation: dc.location(),
+		Raw:         discovery.Raw(dvpg),
+	}
+
+	log.Infof("Adding virtual network '%s'", r.Name)
+
+	return r
+}
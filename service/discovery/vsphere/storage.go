@@ -0,0 +1,69 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+// discoverDatastores discovers the datastores of the datacenter
+func (d *vsphereDiscovery) discoverDatastores(dc *datacenter) (list []ontology.IsResource, err error) {
+	var datastores []mo.Datastore
+
+	err = dc.view.Retrieve(context.Background(), []string{"Datastore"}, []string{"name", "summary"}, &datastores)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve datastores: %w", err)
+	}
+
+	for _, ds := range datastores {
+		list = append(list, d.handleDatastore(dc, &ds))
+	}
+
+	return
+}
+
+// handleDatastore returns a [ontology.BlockStorage] out of an existing [mo.Datastore]. The encryption of a datastore
+// depends on its backing (e.g., vSAN data-at-rest encryption or an encrypting storage array) and cannot be determined
+// from the datastore itself. The encryption of the virtual disks stored on it is reported on the disks instead.
+func (d *vsphereDiscovery) handleDatastore(dc *datacenter, ds *mo.Datastore) ontology.IsResource {
+	r := &ontology.BlockStorage{
+		Id:          d.resourceID(ds.Reference()),
+		Name:        ds.Name,
+		Description: fmt.Sprintf("%s datastore", ds.Summary.Type),
+		GeoLocation: dc.location(),
+		Raw:         discovery.Raw(ds),
+	}
+
+	log.Infof("Adding block storage '%s'", r.Name)
+
+	return r
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/vspheretest"
+)
+
+func Test_vsphereDiscovery_discoverDatastores(t *testing.T) {
+	srv := vspheretest.NewServer(t)
+	d, dc := newTestDiscovery(t, srv)
+
+	got, err := d.discoverDatastores(dc)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	ds, ok := got[0].(*ontology.BlockStorage)
+	assert.True(t, ok)
+	assert.NotEmpty(t, ds.Raw)
+	ds.Raw = ""
+
+	assert.Equal(t, &ontology.BlockStorage{
+		Id:          srv.URL().Host + "/Datastore/datastore-59",
+		Name:        "LocalDS_0",
+		Description: "OTHER datastore",
+		GeoLocation: &ontology.GeoLocation{Region: "DC0"},
+	}, ds)
+}
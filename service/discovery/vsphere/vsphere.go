@@ -0,0 +1,313 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+// Package vsphere contains a discoverer for VMware vSphere environments, i.e., a vCenter or a single ESXi host.
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+
+	"github.com/sirupsen/logrus"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/session/keepalive"
+	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	EnvURL      = "VSPHERE_URL"
+	EnvUsername = "VSPHERE_USERNAME"
+	EnvPassword = "VSPHERE_PASSWORD"
+	EnvInsecure = "VSPHERE_INSECURE"
+)
+
+// keepAliveInterval is the interval in which the session is kept alive between two discoveries. vCenter terminates idle
+// sessions after 30 minutes by default.
+const keepAliveInterval = 10 * time.Minute
+
+var (
+	log *logrus.Entry
+
+	ErrMissingURL = errors.New("the vSphere URL is not set")
+)
+
+type vsphereDiscovery struct {
+	ctID string
+	// url is the URL of the vSphere API (e.g., https://vcenter.example.com/sdk) including the credentials
+	url *url.URL
+	// insecure skips the verification of the TLS certificate of the vSphere API
+	insecure bool
+	client   *govmomi.Client
+}
+
+// datacenter contains the information of a datacenter, which is needed while discovering the resources of it
+type datacenter struct {
+	ref  types.ManagedObjectReference
+	name string
+	// view contains all managed objects of the datacenter
+	view *view.ContainerView
+}
+
+type DiscoveryOption func(d *vsphereDiscovery)
+
+func WithTargetOfEvaluationID(ctID string) DiscoveryOption {
+	return func(d *vsphereDiscovery) {
+		d.ctID = ctID
+	}
+}
+
+// WithAuthorizer is an option to set the URL of the vSphere API including the credentials
+func WithAuthorizer(u *url.URL) DiscoveryOption {
+	return func(d *vsphereDiscovery) {
+		d.url = u
+	}
+}
+
+// WithInsecure is an option to skip the verification of the TLS certificate of the vSphere API
+func WithInsecure(insecure bool) DiscoveryOption {
+	return func(d *vsphereDiscovery) {
+		d.insecure = insecure
+	}
+}
+
+func init() {
+	log = logrus.WithField("component", "vsphere-discovery")
+}
+
+func NewVSphereDiscovery(opts ...DiscoveryOption) discovery.Discoverer {
+	d := &vsphereDiscovery{
+		ctID: config.DefaultTargetOfEvaluationID,
+	}
+
+	// Apply options
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (*vsphereDiscovery) Name() string {
+	return "vSphere"
+}
+
+func (*vsphereDiscovery) Description() string {
+	return "Discovery vSphere."
+}
+
+func (d *vsphereDiscovery) TargetOfEvaluationID() string {
+	return d.ctID
+}
+
+// NewAuthorizer returns the URL of the vSphere API including the credentials from the environment variables
+// VSPHERE_URL, VSPHERE_USERNAME and VSPHERE_PASSWORD. Additionally, it returns whether the TLS certificate should not
+// be verified, which is configured by the environment variable VSPHERE_INSECURE.
+func NewAuthorizer() (u *url.URL, insecure bool, err error) {
+	if os.Getenv(EnvURL) == "" {
+		return nil, false, ErrMissingURL
+	}
+
+	u, err = url.Parse(os.Getenv(EnvURL))
+	if err != nil {
+		return nil, false, fmt.Errorf("could not parse vSphere URL: %w", err)
+	}
+
+	if username := os.Getenv(EnvUsername); username != "" {
+		u.User = url.UserPassword(username, os.Getenv(EnvPassword))
+	}
+
+	insecure, _ = strconv.ParseBool(os.Getenv(EnvInsecure))
+
+	return u, insecure, nil
+}
+
+// authorize logs in to the vSphere API. The session is kept alive between two discoveries. If the session is no longer
+// valid nevertheless (e.g., because the vCenter was restarted), the client logs in again.
+func (d *vsphereDiscovery) authorize() (err error) {
+	var ctx = context.Background()
+
+	if d.url == nil {
+		return ErrMissingURL
+	}
+
+	if d.client == nil {
+		sc := soap.NewClient(d.url, d.insecure)
+
+		vc, err := vim25.NewClient(ctx, sc)
+		if err != nil {
+			return fmt.Errorf("could not connect: %w", err)
+		}
+
+		// The keep alive handler is started on login and stopped on logout
+		vc.RoundTripper = keepalive.NewHandlerSOAP(sc, keepAliveInterval, nil)
+
+		d.client = &govmomi.Client{
+			Client:         vc,
+			SessionManager: session.NewManager(vc),
+		}
+	} else if s, err := d.client.SessionManager.UserSession(ctx); err == nil && s != nil {
+		return nil
+	}
+
+	// Only log in if the URL contains user information
+	if d.url.User == nil {
+		return nil
+	}
+
+	if err = d.client.Login(ctx, d.url.User); err != nil {
+		return fmt.Errorf("could not log in: %w", err)
+	}
+
+	return nil
+}
+
+// Close logs out of the vSphere API, which also stops keeping the session alive
+func (d *vsphereDiscovery) Close() error {
+	if d.client == nil {
+		return nil
+	}
+
+	return d.client.Logout(context.Background())
+}
+
+// List discovers the following vSphere resource types of each datacenter and translates them into the Clouditor
+// ontology:
+// * Hosts
+// * Virtual machines (and their virtual disks)
+// * Datastores
+// * Port groups (standard and distributed)
+func (d *vsphereDiscovery) List() (list []ontology.IsResource, err error) {
+	var (
+		datacenters []*datacenter
+		r           []ontology.IsResource
+	)
+
+	log.Infof("Collecting evidences in %s", d.Name())
+
+	if err = d.authorize(); err != nil {
+		return nil, fmt.Errorf("could not authorize vsphere: %w", err)
+	}
+
+	datacenters, err = d.discoverDatacenters()
+	if err != nil {
+		return nil, fmt.Errorf("could not discover datacenters: %w", err)
+	}
+	defer destroyViews(datacenters)
+
+	for _, dc := range datacenters {
+		// Discover hosts
+		r, err = d.discoverHosts(dc)
+		if err != nil {
+			return nil, fmt.Errorf("could not discover hosts of datacenter '%s': %w", dc.name, err)
+		}
+		list = append(list, r...)
+
+		// Discover virtual machines
+		r, err = d.discoverVirtualMachines(dc)
+		if err != nil {
+			return nil, fmt.Errorf("could not discover virtual machines of datacenter '%s': %w", dc.name, err)
+		}
+		list = append(list, r...)
+
+		// Discover datastores
+		r, err = d.discoverDatastores(dc)
+		if err != nil {
+			return nil, fmt.Errorf("could not discover datastores of datacenter '%s': %w", dc.name, err)
+		}
+		list = append(list, r...)
+
+		// Discover port groups
+		r, err = d.discoverPortGroups(dc)
+		if err != nil {
+			return nil, fmt.Errorf("could not discover port groups of datacenter '%s': %w", dc.name, err)
+		}
+		list = append(list, r...)
+	}
+
+	return
+}
+
+// discoverDatacenters returns all datacenters together with a view of their managed objects
+func (d *vsphereDiscovery) discoverDatacenters() (datacenters []*datacenter, err error) {
+	var ctx = context.Background()
+
+	dcs, err := find.NewFinder(d.client.Client).DatacenterList(ctx, "*")
+	if err != nil {
+		return nil, fmt.Errorf("could not list datacenters: %w", err)
+	}
+
+	for _, dc := range dcs {
+		v, err := view.NewManager(d.client.Client).CreateContainerView(ctx, dc.Reference(), nil, true)
+		if err != nil {
+			destroyViews(datacenters)
+			return nil, fmt.Errorf("could not create view of datacenter '%s': %w", dc.Name(), err)
+		}
+
+		datacenters = append(datacenters, &datacenter{
+			ref:  dc.Reference(),
+			name: dc.Name(),
+			view: v,
+		})
+	}
+
+	return
+}
+
+// destroyViews destroys the views of the datacenters, since they are kept by the vSphere API until the session ends
+func destroyViews(datacenters []*datacenter) {
+	for _, dc := range datacenters {
+		if err := dc.view.Destroy(context.Background()); err != nil {
+			log.Warnf("Could not destroy view of datacenter '%s': %v", dc.name, err)
+		}
+	}
+}
+
+// resourceID returns the ID of a managed object. The ID of a managed object is only unique within a vCenter, therefore
+// the host of the vCenter is prepended.
+func (d *vsphereDiscovery) resourceID(ref types.ManagedObjectReference) string {
+	return fmt.Sprintf("%s/%s/%s", d.url.Host, ref.Type, ref.Value)
+}
+
+// location returns the geo location of the resources of a datacenter. Since vSphere is typically deployed on-premises,
+// the name of the datacenter is used as region.
+func (dc *datacenter) location() *ontology.GeoLocation {
+	return &ontology.GeoLocation{
+		Region: dc.name,
+	}
+}
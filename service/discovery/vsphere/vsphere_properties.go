@@ -0,0 +1,79 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"fmt"
+	"time"
+
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/constants"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// creationTime returns the creation time as timestamp or nil, if it is not set
+func creationTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// atRestEncryption returns the at-rest encryption of a virtual disk. vSphere VM encryption uses AES-256 with a data
+// encryption key, which is wrapped by a key of the configured key provider (e.g., a KMS).
+func atRestEncryption(keyID *types.CryptoKeyId) *ontology.AtRestEncryption {
+	if keyID == nil {
+		return &ontology.AtRestEncryption{
+			Type: &ontology.AtRestEncryption_ManagedKeyEncryption{
+				ManagedKeyEncryption: &ontology.ManagedKeyEncryption{
+					Enabled: false,
+				},
+			},
+		}
+	}
+
+	return &ontology.AtRestEncryption{
+		Type: &ontology.AtRestEncryption_CustomerKeyEncryption{
+			CustomerKeyEncryption: &ontology.CustomerKeyEncryption{
+				Algorithm: constants.AES256,
+				Enabled:   true,
+				KeyUrl:    keyURL(keyID),
+			},
+		},
+	}
+}
+
+// keyURL returns the URL of an encryption key in the form <provider>/<key>
+func keyURL(keyID *types.CryptoKeyId) string {
+	if keyID.ProviderId == nil {
+		return keyID.KeyId
+	}
+
+	return fmt.Sprintf("%s/%s", keyID.ProviderId.Id, keyID.KeyId)
+}
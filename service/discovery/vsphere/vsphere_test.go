@@ -0,0 +1,285 @@
+// Copyright 2025 Fraunhofer AISEC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//           $$\                           $$\ $$\   $$\
+//           $$ |                          $$ |\__|  $$ |
+//  $$$$$$$\ $$ | $$$$$$\  $$\   $$\  $$$$$$$ |$$\ $$$$$$\    $$$$$$\   $$$$$$\
+// $$  _____|$$ |$$  __$$\ $$ |  $$ |$$  __$$ |$$ |\_$$  _|  $$  __$$\ $$  __$$\
+// $$ /      $$ |$$ /  $$ |$$ |  $$ |$$ /  $$ |$$ |  $$ |    $$ /  $$ |$$ | \__|
+// $$ |      $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$ |  $$ |$$\ $$ |  $$ |$$ |
+// \$$$$$$\  $$ |\$$$$$   |\$$$$$   |\$$$$$$  |$$ |  \$$$   |\$$$$$   |$$ |
+//  \_______|\__| \______/  \______/  \_______|\__|   \____/  \______/ \__|
+//
+// This file is part of Clouditor Community Edition.
+
+package vsphere
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"clouditor.io/clouditor/v2/api/discovery"
+	"clouditor.io/clouditor/v2/api/ontology"
+	"clouditor.io/clouditor/v2/internal/config"
+	"clouditor.io/clouditor/v2/internal/testdata"
+	"clouditor.io/clouditor/v2/internal/testutil/assert"
+	"clouditor.io/clouditor/v2/internal/testutil/servicetest/discoverytest/vspheretest"
+)
+
+func TestNewVSphereDiscovery(t *testing.T) {
+	type args struct {
+		opts []DiscoveryOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want assert.Want[discovery.Discoverer]
+	}{
+		{
+			name: "Happy path: default values",
+			args: args{},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*vsphereDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "vSphere", d.Name())
+				assert.Nil(t, d.url)
+				assert.False(t, d.insecure)
+				return assert.Equal(t, config.DefaultTargetOfEvaluationID, d.TargetOfEvaluationID())
+			},
+		},
+		{
+			name: "Happy path: with options",
+			args: args{
+				opts: []DiscoveryOption{
+					WithTargetOfEvaluationID(testdata.MockTargetOfEvaluationID1),
+					WithAuthorizer(&url.URL{Scheme: "https", Host: "vcenter.example.com", Path: "/sdk"}),
+					WithInsecure(true),
+				},
+			},
+			want: func(t *testing.T, got discovery.Discoverer) bool {
+				d, ok := got.(*vsphereDiscovery)
+				assert.True(t, ok)
+				assert.Equal(t, "vcenter.example.com", d.url.Host)
+				assert.True(t, d.insecure)
+				return assert.Equal(t, testdata.MockTargetOfEvaluationID1, d.TargetOfEvaluationID())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewVSphereDiscovery(tt.args.opts...)
+			tt.want(t, got)
+		})
+	}
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          map[string]string
+		wantURL      assert.Want[*url.URL]
+		wantInsecure bool
+		wantErr      assert.WantErr
+	}{
+		{
+			name:    "URL not set",
+			env:     map[string]string{},
+			wantURL: assert.Nil[*url.URL],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingURL)
+			},
+		},
+		{
+			name: "URL invalid",
+			env: map[string]string{
+				EnvURL: "https://vcenter example.com/sdk",
+			},
+			wantURL: assert.Nil[*url.URL],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not parse vSphere URL")
+			},
+		},
+		{
+			name: "Happy path",
+			env: map[string]string{
+				EnvURL:      "https://vcenter.example.com/sdk",
+				EnvUsername: "administrator@vsphere.local",
+				EnvPassword: "secret",
+				EnvInsecure: "true",
+			},
+			wantURL: func(t *testing.T, got *url.URL) bool {
+				password, _ := got.User.Password()
+				assert.Equal(t, "vcenter.example.com", got.Host)
+				assert.Equal(t, "administrator@vsphere.local", got.User.Username())
+				return assert.Equal(t, "secret", password)
+			},
+			wantInsecure: true,
+			wantErr:      assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{EnvURL, EnvUsername, EnvPassword, EnvInsecure} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			gotURL, gotInsecure, err := NewAuthorizer()
+
+			tt.wantURL(t, gotURL)
+			assert.Equal(t, tt.wantInsecure, gotInsecure)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_vsphereDiscovery_authorize(t *testing.T) {
+	srv := vspheretest.NewServer(t)
+
+	invalidCredentials := srv.URL()
+	invalidCredentials.User = url.UserPassword("user", "")
+
+	tests := []struct {
+		name    string
+		url     *url.URL
+		wantErr assert.WantErr
+	}{
+		{
+			name: "URL not set",
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorIs(t, err, ErrMissingURL)
+			},
+		},
+		{
+			name: "invalid credentials",
+			url:  invalidCredentials,
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not log in")
+			},
+		},
+		{
+			name:    "Happy path",
+			url:     srv.URL(),
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewVSphereDiscovery(WithAuthorizer(tt.url), WithInsecure(true)).(*vsphereDiscovery)
+
+			err := d.authorize()
+
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func Test_vsphereDiscovery_Close(t *testing.T) {
+	srv := vspheretest.NewServer(t)
+	d := NewVSphereDiscovery(WithAuthorizer(srv.URL()), WithInsecure(true)).(*vsphereDiscovery)
+
+	// Closing a discoverer that never logged in is a no-op
+	assert.NoError(t, d.Close())
+
+	assert.NoError(t, d.authorize())
+	assert.NoError(t, d.Close())
+
+	s, err := d.client.SessionManager.UserSession(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+
+	// The client logs in again, if the session is no longer valid
+	assert.NoError(t, d.authorize())
+
+	s, err = d.client.SessionManager.UserSession(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+}
+
+func Test_vsphereDiscovery_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      func(srv *vspheretest.Server) *url.URL
+		wantList assert.Want[[]ontology.IsResource]
+		wantErr  assert.WantErr
+	}{
+		{
+			name: "error authorize",
+			url: func(srv *vspheretest.Server) *url.URL {
+				return nil
+			},
+			wantList: assert.Nil[[]ontology.IsResource],
+			wantErr: func(t *testing.T, err error) bool {
+				return assert.ErrorContains(t, err, "could not authorize vsphere")
+			},
+		},
+		{
+			name: "Happy path",
+			url: func(srv *vspheretest.Server) *url.URL {
+				return srv.URL()
+			},
+			wantList: func(t *testing.T, got []ontology.IsResource) bool {
+				// 4 hosts, 4 VMs with one disk each, 1 datastore, 1 standard port group and 2 distributed port groups
+				// (vcsim does not flag the uplink port group as such)
+				assert.Equal(t, 16, len(got))
+
+				host, ok := got[0].(*ontology.ResourceGroup)
+				assert.True(t, ok)
+				assert.Equal(t, "DC0_H0", host.GetName())
+				assert.Equal(t, "DC0", host.GetGeoLocation().GetRegion())
+
+				vm, ok := got[4].(*ontology.VirtualMachine)
+				assert.True(t, ok)
+				assert.Equal(t, vspheretest.HardenedVM, vm.GetName())
+				assert.Equal(t, host.GetId(), vm.GetParentId())
+				assert.Equal(t, "true", vm.GetLabels()[LabelVirtualTPM])
+				assert.Equal(t, []string{got[5].GetId()}, vm.GetBlockStorageIds())
+
+				datastore, ok := got[12].(*ontology.BlockStorage)
+				assert.True(t, ok)
+				assert.Equal(t, datastore.GetId(), got[5].(*ontology.BlockStorage).GetParentId())
+
+				network, ok := got[13].(*ontology.VirtualNetwork)
+				assert.True(t, ok)
+				return assert.Equal(t, "VM Network", network.GetName())
+			},
+			wantErr: assert.Nil[error],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := vspheretest.NewServer(t)
+			srv.HardenVirtualMachine(t)
+
+			d := NewVSphereDiscovery(WithAuthorizer(tt.url(srv)), WithInsecure(true))
+
+			gotList, err := d.List()
+
+			tt.wantList(t, gotList)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+// newTestDiscovery returns a discoverer, which is logged in to the simulated vCenter, and its first datacenter
+func newTestDiscovery(t *testing.T, srv *vspheretest.Server) (*vsphereDiscovery, *datacenter) {
+	d := NewVSphereDiscovery(WithAuthorizer(srv.URL()), WithInsecure(true)).(*vsphereDiscovery)
+	assert.NoError(t, d.authorize())
+
+	datacenters, err := d.discoverDatacenters()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(datacenters))
+
+	return d, datacenters[0]
+}
@@ -802,55 +802,9 @@ func getMetricIds(metrics []*assessment.Metric) []string {
 	return metricIds
 }
 
-// getAllMetricsFromControl returns all metrics from a given controlId.
-//
-// For now a control has either sub-controls or metrics. If the control has sub-controls, get also all metrics from the
-// sub-controls.
+// getAllMetricsFromControl returns all metrics from a given controlId, using the cached catalog controls.
 func (svc *Service) getAllMetricsFromControl(catalogId, categoryName, controlId string) (metrics []*assessment.Metric, err error) {
-	var subControlMetrics []*assessment.Metric
-
-	control, err := svc.getControl(catalogId, categoryName, controlId)
-	if err != nil {
-		err = fmt.Errorf("could not get control for control id {%s}: %w", controlId, err)
-		return
-	}
-
-	// Add metric of control to the metrics list
-	metrics = append(metrics, control.Metrics...)
-
-	// Add sub-control metrics to the metric list if exist
-	if len(control.Controls) != 0 {
-		// Get the metrics from the next sub-control
-		subControlMetrics, err = svc.getMetricsFromSubcontrols(control)
-		if err != nil {
-			err = fmt.Errorf("error getting metrics from sub-controls: %w", err)
-			return
-		}
-
-		metrics = append(metrics, subControlMetrics...)
-	}
-
-	return
-}
-
-// getMetricsFromSubcontrols returns a list of metrics from the sub-controls.
-func (svc *Service) getMetricsFromSubcontrols(control *orchestrator.Control) (metrics []*assessment.Metric, err error) {
-	var subcontrol *orchestrator.Control
-
-	if control == nil {
-		return nil, errors.New("control is missing")
-	}
-
-	for _, control := range control.Controls {
-		subcontrol, err = svc.getControl(control.CategoryCatalogId, control.CategoryName, control.Id)
-		if err != nil {
-			return
-		}
-
-		metrics = append(metrics, subcontrol.Metrics...)
-	}
-
-	return
+	return orchestrator.AllMetricsFromControl(svc.getControl, catalogId, categoryName, controlId)
 }
 
 // cacheControls caches the catalog controls for the given catalog.
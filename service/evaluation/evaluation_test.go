@@ -606,7 +606,7 @@ func TestService_getMetricsFromSubControls(t *testing.T) {
 				storage:                       tt.fields.storage,
 				catalogControls:               tt.fields.catalogControls,
 			}
-			gotMetrics, err := s.getMetricsFromSubcontrols(tt.args.control)
+			gotMetrics, err := orchestrator.MetricsFromSubcontrols(s.getControl, tt.args.control)
 
 			tt.wantErr(t, err)
 
@@ -1278,7 +1278,7 @@ func (m *mockAssessmentClient) AssessEvidence(ctx context.Context, req *assessme
 }
 
 // CalculateCompliance is a stub implementation to satisfy the AssessmentClient interface.
-func (m *mockAssessmentClient) CalculateCompliance(ctx context.Context, req *assessment.CalculateComplianceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *mockAssessmentClient) CalculateCompliance(ctx context.Context, req *assessment.CalculateComplianceRequest, opts ...grpc.CallOption) (*assessment.CalculateComplianceResponse, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 